@@ -137,6 +137,16 @@ func priceFeederCmdHandler(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to parse height poll interval: %w", err)
 	}
 
+	broadcastBackoff, err := time.ParseDuration(cfg.BroadcastRetry.Backoff)
+	if err != nil {
+		return fmt.Errorf("failed to parse broadcast backoff: %w", err)
+	}
+
+	broadcastMaxBackoff, err := time.ParseDuration(cfg.BroadcastRetry.MaxBackoff)
+	if err != nil {
+		return fmt.Errorf("failed to parse broadcast max backoff: %w", err)
+	}
+
 	oracleClient, err := client.NewOracleClient(
 		ctx,
 		logger,
@@ -153,6 +163,11 @@ func priceFeederCmdHandler(cmd *cobra.Command, args []string) error {
 		cfg.GasAdjustment,
 		cfg.GasPrices,
 		heightPollInterval,
+		client.BroadcastRetry{
+			MaxAttempts: cfg.BroadcastRetry.MaxAttempts,
+			Backoff:     broadcastBackoff,
+			MaxBackoff:  broadcastMaxBackoff,
+		},
 	)
 	if err != nil {
 		return err
@@ -172,6 +187,15 @@ func priceFeederCmdHandler(cmd *cobra.Command, args []string) error {
 		deviations[deviation.Base] = threshold
 	}
 
+	priceBounds := make(map[string]types.PriceBound, len(cfg.PriceBounds))
+	for _, bound := range cfg.PriceBounds {
+		priceBound, err := bound.ToPriceBound()
+		if err != nil {
+			return err
+		}
+		priceBounds[bound.Base] = priceBound
+	}
+
 	endpoints := make(map[provider.Name]provider.Endpoint, len(cfg.ProviderEndpoints))
 	for _, e := range cfg.ProviderEndpoints {
 		endpoint, err := e.ToEndpoint()
@@ -188,6 +212,7 @@ func priceFeederCmdHandler(cmd *cobra.Command, args []string) error {
 
 	derivativePairs := map[string][]types.CurrencyPair{}
 	derivativePeriods := map[string]map[string]time.Duration{}
+	derivativeHalfLives := map[string]map[string]time.Duration{}
 	derivativeSymbols := map[string]struct{}{}
 	providerPairs := []config.CurrencyPair{}
 	for _, pair := range cfg.CurrencyPairs {
@@ -200,10 +225,22 @@ func priceFeederCmdHandler(cmd *cobra.Command, args []string) error {
 			if !ok {
 				pairs = []types.CurrencyPair{}
 				derivativePeriods[pair.Derivative] = map[string]time.Duration{}
+				derivativeHalfLives[pair.Derivative] = map[string]time.Duration{}
 			}
-			currencyPair := types.CurrencyPair{Base: pair.Base, Quote: pair.Quote}
+			unit, err := types.ParsePriceUnit(pair.Unit)
+			if err != nil {
+				return err
+			}
+			currencyPair := types.CurrencyPair{Base: pair.Base, Quote: pair.Quote, Unit: unit}
 			derivativePairs[pair.Derivative] = append(pairs, currencyPair)
 			derivativePeriods[pair.Derivative][currencyPair.String()] = period
+			if pair.DerivativeHalfLife != "" {
+				halfLife, err := time.ParseDuration(pair.DerivativeHalfLife)
+				if err != nil {
+					return err
+				}
+				derivativeHalfLives[pair.Derivative][currencyPair.String()] = halfLife
+			}
 			derivativeSymbols[pair.Base+pair.Quote] = struct{}{}
 		}
 		providerPairs = append(providerPairs, pair)
@@ -211,7 +248,14 @@ func priceFeederCmdHandler(cmd *cobra.Command, args []string) error {
 
 	derivatives := map[string]derivative.Derivative{}
 	for name, pairs := range derivativePairs {
-		d, err := derivative.NewDerivative(name, logger, &history, pairs, derivativePeriods[name])
+		d, err := derivative.NewDerivative(
+			name,
+			logger,
+			&history,
+			pairs,
+			derivativePeriods[name],
+			derivativeHalfLives[name],
+		)
 		if err != nil {
 			return err
 		}
@@ -224,12 +268,17 @@ func priceFeederCmdHandler(cmd *cobra.Command, args []string) error {
 		providerPairs,
 		providerTimeout,
 		deviations,
+		cfg.DeviationFilter,
+		priceBounds,
+		cfg.QuotePriority,
 		endpoints,
 		derivatives,
 		derivativePairs,
 		derivativeSymbols,
 		cfg.Healthchecks,
 		history,
+		cfg.ProviderMinOverride,
+		cfg.SkipFailedProviders,
 	)
 
 	telemetryCfg := telemetry.Config{}
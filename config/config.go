@@ -9,6 +9,7 @@ import (
 
 	"price-feeder/oracle/derivative"
 	"price-feeder/oracle/provider"
+	"price-feeder/oracle/types"
 
 	"github.com/BurntSushi/toml"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -18,13 +19,15 @@ import (
 const (
 	DenomUSD = "USD"
 
-	defaultListenAddr         = "0.0.0.0:7171"
-	defaultSrvWriteTimeout    = 15 * time.Second
-	defaultSrvReadTimeout     = 15 * time.Second
-	defaultProviderTimeout    = 100 * time.Millisecond
-	defaultHeightPollInterval = 1 * time.Second
-	defaultHistoryDb          = "prices.db"
-	defaultDerivativePeriod   = 30 * time.Minute
+	defaultListenAddr          = "0.0.0.0:7171"
+	defaultSrvWriteTimeout     = 15 * time.Second
+	defaultSrvReadTimeout      = 15 * time.Second
+	defaultProviderTimeout     = 100 * time.Millisecond
+	defaultHeightPollInterval  = 1 * time.Second
+	defaultHistoryDb           = "prices.db"
+	defaultDerivativePeriod    = 30 * time.Minute
+	defaultBroadcastBackoff    = 1 * time.Second
+	defaultBroadcastMaxBackoff = 5 * time.Second
 )
 
 var (
@@ -36,35 +39,107 @@ var (
 	// SupportedProviders defines a lookup table of all the supported currency API
 	// providers.
 	SupportedProviders = map[provider.Name]struct{}{
-		provider.ProviderBybit:     {},
-		provider.ProviderBitfinex:  {},
-		provider.ProviderBitforex:  {},
-		provider.ProviderBkex:      {},
-		provider.ProviderBitmart:   {},
-		provider.ProviderFin:       {},
-		provider.ProviderFinUsk:    {},
-		provider.ProviderPoloniex:  {},
-		provider.ProviderPhemex:    {},
-		provider.ProviderLbank:     {},
-		provider.ProviderHitBtc:    {},
-		provider.ProviderKraken:    {},
-		provider.ProviderKucoin:    {},
-		provider.ProviderBinance:   {},
-		provider.ProviderBinanceUS: {},
-		provider.ProviderOsmosis:   {},
-		provider.ProviderOsmosisV2: {},
-		provider.ProviderOkx:       {},
-		provider.ProviderHuobi:     {},
-		provider.ProviderGate:      {},
-		provider.ProviderCoinbase:  {},
-		provider.ProviderBitget:    {},
-		provider.ProviderMexc:      {},
-		provider.ProviderCrypto:    {},
-		provider.ProviderCurve:     {},
-		provider.ProviderMock:      {},
-		provider.ProviderStride:    {},
-		provider.ProviderXt:        {},
-		provider.ProviderZero:      {},
+		provider.ProviderBybit:             {},
+		provider.ProviderBitfinex:          {},
+		provider.ProviderBitforex:          {},
+		provider.ProviderBkex:              {},
+		provider.ProviderBitmart:           {},
+		provider.ProviderFin:               {},
+		provider.ProviderFinUsk:            {},
+		provider.ProviderPoloniex:          {},
+		provider.ProviderPhemex:            {},
+		provider.ProviderLbank:             {},
+		provider.ProviderHitBtc:            {},
+		provider.ProviderKraken:            {},
+		provider.ProviderKucoin:            {},
+		provider.ProviderBinance:           {},
+		provider.ProviderBinanceUS:         {},
+		provider.ProviderOsmosis:           {},
+		provider.ProviderOsmosisV2:         {},
+		provider.ProviderOkx:               {},
+		provider.ProviderHuobi:             {},
+		provider.ProviderGate:              {},
+		provider.ProviderCoinbase:          {},
+		provider.ProviderBitget:            {},
+		provider.ProviderMexc:              {},
+		provider.ProviderCrypto:            {},
+		provider.ProviderCurve:             {},
+		provider.ProviderMock:              {},
+		provider.ProviderStride:            {},
+		provider.ProviderXt:                {},
+		provider.ProviderZero:              {},
+		provider.ProviderBinanceFutures:    {},
+		provider.ProviderDeribit:           {},
+		provider.ProviderWhitebit:          {},
+		provider.ProviderProbit:            {},
+		provider.ProviderBtse:              {},
+		provider.ProviderAscendex:          {},
+		provider.ProviderBitflyer:          {},
+		provider.ProviderGemini:            {},
+		provider.ProviderBithumb:           {},
+		provider.ProviderUpbit:             {},
+		provider.ProviderFx:                {},
+		provider.ProviderBitstamp:          {},
+		provider.ProviderOkxIndex:          {},
+		provider.ProviderKrakenFutures:     {},
+		provider.ProviderCfBenchmarks:      {},
+		provider.ProviderHyperliquid:       {},
+		provider.ProviderBackpack:          {},
+		provider.ProviderUniswapV3:         {},
+		provider.ProviderUniswapV2:         {},
+		provider.ProviderCurvePools:        {},
+		provider.ProviderBalancer:          {},
+		provider.ProviderPancake:           {},
+		provider.ProviderTraderJoe:         {},
+		provider.ProviderVelodrome:         {},
+		provider.ProviderAerodrome:         {},
+		provider.ProviderGmx:               {},
+		provider.ProviderCamelot:           {},
+		provider.ProviderSushi:             {},
+		provider.ProviderRaydium:           {},
+		provider.ProviderOrca:              {},
+		provider.ProviderJupiter:           {},
+		provider.ProviderDrift:             {},
+		provider.ProviderOsmosisRPC:        {},
+		provider.ProviderOsmosisTwap:       {},
+		provider.ProviderAstroport:         {},
+		provider.ProviderFinRPC:            {},
+		provider.ProviderWhiteWhale:        {},
+		provider.ProviderAstrovault:        {},
+		provider.ProviderDexter:            {},
+		provider.ProviderDemex:             {},
+		provider.ProviderShade:             {},
+		provider.ProviderOraidex:           {},
+		provider.ProviderThorchain:         {},
+		provider.ProviderInjective:         {},
+		provider.ProviderDydx:              {},
+		provider.ProviderLevana:            {},
+		provider.ProviderCrescent:          {},
+		provider.ProviderTerraswap:         {},
+		provider.ProviderCosmwasmPool:      {},
+		provider.ProviderEvmCall:           {},
+		provider.ProviderRestJSON:          {},
+		provider.ProviderGraphQL:           {},
+		provider.ProviderCoinGecko:         {},
+		provider.ProviderCoinMarketCap:     {},
+		provider.ProviderCryptoCompare:     {},
+		provider.ProviderKaiko:             {},
+		provider.ProviderPyth:              {},
+		provider.ProviderChainlink:         {},
+		provider.ProviderBand:              {},
+		provider.ProviderRedstone:          {},
+		provider.ProviderDia:               {},
+		provider.ProviderEcb:               {},
+		provider.ProviderOpenExchangeRates: {},
+		provider.ProviderFixer:             {},
+		provider.ProviderAlphaVantage:      {},
+		provider.ProviderSwissquote:        {},
+		provider.ProviderTreasury:          {},
+		provider.ProviderLido:              {},
+		provider.ProviderPersistence:       {},
+		provider.ProviderMilkyway:          {},
+		provider.ProviderPstake:            {},
+		provider.ProviderFrankfurter:       {},
 	}
 
 	SupportedDerivatives = map[string]struct{}{
@@ -82,15 +157,20 @@ type (
 		Server              Server              `toml:"server"`
 		CurrencyPairs       []CurrencyPair      `toml:"currency_pairs" validate:"required,gt=0,dive,required"`
 		Deviations          []Deviation         `toml:"deviation_thresholds"`
+		DeviationFilter     string              `toml:"deviation_filter" validate:"omitempty,oneof=stddev mad"`
+		PriceBounds         []PriceBound        `toml:"price_bounds" validate:"dive"`
+		QuotePriority       []string            `toml:"quote_priority"`
 		Account             Account             `toml:"account" validate:"required,gt=0,dive,required"`
 		Keyring             Keyring             `toml:"keyring" validate:"required,gt=0,dive,required"`
 		RPC                 RPC                 `toml:"rpc" validate:"required,gt=0,dive,required"`
+		BroadcastRetry      BroadcastRetry      `toml:"broadcast_retry"`
 		Telemetry           Telemetry           `toml:"telemetry"`
 		GasAdjustment       float64             `toml:"gas_adjustment" validate:"required"`
 		GasPrices           string              `toml:"gas_prices" validate:"required"`
 		ProviderTimeout     string              `toml:"provider_timeout"`
 		ProviderEndpoints   []ProviderEndpoints `toml:"provider_endpoints" validate:"dive"`
 		ProviderMinOverride bool                `toml:"provider_min_override"`
+		SkipFailedProviders bool                `toml:"skip_failed_providers"`
 		EnableServer        bool                `toml:"enable_server"`
 		EnableVoter         bool                `toml:"enable_voter"`
 		Healthchecks        []Healthchecks      `toml:"healthchecks" validate:"dive"`
@@ -110,11 +190,16 @@ type (
 	// CurrencyPair defines a price quote of the exchange rate for two different
 	// currencies and the supported providers for getting the exchange rate.
 	CurrencyPair struct {
-		Base             string          `toml:"base" validate:"required"`
-		Quote            string          `toml:"quote" validate:"required"`
-		Providers        []provider.Name `toml:"providers" validate:"required,gt=0,dive,required"`
-		Derivative       string          `toml:"derivative"`
-		DerivativePeriod string          `toml:"derivative_period"`
+		Base               string          `toml:"base" validate:"required"`
+		Quote              string          `toml:"quote" validate:"required"`
+		Providers          []provider.Name `toml:"providers" validate:"required,gt=0,dive,required"`
+		Derivative         string          `toml:"derivative"`
+		DerivativePeriod   string          `toml:"derivative_period"`
+		DerivativeHalfLife string          `toml:"derivative_half_life"`
+		Unit               string          `toml:"unit"`
+		SlewLimit          string          `toml:"slew_limit"`
+		Aggregation        string          `toml:"aggregation" validate:"omitempty,oneof=vwap median trimmed_mean"`
+		Trim               string          `toml:"trim"`
 	}
 
 	// Deviation defines a maximum amount of standard deviations that a given asset can
@@ -124,6 +209,16 @@ type (
 		Threshold string `toml:"threshold" validate:"required"`
 	}
 
+	// PriceBound defines the absolute plausibility bounds of an asset's price
+	// per whole token. Prices outside of them are never submitted, even if all
+	// providers agree on them.
+	PriceBound struct {
+		Base      string `toml:"base" validate:"required"`
+		Min       string `toml:"min"`
+		Max       string `toml:"max"`
+		Magnitude string `toml:"magnitude"`
+	}
+
 	// Account defines account related configuration that is related to the
 	// network and transaction signing functionality.
 	Account struct {
@@ -147,6 +242,14 @@ type (
 		RPCTimeout    string `toml:"rpc_timeout" validate:"required"`
 	}
 
+	// BroadcastRetry defines how failed vote and prevote broadcasts are retried
+	// within their voting window.
+	BroadcastRetry struct {
+		MaxAttempts int    `toml:"max_attempts"`
+		Backoff     string `toml:"backoff"`
+		MaxBackoff  string `toml:"max_backoff"`
+	}
+
 	// Telemetry defines the configuration options for application telemetry.
 	Telemetry struct {
 		// Prefixed with keys to separate services
@@ -184,11 +287,69 @@ type (
 	}
 
 	ProviderEndpoints struct {
-		Name          provider.Name `toml:"name" validate:"required"`
-		Urls          []string      `toml:"urls"`
-		Websocket     string        `toml:"websocket"`
-		WebsocketPath string        `toml:"websocket_path"`
-		PollInterval  string        `toml:"poll_interval"`
+		Name          provider.Name     `toml:"name" validate:"required"`
+		Urls          []string          `toml:"urls"`
+		Websocket     string            `toml:"websocket"`
+		WebsocketPath string            `toml:"websocket_path"`
+		PollInterval  string            `toml:"poll_interval"`
+		DepthBand     string            `toml:"depth_band"`
+		SymbolsTTL    string            `toml:"symbols_ttl"`
+		Role          provider.Role     `toml:"role" validate:"omitempty,oneof=vote referenceOnly fallback"`
+		ApiKey        string            `toml:"api_key"`
+		Contracts     map[string]string `toml:"contracts"`
+		Decimals      map[string]int64  `toml:"decimals"`
+		TwapWindow    string            `toml:"twap_window"`
+		MaxAge        string            `toml:"max_age"`
+		MaxDeviation  string            `toml:"max_deviation"`
+		Pools         []CosmwasmPool    `toml:"pools" validate:"dive"`
+		Calls         []EvmCall         `toml:"calls" validate:"dive"`
+		Tickers       []RestTicker      `toml:"tickers" validate:"dive"`
+		Queries       []GraphQLQuery    `toml:"queries" validate:"dive"`
+	}
+
+	// CosmwasmPool defines a pair contract queried by the cosmwasm provider,
+	// the decimals default to six.
+	CosmwasmPool struct {
+		Base          string `toml:"base" validate:"required"`
+		Quote         string `toml:"quote" validate:"required"`
+		Url           string `toml:"url"`
+		Contract      string `toml:"contract" validate:"required"`
+		BaseDenom     string `toml:"base_denom" validate:"required"`
+		QuoteDenom    string `toml:"quote_denom" validate:"required"`
+		BaseDecimals  int64  `toml:"base_decimals"`
+		QuoteDecimals int64  `toml:"quote_decimals"`
+	}
+
+	// EvmCall defines a contract call executed by the evmcall provider.
+	EvmCall struct {
+		Base     string `toml:"base" validate:"required"`
+		Quote    string `toml:"quote" validate:"required"`
+		Url      string `toml:"url"`
+		Contract string `toml:"contract" validate:"required"`
+		Method   string `toml:"method" validate:"required"`
+		Output   int    `toml:"output" validate:"gte=0"`
+		Decimals int64  `toml:"decimals" validate:"gte=0"`
+	}
+
+	// RestTicker defines a REST ticker polled by the restjson provider.
+	RestTicker struct {
+		Base   string `toml:"base" validate:"required"`
+		Quote  string `toml:"quote" validate:"required"`
+		Url    string `toml:"url" validate:"required"`
+		Price  string `toml:"price" validate:"required"`
+		Volume string `toml:"volume"`
+		Time   string `toml:"time"`
+	}
+
+	// GraphQLQuery defines a GraphQL query sent by the graphql provider.
+	GraphQLQuery struct {
+		Base   string `toml:"base" validate:"required"`
+		Quote  string `toml:"quote" validate:"required"`
+		Url    string `toml:"url"`
+		Query  string `toml:"query" validate:"required"`
+		Price  string `toml:"price" validate:"required"`
+		Volume string `toml:"volume"`
+		Time   string `toml:"time"`
 	}
 )
 
@@ -205,7 +366,9 @@ func telemetryValidation(sl validator.StructLevel) {
 func endpointValidation(sl validator.StructLevel) {
 	endpoint := sl.Current().Interface().(ProviderEndpoints)
 
-	if len(endpoint.Name) < 1 || (len(endpoint.Urls) < 1 && len(endpoint.Websocket) < 1) {
+	// an endpoint may only set the role, api key, contracts, pools, calls or
+	// tickers of a provider and use its default urls
+	if len(endpoint.Name) < 1 || (len(endpoint.Urls) < 1 && len(endpoint.Websocket) < 1 && len(endpoint.Role) < 1 && len(endpoint.ApiKey) < 1 && len(endpoint.Contracts) < 1 && len(endpoint.Pools) < 1 && len(endpoint.Calls) < 1 && len(endpoint.Tickers) < 1 && len(endpoint.Queries) < 1) {
 		sl.ReportError(endpoint, "endpoint", "Endpoint", "unsupportedEndpointType", "")
 	}
 	if _, ok := SupportedProviders[endpoint.Name]; !ok {
@@ -229,16 +392,166 @@ func (p ProviderEndpoints) ToEndpoint() (provider.Endpoint, error) {
 		}
 		pollInterval = interval
 	}
+	var depthBand sdk.Dec
+	if p.DepthBand != "" {
+		band, err := sdk.NewDecFromStr(p.DepthBand)
+		if err != nil {
+			return provider.Endpoint{}, fmt.Errorf("failed to parse depth band: %v", err)
+		}
+		if !band.IsPositive() || band.GTE(sdk.OneDec()) {
+			return provider.Endpoint{}, fmt.Errorf("depth band must be between 0 and 1")
+		}
+		depthBand = band
+	}
+	var symbolsTTL time.Duration
+	if p.SymbolsTTL != "" {
+		ttl, err := time.ParseDuration(p.SymbolsTTL)
+		if err != nil {
+			return provider.Endpoint{}, fmt.Errorf("failed to parse symbols ttl: %v", err)
+		}
+		symbolsTTL = ttl
+	}
+	var twapWindow time.Duration
+	if p.TwapWindow != "" {
+		window, err := time.ParseDuration(p.TwapWindow)
+		if err != nil {
+			return provider.Endpoint{}, fmt.Errorf("failed to parse twap window: %v", err)
+		}
+		if window < time.Second {
+			return provider.Endpoint{}, fmt.Errorf("twap window must be at least one second")
+		}
+		twapWindow = window
+	}
+	var maxAge time.Duration
+	if p.MaxAge != "" {
+		age, err := time.ParseDuration(p.MaxAge)
+		if err != nil {
+			return provider.Endpoint{}, fmt.Errorf("failed to parse max age: %v", err)
+		}
+		maxAge = age
+	}
+	var maxDeviation sdk.Dec
+	if p.MaxDeviation != "" {
+		deviation, err := sdk.NewDecFromStr(p.MaxDeviation)
+		if err != nil {
+			return provider.Endpoint{}, fmt.Errorf("failed to parse max deviation: %v", err)
+		}
+		if !deviation.IsPositive() || deviation.GTE(sdk.OneDec()) {
+			return provider.Endpoint{}, fmt.Errorf("max deviation must be between 0 and 1")
+		}
+		maxDeviation = deviation
+	}
 	e := provider.Endpoint{
 		Name:          p.Name,
 		Urls:          p.Urls,
 		Websocket:     p.Websocket,
 		WebsocketPath: p.WebsocketPath,
 		PollInterval:  pollInterval,
+		DepthBand:     depthBand,
+		SymbolsTTL:    symbolsTTL,
+		Role:          p.Role,
+		ApiKey:        p.ApiKey,
+		Contracts:     p.Contracts,
+		Decimals:      p.Decimals,
+		TwapWindow:    twapWindow,
+		MaxAge:        maxAge,
+		MaxDeviation:  maxDeviation,
+	}
+	for _, pool := range p.Pools {
+		e.Pools = append(e.Pools, pool.ToCosmwasmPool())
+	}
+	for _, call := range p.Calls {
+		e.Calls = append(e.Calls, call.ToEvmCall())
+	}
+	for _, ticker := range p.Tickers {
+		e.Tickers = append(e.Tickers, ticker.ToRestTicker())
+	}
+	for _, query := range p.Queries {
+		e.Queries = append(e.Queries, query.ToGraphQLQuery())
 	}
 	return e, nil
 }
 
+func (p CosmwasmPool) ToCosmwasmPool() provider.CosmwasmPool {
+	return provider.CosmwasmPool{
+		Base:          strings.ToUpper(p.Base),
+		Quote:         strings.ToUpper(p.Quote),
+		Url:           p.Url,
+		Contract:      p.Contract,
+		BaseDenom:     p.BaseDenom,
+		QuoteDenom:    p.QuoteDenom,
+		BaseDecimals:  p.BaseDecimals,
+		QuoteDecimals: p.QuoteDecimals,
+	}
+}
+
+func (c EvmCall) ToEvmCall() provider.EvmCall {
+	return provider.EvmCall{
+		Base:     strings.ToUpper(c.Base),
+		Quote:    strings.ToUpper(c.Quote),
+		Url:      c.Url,
+		Contract: c.Contract,
+		Method:   c.Method,
+		Output:   c.Output,
+		Decimals: c.Decimals,
+	}
+}
+
+func (t RestTicker) ToRestTicker() provider.RestTicker {
+	return provider.RestTicker{
+		Base:   strings.ToUpper(t.Base),
+		Quote:  strings.ToUpper(t.Quote),
+		Url:    t.Url,
+		Price:  t.Price,
+		Volume: t.Volume,
+		Time:   t.Time,
+	}
+}
+
+func (q GraphQLQuery) ToGraphQLQuery() provider.GraphQLQuery {
+	return provider.GraphQLQuery{
+		Base:   strings.ToUpper(q.Base),
+		Quote:  strings.ToUpper(q.Quote),
+		Url:    q.Url,
+		Query:  q.Query,
+		Price:  q.Price,
+		Volume: q.Volume,
+		Time:   q.Time,
+	}
+}
+
+func (b PriceBound) ToPriceBound() (types.PriceBound, error) {
+	var (
+		bound types.PriceBound
+		err   error
+	)
+	if b.Min != "" {
+		bound.Min, err = sdk.NewDecFromStr(b.Min)
+		if err != nil {
+			return bound, fmt.Errorf("failed to parse min price: %w", err)
+		}
+	}
+	if b.Max != "" {
+		bound.Max, err = sdk.NewDecFromStr(b.Max)
+		if err != nil {
+			return bound, fmt.Errorf("failed to parse max price: %w", err)
+		}
+	}
+	if b.Magnitude != "" {
+		bound.Magnitude, err = sdk.NewDecFromStr(b.Magnitude)
+		if err != nil {
+			return bound, fmt.Errorf("failed to parse price magnitude: %w", err)
+		}
+		if !bound.Magnitude.IsPositive() {
+			return bound, fmt.Errorf("price magnitude must be positive")
+		}
+	}
+	if !bound.Min.IsNil() && !bound.Max.IsNil() && bound.Min.GT(bound.Max) {
+		return bound, fmt.Errorf("min price must not exceed max price")
+	}
+	return bound, nil
+}
+
 // ParseConfig attempts to read and parse configuration from the given file path.
 // An error is returned if reading or parsing the config fails.
 func ParseConfig(configPath string) (Config, error) {
@@ -275,9 +588,20 @@ func ParseConfig(configPath string) (Config, error) {
 	if cfg.HistoryDb == "" {
 		cfg.HistoryDb = defaultHistoryDb
 	}
+	if cfg.BroadcastRetry.Backoff == "" {
+		cfg.BroadcastRetry.Backoff = defaultBroadcastBackoff.String()
+	}
+	if cfg.BroadcastRetry.MaxBackoff == "" {
+		cfg.BroadcastRetry.MaxBackoff = defaultBroadcastMaxBackoff.String()
+	}
+	if cfg.BroadcastRetry.MaxAttempts < 0 {
+		return cfg, fmt.Errorf("broadcast retry max attempts must not be negative")
+	}
 
 	derivativeDenoms := map[string]struct{}{}
 	derivativeBases := map[string]struct{}{}
+	units := map[string]types.PriceUnit{}
+	slewLimits := map[string]string{}
 	pairs := make(map[string]map[provider.Name]struct{})
 	coinQuotes := make(map[string]struct{})
 	for i, cp := range cfg.CurrencyPairs {
@@ -302,12 +626,54 @@ func ParseConfig(configPath string) (Config, error) {
 			} else {
 				cfg.CurrencyPairs[i].DerivativePeriod = defaultDerivativePeriod.String()
 			}
+			if cp.DerivativeHalfLife != "" {
+				halfLife, err := time.ParseDuration(cp.DerivativeHalfLife)
+				if err != nil {
+					return cfg, err
+				}
+				if halfLife <= 0 {
+					return cfg, fmt.Errorf("derivative half life must be positive")
+				}
+			}
 		} else {
 			_, ok := derivativeDenoms[cp.Base]
 			if ok {
 				return cfg, fmt.Errorf("cannot combine derivative and nonderivative pairs for %s", cp.Base)
 			}
 		}
+		unit, err := types.ParsePriceUnit(cp.Unit)
+		if err != nil {
+			return cfg, err
+		}
+		if existing, ok := units[cp.Base]; ok && existing != unit {
+			return cfg, fmt.Errorf("inconsistent price units for %s: %s and %s", cp.Base, existing, unit)
+		}
+		units[cp.Base] = unit
+		if cp.SlewLimit != "" {
+			slewLimit, err := sdk.NewDecFromStr(cp.SlewLimit)
+			if err != nil {
+				return cfg, fmt.Errorf("slew limit must be numeric: %w", err)
+			}
+			if !slewLimit.IsPositive() {
+				return cfg, fmt.Errorf("slew limit must be positive")
+			}
+			if existing, ok := slewLimits[cp.Base]; ok && existing != cp.SlewLimit {
+				return cfg, fmt.Errorf("inconsistent slew limits for %s: %s and %s", cp.Base, existing, cp.SlewLimit)
+			}
+			slewLimits[cp.Base] = cp.SlewLimit
+		}
+		if cp.Trim != "" {
+			if cp.Aggregation != "trimmed_mean" {
+				return cfg, fmt.Errorf("trim requires the trimmed_mean aggregation")
+			}
+			trim, err := sdk.NewDecFromStr(cp.Trim)
+			if err != nil {
+				return cfg, fmt.Errorf("trim must be numeric: %w", err)
+			}
+			if trim.IsNegative() || trim.GTE(sdk.NewDecWithPrec(5, 1)) {
+				return cfg, fmt.Errorf("trim must be at least 0 and below 0.5")
+			}
+		}
 		for _, provider := range cp.Providers {
 			if _, ok := SupportedProviders[provider]; !ok {
 				return cfg, fmt.Errorf("unsupported provider: %s", provider)
@@ -337,5 +703,12 @@ func ParseConfig(configPath string) (Config, error) {
 		}
 	}
 
+	for _, bound := range cfg.PriceBounds {
+		_, err := bound.ToPriceBound()
+		if err != nil {
+			return cfg, fmt.Errorf("invalid price bounds for %s: %w", bound.Base, err)
+		}
+	}
+
 	return cfg, cfg.Validate()
 }
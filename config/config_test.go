@@ -551,3 +551,62 @@ global_labels = [["chain-id", "umee-local-testnet"]]
 	_, err = config.ParseConfig(tmpFile.Name())
 	require.Error(t, err)
 }
+
+func TestParseConfig_Invalid_Units(t *testing.T) {
+	tmpFile, err := ioutil.TempFile("", "price-feeder.toml")
+	require.NoError(t, err)
+	defer os.Remove(tmpFile.Name())
+
+	content := []byte(`
+gas_adjustment = 1.5
+provider_min_override = true
+
+[[currency_pairs]]
+base = "ATOM"
+quote = "USDT"
+unit = "micro"
+providers = [
+	"kraken",
+]
+
+[[currency_pairs]]
+base = "ATOM"
+quote = "USD"
+providers = [
+	"binance",
+]
+`)
+	_, err = tmpFile.Write(content)
+	require.NoError(t, err)
+
+	_, err = config.ParseConfig(tmpFile.Name())
+	require.ErrorContains(t, err, "inconsistent price units")
+}
+
+func TestParseConfig_Invalid_PriceBounds(t *testing.T) {
+	tmpFile, err := ioutil.TempFile("", "price-feeder.toml")
+	require.NoError(t, err)
+	defer os.Remove(tmpFile.Name())
+
+	content := []byte(`
+gas_adjustment = 1.5
+provider_min_override = true
+
+[[currency_pairs]]
+base = "ATOM"
+quote = "USDT"
+providers = [
+	"kraken",
+]
+
+[[price_bounds]]
+base = "ATOM"
+min = "100"
+max = "1"
+`)
+	_, err = tmpFile.Write(content)
+	require.NoError(t, err)
+
+	_, err = config.ParseConfig(tmpFile.Name())
+	require.ErrorContains(t, err, "invalid price bounds for ATOM")
+}
@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"regexp"
+	"strconv"
 	"time"
 
 	"github.com/Team-Kujira/core/app"
@@ -26,6 +28,15 @@ import (
 	tmjsonclient "github.com/tendermint/tendermint/rpc/jsonrpc/client"
 )
 
+const (
+	defaultBroadcastBackoff    = 1 * time.Second
+	defaultBroadcastMaxBackoff = 5 * time.Second
+)
+
+// sequenceMismatchRegex matches the sdkerrors.ErrWrongSequence message, ex.:
+// "account sequence mismatch, expected 7, got 6: incorrect account sequence".
+var sequenceMismatchRegex = regexp.MustCompile(`account sequence mismatch, expected (\d+)`)
+
 type (
 	// OracleClient defines a structure that interfaces with the Umee node.
 	OracleClient struct {
@@ -47,8 +58,21 @@ type (
 		GRPCEndpoint        string
 		KeyringPassphrase   string
 		ChainHeight         *ChainHeight
+		BroadcastRetry      BroadcastRetry
 	}
 
+	// BroadcastRetry defines how failed broadcasts are retried. Retries never
+	// exceed the timeout height of the broadcast.
+	BroadcastRetry struct {
+		MaxAttempts int           // 0 retries until the timeout height
+		Backoff     time.Duration // delay after the first failed attempt
+		MaxBackoff  time.Duration
+	}
+
+	// broadcastFunc broadcasts a transaction using the given account sequence.
+	// A sequence of 0 queries the current sequence of the account.
+	broadcastFunc func(sequence uint64) (*sdk.TxResponse, error)
+
 	passReader struct {
 		pass string
 		buf  *bytes.Buffer
@@ -71,6 +95,7 @@ func NewOracleClient(
 	gasAdjustment float64,
 	gasPrices string,
 	heightPollInterval time.Duration,
+	broadcastRetry BroadcastRetry,
 ) (OracleClient, error) {
 	oracleAddr, err := sdk.AccAddressFromBech32(oracleAddrString)
 	if err != nil {
@@ -96,6 +121,7 @@ func NewOracleClient(
 		GasAdjustment:       gasAdjustment,
 		GRPCEndpoint:        grpcEndpoint,
 		GasPrices:           gasPrices,
+		BroadcastRetry:      broadcastRetry,
 	}
 
 	clientCtx, err := oracleClient.CreateClientContext()
@@ -134,13 +160,12 @@ func (r *passReader) Read(p []byte) (n int, err error) {
 	return n, err
 }
 
-// BroadcastTx attempts to broadcast a signed transaction. If it fails, a few re-attempts
-// will be made until the transaction succeeds or ultimately times out or fails.
+// BroadcastTx attempts to broadcast a signed transaction. If it fails, re-attempts
+// with an exponential backoff will be made until the transaction succeeds or
+// ultimately times out or fails. Sequence mismatches refresh the account sequence
+// used by the next attempt.
 // Ref: https://github.com/terra-money/oracle-feeder/blob/baef2a4a02f57a2ffeaa207932b2e03d7fb0fb25/feeder/src/vote.ts#L230
 func (oc OracleClient) BroadcastTx(nextBlockHeight, timeoutHeight int64, msgs ...sdk.Msg) error {
-	maxBlockHeight := nextBlockHeight + timeoutHeight
-	lastCheckHeight := nextBlockHeight - 1
-
 	clientCtx, err := oc.CreateClientContext()
 	if err != nil {
 		return err
@@ -151,24 +176,38 @@ func (oc OracleClient) BroadcastTx(nextBlockHeight, timeoutHeight int64, msgs ..
 		return err
 	}
 
+	return oc.broadcastWithRetry(nextBlockHeight, timeoutHeight, func(sequence uint64) (*sdk.TxResponse, error) {
+		return BroadcastTx(clientCtx, factory.WithSequence(sequence), msgs...)
+	})
+}
+
+func (oc OracleClient) broadcastWithRetry(nextBlockHeight, timeoutHeight int64, broadcast broadcastFunc) error {
+	maxBlockHeight := nextBlockHeight + timeoutHeight
+	backoff := oc.BroadcastRetry.Backoff
+	if backoff == 0 {
+		backoff = defaultBroadcastBackoff
+	}
+	maxBackoff := oc.BroadcastRetry.MaxBackoff
+	if maxBackoff == 0 {
+		maxBackoff = defaultBroadcastMaxBackoff
+	}
+
+	var sequence uint64
 	// re-try voting until timeout
-	for lastCheckHeight < maxBlockHeight {
+	for attempt := 1; ; attempt++ {
 		latestBlockHeight, err := oc.ChainHeight.GetChainHeight()
 		if err != nil {
 			return err
 		}
 
-		if latestBlockHeight <= lastCheckHeight {
-			continue
+		if latestBlockHeight >= maxBlockHeight {
+			break
 		}
 
-		// set last check height to latest block height
-		lastCheckHeight = latestBlockHeight
-
-		resp, err := BroadcastTx(clientCtx, factory, msgs...)
+		resp, err := broadcast(sequence)
 		if resp != nil && resp.Code != 0 {
 			telemetry.IncrCounter(1, "failure", "tx", "code")
-			err = fmt.Errorf("invalid response code from tx: %d", resp.Code)
+			err = fmt.Errorf("invalid response code from tx: %d: %s", resp.Code, resp.RawLog)
 		}
 		if err != nil {
 			var (
@@ -180,15 +219,32 @@ func (oc OracleClient) BroadcastTx(nextBlockHeight, timeoutHeight int64, msgs ..
 				hash = resp.TxHash
 			}
 
+			// use the expected sequence of a mismatch, otherwise query it again
+			sequence = 0
+			if expected, ok := parseExpectedSequence(err); ok {
+				sequence = expected
+			}
+
 			oc.Logger.Debug().
 				Err(err).
+				Int("attempt", attempt).
 				Int64("max_height", maxBlockHeight).
-				Int64("last_check_height", lastCheckHeight).
+				Int64("last_check_height", latestBlockHeight).
 				Str("tx_hash", hash).
 				Uint32("tx_code", code).
+				Uint64("sequence", sequence).
 				Msg("failed to broadcast tx; retrying...")
 
-			time.Sleep(time.Second * 1)
+			if oc.BroadcastRetry.MaxAttempts > 0 && attempt >= oc.BroadcastRetry.MaxAttempts {
+				telemetry.IncrCounter(1, "failure", "tx", "attempts")
+				return fmt.Errorf("broadcasting tx failed after %d attempts: %w", attempt, err)
+			}
+
+			time.Sleep(backoff)
+			backoff *= 2
+			if backoff > maxBackoff {
+				backoff = maxBackoff
+			}
 			continue
 		}
 
@@ -205,6 +261,20 @@ func (oc OracleClient) BroadcastTx(nextBlockHeight, timeoutHeight int64, msgs ..
 	return errors.New("broadcasting tx timed out")
 }
 
+// parseExpectedSequence returns the expected account sequence of a sequence
+// mismatch error.
+func parseExpectedSequence(err error) (uint64, bool) {
+	matches := sequenceMismatchRegex.FindStringSubmatch(err.Error())
+	if len(matches) != 2 {
+		return 0, false
+	}
+	sequence, parseErr := strconv.ParseUint(matches[1], 10, 64)
+	if parseErr != nil {
+		return 0, false
+	}
+	return sequence, true
+}
+
 // CreateClientContext creates an SDK client Context instance used for transaction
 // generation, signing and broadcasting.
 func (oc OracleClient) CreateClientContext() (client.Context, error) {
@@ -0,0 +1,65 @@
+package client
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBroadcastWithRetry_SequenceMismatch(t *testing.T) {
+	oc := OracleClient{
+		Logger:      zerolog.Nop(),
+		ChainHeight: &ChainHeight{height: 10},
+		BroadcastRetry: BroadcastRetry{
+			Backoff: time.Millisecond,
+		},
+	}
+
+	sequences := []uint64{}
+	err := oc.broadcastWithRetry(11, 5, func(sequence uint64) (*sdk.TxResponse, error) {
+		sequences = append(sequences, sequence)
+		if len(sequences) == 1 {
+			return &sdk.TxResponse{
+				Code:   32,
+				RawLog: "account sequence mismatch, expected 7, got 6: incorrect account sequence",
+			}, nil
+		}
+		return &sdk.TxResponse{TxHash: "ABCD"}, nil
+	})
+	require.NoError(t, err)
+	// the first attempt queries the sequence, the retry uses the expected one
+	require.Equal(t, []uint64{0, 7}, sequences)
+}
+
+func TestBroadcastWithRetry_Bounded(t *testing.T) {
+	oc := OracleClient{
+		Logger:      zerolog.Nop(),
+		ChainHeight: &ChainHeight{height: 10},
+		BroadcastRetry: BroadcastRetry{
+			MaxAttempts: 3,
+			Backoff:     time.Millisecond,
+		},
+	}
+
+	attempts := 0
+	err := oc.broadcastWithRetry(11, 5, func(sequence uint64) (*sdk.TxResponse, error) {
+		attempts++
+		return nil, fmt.Errorf("mempool is full")
+	})
+	require.ErrorContains(t, err, "after 3 attempts")
+	require.Equal(t, 3, attempts)
+
+	// never broadcast past the timeout height
+	oc.ChainHeight.height = 16
+	attempts = 0
+	err = oc.broadcastWithRetry(11, 5, func(sequence uint64) (*sdk.TxResponse, error) {
+		attempts++
+		return &sdk.TxResponse{}, nil
+	})
+	require.ErrorContains(t, err, "timed out")
+	require.Zero(t, attempts)
+}
@@ -13,18 +13,52 @@ import (
 // using the conversion rates of other tickers. It will also filter out any tickers
 // not within the deviation threshold set by the config.
 //
+// If several quotes of the same base resolve to a USD rate, the rate of the
+// quote ranked first in the quote priority is used. Quotes of the same
+// priority, including any quotes not listed, are combined.
+//
+// The price of every symbol is aggregated over the providers using the
+// aggregation configured for the pair, which defaults to the VWAP.
+//
 // Ref: https://github.com/umee-network/umee/blob/4348c3e433df8c37dd98a690e96fc275de609bc1/price-feeder/oracle/filter.go#L41
 func convertTickersToUSD(
 	logger zerolog.Logger,
 	tickers provider.AggregatedProviderPrices,
 	providerPairs map[provider.Name][]types.CurrencyPair,
 	deviationThresholds map[string]sdk.Dec,
+	deviationFilter string,
+	quotePriority []string,
+	aggregations map[string]Aggregation,
 ) (map[string]sdk.Dec, error) {
 
 	if len(tickers) == 0 {
 		return nil, nil
 	}
 
+	// remove outliers
+
+	providerPrices, err := FilterTickerDeviations(
+		logger,
+		tickers,
+		deviationThresholds,
+		deviationFilter,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertFilteredTickersToUSD(logger, providerPrices, providerPairs, quotePriority, aggregations)
+}
+
+// convertFilteredTickersToUSD converts the tickers to USD like
+// convertTickersToUSD, but expects the outliers to be removed already.
+func convertFilteredTickersToUSD(
+	logger zerolog.Logger,
+	providerPrices provider.AggregatedProviderPrices,
+	providerPairs map[provider.Name][]types.CurrencyPair,
+	quotePriority []string,
+	aggregations map[string]Aggregation,
+) (map[string]sdk.Dec, error) {
 	type Vwap struct {
 		Base   string
 		Quote  string
@@ -33,8 +67,22 @@ func convertTickersToUSD(
 	}
 
 	type Rate struct {
-		Value  sdk.Dec
-		Volume sdk.Dec
+		Value    sdk.Dec
+		Volume   sdk.Dec
+		Priority int
+	}
+
+	// lower values are preferred
+	priorities := map[string]int{}
+	for i, quote := range quotePriority {
+		priorities[quote] = i
+	}
+	getPriority := func(quote string) int {
+		priority, found := priorities[quote]
+		if !found {
+			return len(quotePriority)
+		}
+		return priority
 	}
 
 	// prepare map of vwap prices calculated over all providers
@@ -54,17 +102,6 @@ func convertTickersToUSD(
 		}
 	}
 
-	// remove outliers
-
-	providerPrices, err := FilterTickerDeviations(
-		logger,
-		tickers,
-		deviationThresholds,
-	)
-	if err != nil {
-		return nil, err
-	}
-
 	// group ticker prices by symbol
 
 	tickerPricesBySymbol := map[string][]types.TickerPrice{}
@@ -94,12 +131,12 @@ func convertTickersToUSD(
 			continue
 		}
 
-		vwap, err := ComputeVWAP(tickerPrices)
+		vwap, err := ComputeAggregatedPrice(aggregations[symbol], tickerPrices)
 
 		if err != nil {
 			logger.Error().
 				Str("symbol", symbol).
-				Msg("Failed computing VWAP")
+				Msg("Failed computing aggregated price")
 			continue
 		}
 
@@ -143,7 +180,7 @@ func convertTickersToUSD(
 		})
 
 		for _, vwap := range vwaps {
-			rate := Rate{}
+			rate := Rate{Priority: getPriority(vwap.Quote)}
 			add := false
 			if vwap.Quote == "USD" {
 				rate.Value = vwap.Value
@@ -163,7 +200,10 @@ func convertTickersToUSD(
 			if add {
 				// VWAP
 				existing, found := rates[vwap.Base]
-				if found {
+				if found && existing.Priority < rate.Priority {
+					continue
+				}
+				if found && existing.Priority == rate.Priority {
 					difference := existing.Value.Sub(rate.Value).Abs()
 					if !difference.IsZero() {
 						difference = difference.Quo(existing.Value)
@@ -1,7 +1,12 @@
 package oracle
 
 import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"price-feeder/oracle/provider"
 	"price-feeder/oracle/types"
@@ -72,6 +77,9 @@ func TestConvertTickersToUsdChaining(t *testing.T) {
 		providerPrices,
 		providerPairs,
 		make(map[string]sdk.Dec),
+		"",
+		nil,
+		nil,
 	)
 	require.NoError(t, err)
 
@@ -155,6 +163,9 @@ func TestConvertTickersToUSDFiltering(t *testing.T) {
 		providerPrices,
 		providerPairs,
 		make(map[string]sdk.Dec),
+		"",
+		nil,
+		nil,
 	)
 	require.NoError(t, err)
 
@@ -205,6 +216,9 @@ func TestConvertTickersToUsdVwap(t *testing.T) {
 		providerPrices,
 		providerPairs,
 		make(map[string]sdk.Dec),
+		"",
+		nil,
+		nil,
 	)
 	require.NoError(t, err)
 
@@ -227,6 +241,55 @@ func TestConvertTickersToUsdVwap(t *testing.T) {
 	)
 }
 
+func TestConvertTickersToUsdUpbitKrw(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		_, _ = fmt.Fprintf(rw, `[{"market":"KRW-ATOM","trade_price":14470,"acc_trade_volume_24h":1000,"timestamp":%d}]`, time.Now().UnixMilli())
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	atomKrw := types.CurrencyPair{Base: "ATOM", Quote: "KRW"}
+	krwUsd := types.CurrencyPair{Base: "KRW", Quote: "USD"}
+
+	upbit, err := provider.NewUpbitProvider(ctx, zerolog.Nop(), provider.Endpoint{
+		Name: provider.ProviderUpbit,
+		Urls: []string{server.URL},
+	}, atomKrw)
+	require.NoError(t, err)
+	require.NoError(t, upbit.Poll())
+	upbitTickers, err := upbit.GetTickerPrices(atomKrw)
+	require.NoError(t, err)
+
+	providerPrices := provider.AggregatedProviderPrices{
+		provider.ProviderUpbit: upbitTickers,
+		provider.ProviderFrankfurter: {
+			"KRWUSD": {
+				Price:  sdk.MustNewDecFromStr("0.0008"),
+				Volume: sdk.OneDec(),
+			},
+		},
+	}
+	providerPairs := map[provider.Name][]types.CurrencyPair{
+		provider.ProviderUpbit:       {atomKrw},
+		provider.ProviderFrankfurter: {krwUsd},
+	}
+
+	rates, err := convertTickersToUSD(
+		zerolog.Nop(),
+		providerPrices,
+		providerPairs,
+		make(map[string]sdk.Dec),
+		"",
+		nil,
+		nil,
+	)
+	require.NoError(t, err)
+	// 14470 KRW * 0.0008 USD
+	require.Equal(t, sdk.MustNewDecFromStr("11.576"), rates["ATOM"])
+}
+
 func TestConvertTickersToUsdEmptyProvider(t *testing.T) {
 	providerPrices := provider.AggregatedProviderPrices{}
 
@@ -243,6 +306,9 @@ func TestConvertTickersToUsdEmptyProvider(t *testing.T) {
 		providerPrices,
 		providerPairs,
 		make(map[string]sdk.Dec),
+		"",
+		nil,
+		nil,
 	)
 	require.NoError(t, err)
 
@@ -259,8 +325,100 @@ func TestConvertTickersToUsdEmptyPrices(t *testing.T) {
 		providerPrices,
 		providerPairs,
 		make(map[string]sdk.Dec),
+		"",
+		nil,
+		nil,
 	)
 	require.NoError(t, err)
 
 	require.Equal(t, 0, len(rates))
 }
+
+func TestConvertTickersToUsdQuotePriority(t *testing.T) {
+	atomUsd := types.CurrencyPair{Base: "ATOM", Quote: "USD"}
+	atomUsdc := types.CurrencyPair{Base: "ATOM", Quote: "USDC"}
+	atomUsdt := types.CurrencyPair{Base: "ATOM", Quote: "USDT"}
+	usdcUsd := types.CurrencyPair{Base: "USDC", Quote: "USD"}
+	usdtUsd := types.CurrencyPair{Base: "USDT", Quote: "USD"}
+
+	providerPairs := map[provider.Name][]types.CurrencyPair{
+		provider.ProviderKraken:   {atomUsd},
+		provider.ProviderBinance:  {atomUsdc, atomUsdt},
+		provider.ProviderCoinbase: {usdcUsd, usdtUsd},
+	}
+
+	newProviderPrices := func(symbols ...string) provider.AggregatedProviderPrices {
+		tickers := map[string]types.TickerPrice{
+			"ATOMUSD":  {Price: sdk.MustNewDecFromStr("10"), Volume: sdk.MustNewDecFromStr("10")},
+			"ATOMUSDC": {Price: sdk.MustNewDecFromStr("10.1"), Volume: sdk.MustNewDecFromStr("100")},
+			"ATOMUSDT": {Price: sdk.MustNewDecFromStr("10.05"), Volume: sdk.MustNewDecFromStr("1000")},
+			"USDCUSD":  {Price: sdk.MustNewDecFromStr("0.99"), Volume: sdk.MustNewDecFromStr("100000")},
+			"USDTUSD":  {Price: sdk.MustNewDecFromStr("1"), Volume: sdk.MustNewDecFromStr("100000")},
+		}
+		providerPrices := provider.AggregatedProviderPrices{}
+		for providerName, pairs := range providerPairs {
+			providerPrices[providerName] = map[string]types.TickerPrice{}
+			for _, pair := range pairs {
+				for _, symbol := range symbols {
+					if pair.String() == symbol {
+						providerPrices[providerName][symbol] = tickers[symbol]
+					}
+				}
+			}
+		}
+		return providerPrices
+	}
+
+	quotePriority := []string{"USD", "USDC", "USDT"}
+
+	// the preferred quote is used, even though the others have more volume
+	rates, err := convertTickersToUSD(
+		zerolog.Nop(),
+		newProviderPrices("ATOMUSD", "ATOMUSDC", "ATOMUSDT", "USDCUSD", "USDTUSD"),
+		providerPairs,
+		make(map[string]sdk.Dec),
+		"",
+		quotePriority,
+		nil,
+	)
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("10"), rates["ATOM"])
+
+	// fall back to USDC, corrected for its peg: 10.1 * 0.99
+	rates, err = convertTickersToUSD(
+		zerolog.Nop(),
+		newProviderPrices("ATOMUSDC", "ATOMUSDT", "USDCUSD", "USDTUSD"),
+		providerPairs,
+		make(map[string]sdk.Dec),
+		"",
+		quotePriority,
+		nil,
+	)
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("9.999"), rates["ATOM"])
+
+	rates, err = convertTickersToUSD(
+		zerolog.Nop(),
+		newProviderPrices("ATOMUSDT", "USDCUSD", "USDTUSD"),
+		providerPairs,
+		make(map[string]sdk.Dec),
+		"",
+		quotePriority,
+		nil,
+	)
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("10.05"), rates["ATOM"])
+
+	// without a priority all quotes are combined
+	rates, err = convertTickersToUSD(
+		zerolog.Nop(),
+		newProviderPrices("ATOMUSD", "ATOMUSDC", "ATOMUSDT", "USDCUSD", "USDTUSD"),
+		providerPairs,
+		make(map[string]sdk.Dec),
+		"",
+		nil,
+		nil,
+	)
+	require.NoError(t, err)
+	require.NotEqual(t, sdk.MustNewDecFromStr("10"), rates["ATOM"])
+}
@@ -21,10 +21,11 @@ type (
 	}
 
 	derivative struct {
-		pairs   []types.CurrencyPair
-		history *history.PriceHistory
-		logger  zerolog.Logger
-		periods map[string]time.Duration
+		pairs     []types.CurrencyPair
+		history   *history.PriceHistory
+		logger    zerolog.Logger
+		periods   map[string]time.Duration
+		halfLives map[string]time.Duration
 	}
 )
 
@@ -34,13 +35,14 @@ func NewDerivative(
 	history *history.PriceHistory,
 	pairs []types.CurrencyPair,
 	periods map[string]time.Duration,
+	halfLives map[string]time.Duration,
 ) (Derivative, error) {
 	derivativeLogger := logger.With().Str("derivative", name).Logger()
 	switch name {
 	case DerivativeStride:
-		return NewTvwapDerivative(history, derivativeLogger, pairs, periods)
+		return NewTvwapDerivative(history, derivativeLogger, pairs, periods, halfLives)
 	case DerivativeTvwap:
-		return NewTvwapDerivative(history, derivativeLogger, pairs, periods)
+		return NewTvwapDerivative(history, derivativeLogger, pairs, periods, halfLives)
 	}
 	return nil, fmt.Errorf("unsupported provider: %s", name)
 }
@@ -2,6 +2,8 @@ package derivative
 
 import (
 	"fmt"
+	"math"
+	"strconv"
 	"time"
 
 	"price-feeder/oracle/history"
@@ -27,13 +29,15 @@ func NewTvwapDerivative(
 	logger zerolog.Logger,
 	pairs []types.CurrencyPair,
 	periods map[string]time.Duration,
+	halfLives map[string]time.Duration,
 ) (*TvwapDerivative, error) {
 	d := &TvwapDerivative{
 		derivative: derivative{
-			pairs:   pairs,
-			history: history,
-			logger:  logger,
-			periods: periods,
+			pairs:     pairs,
+			history:   history,
+			logger:    logger,
+			periods:   periods,
+			halfLives: halfLives,
 		},
 	}
 	return d, nil
@@ -54,7 +58,7 @@ func (d *TvwapDerivative) GetPrice(pair types.CurrencyPair) (types.TickerPrice,
 		d.logger.Error().Err(err).Str("pair", symbol).Msg("failed to get historical tickers")
 		return types.TickerPrice{}, err
 	}
-	pairPrice, err := Tvwap(tickers, start, now)
+	pairPrice, err := TvwapDecay(tickers, start, now, d.halfLives[symbol])
 	if err != nil || pairPrice.IsNil() || pairPrice.IsZero() {
 		d.logger.Warn().Err(err).Str("pair", symbol).Dur("period", period).Msg("failed to compute derivative price")
 		return types.TickerPrice{}, err
@@ -76,10 +80,26 @@ func (d *TvwapDerivative) GetPrice(pair types.CurrencyPair) (types.TickerPrice,
 	return ticker, nil
 }
 
+// Tvwap computes the time and volume weighted average price of the stored
+// tickers between start and end, where every ticker is weighted by the time
+// until the next ticker of the same provider.
 func Tvwap(
 	tickers map[string][]types.TickerPrice,
 	start time.Time,
 	end time.Time,
+) (sdk.Dec, error) {
+	return TvwapDecay(tickers, start, end, 0)
+}
+
+// TvwapDecay computes the Tvwap with the weight of every ticker decaying
+// exponentially with its age at end, halving every halfLife, so recent
+// prices dominate while spikes within the window are still smoothed out.
+// A halfLife of zero disables the decay.
+func TvwapDecay(
+	tickers map[string][]types.TickerPrice,
+	start time.Time,
+	end time.Time,
+	halfLife time.Duration,
 ) (sdk.Dec, error) {
 	priceTotal := sdk.ZeroDec()
 	volumeTotal := sdk.ZeroDec()
@@ -89,6 +109,7 @@ func Tvwap(
 		providerPriceTotal := sdk.ZeroDec()
 		providerVolumeTotal := sdk.ZeroDec()
 		providerTimeTotal := int64(0)
+		providerWeightTotal := sdk.ZeroDec()
 		for i, ticker := range providerTickers {
 			if ticker.Time.Before(start) {
 				continue
@@ -106,15 +127,26 @@ func Tvwap(
 			if timeDelta > tvwapMaxTimeDeltaSeconds {
 				return sdk.Dec{}, fmt.Errorf("missing history for pair")
 			}
-			providerPriceTotal = providerPriceTotal.Add(ticker.Price.MulInt64(timeDelta))
-			providerVolumeTotal = providerVolumeTotal.Add(ticker.Volume.MulInt64(timeDelta))
+			weight := sdk.NewDec(timeDelta)
+			if halfLife > 0 {
+				weight = weight.Mul(tvwapDecayFactor(end.Sub(ticker.Time), halfLife))
+			}
+			providerPriceTotal = providerPriceTotal.Add(ticker.Price.Mul(weight))
+			providerVolumeTotal = providerVolumeTotal.Add(ticker.Volume.Mul(weight))
+			providerWeightTotal = providerWeightTotal.Add(weight)
 			providerTimeTotal = providerTimeTotal + timeDelta
 		}
-		if providerTimeTotal == 0 || providerTimeTotal < minPeriod {
+		if providerTimeTotal == 0 || providerTimeTotal < minPeriod || !providerWeightTotal.IsPositive() {
 			continue
 		}
-		providerWeightedVolume := providerVolumeTotal.QuoInt64(providerTimeTotal)
-		providerWeightedPrice := providerPriceTotal.QuoInt64(providerTimeTotal).Mul(providerWeightedVolume)
+		average := func(total sdk.Dec) sdk.Dec {
+			if halfLife > 0 {
+				return total.Quo(providerWeightTotal)
+			}
+			return total.QuoInt64(providerTimeTotal)
+		}
+		providerWeightedVolume := average(providerVolumeTotal)
+		providerWeightedPrice := average(providerPriceTotal).Mul(providerWeightedVolume)
 		priceTotal = priceTotal.Add(providerWeightedPrice)
 		volumeTotal = volumeTotal.Add(providerWeightedVolume)
 	}
@@ -123,3 +155,10 @@ func Tvwap(
 	}
 	return priceTotal.Quo(volumeTotal), nil
 }
+
+// tvwapDecayFactor returns the weight of a ticker of the given age, which
+// halves every halfLife.
+func tvwapDecayFactor(age time.Duration, halfLife time.Duration) sdk.Dec {
+	factor := math.Exp2(-age.Seconds() / halfLife.Seconds())
+	return sdk.MustNewDecFromStr(strconv.FormatFloat(factor, 'f', 18, 64))
+}
@@ -112,3 +112,18 @@ func TestTvwapDerivative_tvwap(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, testTvwapPrice5, result5)
 }
+
+func TestTvwapDerivative_tvwapDecay(t *testing.T) {
+	result, err := TvwapDecay(testHistoricalTickers2, testTvwapStart2, testTvwapEnd2, 0)
+	require.NoError(t, err)
+	require.Equal(t, testTvwapPrice2, result)
+
+	// the weights of the tickers aged 3s, 2s and 1s halve every second
+	result, err = TvwapDecay(testHistoricalTickers2, testTvwapStart2, testTvwapEnd2, time.Second)
+	require.NoError(t, err)
+	expected := sdk.MustNewDecFromStr("12.142857142857142857")
+	require.True(t, result.Sub(expected).Abs().LT(sdk.MustNewDecFromStr("0.000000001")), result.String())
+
+	_, err = TvwapDecay(testHistoricalTickers4, testTvwapStart4, testTvwapEnd4, time.Second)
+	require.Error(t, err)
+}
@@ -17,10 +17,13 @@ var defaultDeviationThreshold = sdk.MustNewDecFromStr("1.0")
 
 // FilterTickerDeviations finds the standard deviations of the prices of
 // all assets, and filters out any providers that are not within 2𝜎 of the mean.
+// The median absolute deviation filter uses the median as center and the
+// scaled median absolute deviation as 𝜎 instead.
 func FilterTickerDeviations(
 	logger zerolog.Logger,
 	prices provider.AggregatedProviderPrices,
 	deviationThresholds map[string]sdk.Dec,
+	deviationFilter string,
 ) (provider.AggregatedProviderPrices, error) {
 	var (
 		filteredPrices = make(provider.AggregatedProviderPrices)
@@ -38,7 +41,7 @@ func FilterTickerDeviations(
 		}
 	}
 
-	deviations, means, err := StandardDeviation(priceMap)
+	deviations, means, err := ComputeDeviations(priceMap, deviationFilter)
 	if err != nil {
 		return nil, err
 	}
@@ -76,6 +79,30 @@ func FilterTickerDeviations(
 	return filteredPrices, nil
 }
 
+// FilterPriceBounds removes any computed price outside of the absolute
+// plausibility bounds of its denom.
+func FilterPriceBounds(
+	logger zerolog.Logger,
+	prices map[string]sdk.Dec,
+	bounds map[string]types.PriceBound,
+) map[string]sdk.Dec {
+	filteredPrices := make(map[string]sdk.Dec, len(prices))
+	for denom, price := range prices {
+		bound, ok := bounds[denom]
+		if ok && !bound.IsPlausible(price) {
+			telemetry.IncrCounter(1, "failure", "bounds")
+			logger.Error().
+				Str("denom", denom).
+				Str("price", price.String()).
+				Msg("implausible price vetoed")
+			continue
+		}
+		filteredPrices[denom] = price
+	}
+
+	return filteredPrices
+}
+
 func isBetween(p, mean, margin sdk.Dec) bool {
 	return p.GTE(mean.Sub(margin)) &&
 		p.LTE(mean.Add(margin))
@@ -46,6 +46,7 @@ func TestSuccessFilterTickerDeviations(t *testing.T) {
 		zerolog.Nop(),
 		providerTickers,
 		make(map[string]sdk.Dec),
+		"",
 	)
 
 	_, ok := pricesFiltered[provider.ProviderCoinbase]
@@ -59,9 +60,108 @@ func TestSuccessFilterTickerDeviations(t *testing.T) {
 		zerolog.Nop(),
 		providerTickers,
 		customDeviations,
+		"",
 	)
 
 	_, ok = pricesFilteredCustom[provider.ProviderCoinbase]
 	require.NoError(t, err, "It should successfully not filter out coinbase")
 	require.True(t, ok, "The filtered candle deviation price of coinbase should remain")
 }
+
+func TestFilterTickerDeviations_MAD(t *testing.T) {
+	providerTickers := provider.AggregatedProviderPrices{}
+	prices := map[provider.Name]string{
+		provider.ProviderBinance:  "29.93",
+		provider.ProviderHuobi:    "29.95",
+		provider.ProviderKraken:   "29.90",
+		provider.ProviderCoinbase: "45",
+	}
+	for providerName, price := range prices {
+		providerTickers[providerName] = map[string]types.TickerPrice{
+			"ATOM": {
+				Price:  sdk.MustNewDecFromStr(price),
+				Volume: sdk.OneDec(),
+			},
+		}
+	}
+
+	// the outlier widens the standard deviation enough to keep itself
+	pricesFiltered, err := FilterTickerDeviations(
+		zerolog.Nop(),
+		providerTickers,
+		map[string]sdk.Dec{"ATOM": sdk.NewDec(2)},
+		DeviationFilterStdDev,
+	)
+	require.NoError(t, err)
+	require.Contains(t, pricesFiltered, provider.ProviderCoinbase)
+
+	pricesFiltered, err = FilterTickerDeviations(
+		zerolog.Nop(),
+		providerTickers,
+		map[string]sdk.Dec{"ATOM": sdk.NewDec(2)},
+		DeviationFilterMAD,
+	)
+	require.NoError(t, err)
+	require.NotContains(t, pricesFiltered, provider.ProviderCoinbase)
+	require.Len(t, pricesFiltered, 3)
+}
+
+func TestFilterPriceBounds_UnanimousImplausiblePrice(t *testing.T) {
+	pair := types.CurrencyPair{Base: "ATOM", Quote: "USD"}
+	atomTickerPrice := types.TickerPrice{
+		Price:  sdk.MustNewDecFromStr("1200"),
+		Volume: sdk.MustNewDecFromStr("1994674.34000000"),
+	}
+	umeeTickerPrice := types.TickerPrice{
+		Price:  sdk.MustNewDecFromStr("0.005"),
+		Volume: sdk.MustNewDecFromStr("1994674.34000000"),
+	}
+
+	providerPrices := provider.AggregatedProviderPrices{}
+	providerPairs := map[provider.Name][]types.CurrencyPair{}
+	for _, providerName := range []provider.Name{
+		provider.ProviderBinance,
+		provider.ProviderHuobi,
+		provider.ProviderKraken,
+	} {
+		providerPrices[providerName] = map[string]types.TickerPrice{
+			"ATOMUSD": atomTickerPrice,
+			"UMEEUSD": umeeTickerPrice,
+		}
+		providerPairs[providerName] = []types.CurrencyPair{
+			pair,
+			{Base: "UMEE", Quote: "USD"},
+		}
+	}
+
+	prices, err := GetComputedPrices(
+		zerolog.Nop(),
+		providerPrices,
+		providerPairs,
+		make(map[string]sdk.Dec),
+		"",
+		nil,
+		nil,
+	)
+	require.NoError(t, err)
+	require.Equal(t, atomTickerPrice.Price, prices["ATOM"])
+
+	bounds := map[string]types.PriceBound{
+		"ATOM": {
+			Min: sdk.MustNewDecFromStr("1"),
+			Max: sdk.MustNewDecFromStr("100"),
+		},
+		"UMEE": {
+			Magnitude: sdk.MustNewDecFromStr("0.01"),
+		},
+	}
+
+	filtered := FilterPriceBounds(zerolog.Nop(), prices, bounds)
+	_, ok := filtered["ATOM"]
+	require.False(t, ok, "The unanimous out of bounds ATOM price should be vetoed")
+	require.Equal(t, umeeTickerPrice.Price, filtered["UMEE"])
+
+	bounds["UMEE"] = types.PriceBound{Magnitude: sdk.MustNewDecFromStr("1")}
+	filtered = FilterPriceBounds(zerolog.Nop(), prices, bounds)
+	require.Empty(t, filtered)
+}
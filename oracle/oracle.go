@@ -26,6 +26,7 @@ import (
 
 	oracletypes "github.com/Team-Kujira/core/x/oracle/types"
 
+	"github.com/armon/go-metrics"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 )
 
@@ -35,6 +36,10 @@ import (
 // at least one block during each voting period.
 const (
 	tickerSleep = 1000 * time.Millisecond
+
+	// minimumProviders defines the amount of providers a denom requires
+	// unless the provider_min_override is enabled.
+	minimumProviders = 3
 )
 
 // PreviousPrevote defines a structure for defining the previous prevote
@@ -43,6 +48,9 @@ type PreviousPrevote struct {
 	ExchangeRates     string
 	Salt              string
 	SubmitBlockHeight int64
+	// Prices are the prevoted prices, which become the last voted prices
+	// for the slew limits once the vote is broadcast.
+	Prices map[string]sdk.Dec
 }
 
 func NewPreviousPrevote() *PreviousPrevote {
@@ -60,24 +68,45 @@ type Oracle struct {
 	logger zerolog.Logger
 	closer *pfsync.Closer
 
-	providerTimeout    time.Duration
-	providerPairs      map[provider.Name][]types.CurrencyPair
-	previousPrevote    *PreviousPrevote
-	previousVotePeriod float64
-	priceProviders     map[provider.Name]provider.Provider
-	oracleClient       client.OracleClient
-	deviations         map[string]sdk.Dec
-	endpoints          map[provider.Name]provider.Endpoint
-	history            history.PriceHistory
-	derivatives        map[string]derivative.Derivative
-	derivativePairs    map[string][]types.CurrencyPair
-	derivativeSymbols  map[string]struct{}
+	providerTimeout     time.Duration
+	providerPairs       map[provider.Name][]types.CurrencyPair
+	previousPrevote     *PreviousPrevote
+	previousVotePeriod  float64
+	priceProviders      map[provider.Name]provider.Provider
+	baseProviders       map[string]map[provider.Name]struct{}
+	referenceProviders  map[provider.Name]struct{}
+	providerRoles       map[provider.Name]provider.Role
+	providerMinOverride bool
+	skipFailedProviders bool
+	oracleClient        client.OracleClient
+	deviations          map[string]sdk.Dec
+	deviationFilter     string
+	priceBounds         map[string]types.PriceBound
+	quotePriority       []string
+	endpoints           map[provider.Name]provider.Endpoint
+	history             history.PriceHistory
+	derivatives         map[string]derivative.Derivative
+	derivativePairs     map[string][]types.CurrencyPair
+	derivativeSymbols   map[string]struct{}
+	priceUnits          map[string]types.PriceUnit
+	slewLimits          map[string]sdk.Dec
+	aggregations        map[string]Aggregation
+	lastVotedPrices     map[string]sdk.Dec
+	lastVotedPeriod     float64
 
 	mtx             sync.RWMutex
 	lastPriceSyncTS time.Time
 	prices          map[string]sdk.Dec
+	spreads         map[string]sdk.Dec
 	paramCache      ParamCache
 	healthchecks    map[string]http.Client
+	quality         *providerQualityTracker
+	publishedDenoms map[string]struct{}
+	staleTickers    map[provider.Name]map[string]struct{}
+
+	// referenceDeviations holds the deviations of the reference-only
+	// providers from the computed prices by provider and base.
+	referenceDeviations map[provider.Name]map[string]sdk.Dec
 }
 
 func New(
@@ -86,19 +115,76 @@ func New(
 	currencyPairs []config.CurrencyPair,
 	providerTimeout time.Duration,
 	deviations map[string]sdk.Dec,
+	deviationFilter string,
+	priceBounds map[string]types.PriceBound,
+	quotePriority []string,
 	endpoints map[provider.Name]provider.Endpoint,
 	derivatives map[string]derivative.Derivative,
 	derivativePairs map[string][]types.CurrencyPair,
 	derivativeDenoms map[string]struct{},
 	healthchecksConfig []config.Healthchecks,
 	history history.PriceHistory,
+	providerMinOverride bool,
+	skipFailedProviders bool,
 ) *Oracle {
 	providerPairs := make(map[provider.Name][]types.CurrencyPair)
+	baseProviders := make(map[string]map[provider.Name]struct{})
+	roles := providerRoles(currencyPairs, endpoints)
+	referenceProviders := make(map[provider.Name]struct{})
+	for providerName, role := range roles {
+		if role == provider.RoleReferenceOnly {
+			referenceProviders[providerName] = struct{}{}
+		}
+	}
+	priceUnits := make(map[string]types.PriceUnit)
+	slewLimits := make(map[string]sdk.Dec)
+	aggregations := make(map[string]Aggregation)
 	for _, pair := range currencyPairs {
+		unit, err := types.ParsePriceUnit(pair.Unit)
+		if err != nil {
+			logger.Warn().
+				Str("unit", pair.Unit).
+				Msg("failed to parse price unit, using whole units")
+			unit = types.PriceUnitWhole
+		}
+		priceUnits[pair.Base] = unit
+		if pair.SlewLimit != "" {
+			slewLimit, err := sdk.NewDecFromStr(pair.SlewLimit)
+			if err != nil {
+				logger.Warn().
+					Str("slew_limit", pair.SlewLimit).
+					Msg("failed to parse slew limit, skipping configuration")
+			} else {
+				slewLimits[pair.Base] = slewLimit
+			}
+		}
+		if pair.Aggregation != "" {
+			aggregation := Aggregation{Mode: pair.Aggregation}
+			if pair.Trim != "" {
+				trim, err := sdk.NewDecFromStr(pair.Trim)
+				if err != nil {
+					logger.Warn().
+						Str("trim", pair.Trim).
+						Msg("failed to parse trim, using default")
+				} else {
+					aggregation.Trim = trim
+				}
+			}
+			symbol := types.CurrencyPair{Base: pair.Base, Quote: pair.Quote}.String()
+			aggregations[symbol] = aggregation
+		}
+		if _, ok := baseProviders[pair.Base]; !ok {
+			baseProviders[pair.Base] = make(map[provider.Name]struct{})
+		}
 		for _, provider := range pair.Providers {
+			// reference-only providers don't count towards the minimum providers
+			if _, ok := referenceProviders[provider]; !ok {
+				baseProviders[pair.Base][provider] = struct{}{}
+			}
 			providerPairs[provider] = append(providerPairs[provider], types.CurrencyPair{
 				Base:  pair.Base,
 				Quote: pair.Quote,
+				Unit:  unit,
 			})
 		}
 	}
@@ -116,21 +202,34 @@ func New(
 		}
 	}
 	return &Oracle{
-		logger:            logger.With().Str("module", "oracle").Logger(),
-		closer:            pfsync.NewCloser(),
-		oracleClient:      oc,
-		providerPairs:     providerPairs,
-		priceProviders:    make(map[provider.Name]provider.Provider),
-		previousPrevote:   nil,
-		providerTimeout:   providerTimeout,
-		deviations:        deviations,
-		paramCache:        ParamCache{},
-		endpoints:         endpoints,
-		healthchecks:      healthchecks,
-		derivatives:       derivatives,
-		derivativePairs:   derivativePairs,
-		derivativeSymbols: derivativeDenoms,
-		history:           history,
+		logger:              logger.With().Str("module", "oracle").Logger(),
+		closer:              pfsync.NewCloser(),
+		oracleClient:        oc,
+		providerPairs:       providerPairs,
+		priceProviders:      make(map[provider.Name]provider.Provider),
+		baseProviders:       baseProviders,
+		referenceProviders:  referenceProviders,
+		providerRoles:       roles,
+		providerMinOverride: providerMinOverride,
+		skipFailedProviders: skipFailedProviders,
+		previousPrevote:     nil,
+		providerTimeout:     providerTimeout,
+		deviations:          deviations,
+		deviationFilter:     deviationFilter,
+		priceBounds:         priceBounds,
+		quotePriority:       quotePriority,
+		paramCache:          ParamCache{},
+		endpoints:           endpoints,
+		healthchecks:        healthchecks,
+		derivatives:         derivatives,
+		derivativePairs:     derivativePairs,
+		derivativeSymbols:   derivativeDenoms,
+		priceUnits:          priceUnits,
+		slewLimits:          slewLimits,
+		aggregations:        aggregations,
+		lastVotedPrices:     make(map[string]sdk.Dec),
+		history:             history,
+		quality:             newProviderQualityTracker(qualityWindow, providerTimeout),
 	}
 }
 
@@ -177,7 +276,8 @@ func (o *Oracle) GetLastPriceSyncTimestamp() time.Time {
 }
 
 // GetPrices returns a copy of the current prices fetched from the oracle's
-// set of exchange rate providers.
+// set of exchange rate providers, limited by the slew limits as they are
+// voted.
 func (o *Oracle) GetPrices() sdk.DecCoins {
 	o.mtx.RLock()
 	defer o.mtx.RUnlock()
@@ -191,6 +291,46 @@ func (o *Oracle) GetPrices() sdk.DecCoins {
 	return prices
 }
 
+// GetSpreads returns a copy of the current volume weighted bid/ask spreads
+// of the denoms whose providers report them. They are only reported via the
+// API and telemetry, not voted.
+func (o *Oracle) GetSpreads() map[string]sdk.Dec {
+	o.mtx.RLock()
+	defer o.mtx.RUnlock()
+	spreads := make(map[string]sdk.Dec, len(o.spreads))
+	for denom, spread := range o.spreads {
+		spreads[denom] = spread
+	}
+
+	return spreads
+}
+
+// GetReferenceDeviations returns a copy of the current relative deviations of
+// the reference-only providers from the computed prices by provider and base.
+func (o *Oracle) GetReferenceDeviations() map[provider.Name]map[string]sdk.Dec {
+	o.mtx.RLock()
+	defer o.mtx.RUnlock()
+	deviations := make(map[provider.Name]map[string]sdk.Dec, len(o.referenceDeviations))
+	for providerName, bases := range o.referenceDeviations {
+		deviations[providerName] = make(map[string]sdk.Dec, len(bases))
+		for base, deviation := range bases {
+			deviations[providerName][base] = deviation
+		}
+	}
+
+	return deviations
+}
+
+// GetProviderQuality returns the data quality of all providers over the
+// recent oracle ticks.
+func (o *Oracle) GetProviderQuality() map[string]types.ProviderQuality {
+	qualities := map[string]types.ProviderQuality{}
+	for providerName, quality := range o.quality.Qualities() {
+		qualities[providerName.String()] = quality
+	}
+	return qualities
+}
+
 // SetPrices retrieves all the prices and candles from our set of providers as
 // determined in the config. If candles are available, uses TVWAP in order
 // to determine prices. If candles are not available, uses the most recent prices
@@ -200,17 +340,14 @@ func (o *Oracle) SetPrices(ctx context.Context) error {
 	g := new(errgroup.Group)
 	mtx := new(sync.Mutex)
 	requiredRates := make(map[string]struct{})
+	skippedProviders := make(map[provider.Name]struct{})
 	providerPrices := provider.AggregatedProviderPrices{}
+	qualitySamples := make(map[provider.Name]qualitySample)
 
 	for providerName, currencyPairs := range o.providerPairs {
 		providerName := providerName
 		currencyPairs := currencyPairs
 
-		priceProvider, err := o.getOrSetProvider(ctx, providerName)
-		if err != nil {
-			return err
-		}
-
 		for _, pair := range currencyPairs {
 			_, ok := requiredRates[pair.Base]
 			if !ok {
@@ -218,10 +355,30 @@ func (o *Oracle) SetPrices(ctx context.Context) error {
 			}
 		}
 
+		priceProvider, err := o.getOrSetProvider(ctx, providerName)
+		if err != nil {
+			if !o.skipFailedProviders {
+				return err
+			}
+			o.logger.Error().
+				Err(err).
+				Str("provider", providerName.String()).
+				Msg("skipping provider that failed to construct")
+			skippedProviders[providerName] = struct{}{}
+			qualitySamples[providerName] = qualitySample{Expected: len(currencyPairs)}
+			continue
+		}
+
 		g.Go(func() error {
 			prices := make(map[string]types.TickerPrice, 0)
 			ch := make(chan struct{})
 			errCh := make(chan error, 1)
+			startTime := time.Now()
+			addDownSample := func() {
+				mtx.Lock()
+				defer mtx.Unlock()
+				qualitySamples[providerName] = qualitySample{Expected: len(currencyPairs)}
+			}
 
 			go func() {
 				defer close(ch)
@@ -237,9 +394,11 @@ func (o *Oracle) SetPrices(ctx context.Context) error {
 			case <-ch:
 				break
 			case err := <-errCh:
+				addDownSample()
 				return err
 			case <-time.After(o.providerTimeout):
 				telemetry.IncrCounter(1, "failure", "provider", "type", "timeout")
+				addDownSample()
 				return fmt.Errorf("provider timed out: %s", providerName)
 			}
 
@@ -248,6 +407,19 @@ func (o *Oracle) SetPrices(ctx context.Context) error {
 			// e.g.: {ProviderKraken: {"ATOM": <price, volume>, ...}}
 			mtx.Lock()
 			defer mtx.Unlock()
+			missing := 0
+			for _, pair := range currencyPairs {
+				ticker, ok := prices[pair.String()]
+				if (!ok || ticker == types.TickerPrice{}) {
+					missing++
+				}
+			}
+			qualitySamples[providerName] = qualitySample{
+				Up:       true,
+				Latency:  time.Since(startTime),
+				Expected: len(currencyPairs),
+				Missing:  missing,
+			}
 			for _, pair := range currencyPairs {
 				ticker, ok := prices[pair.String()]
 				if (!ok || ticker == types.TickerPrice{}) {
@@ -275,6 +447,17 @@ func (o *Oracle) SetPrices(ctx context.Context) error {
 		o.logger.Debug().Err(err).Msg("failed to get ticker prices from provider")
 	}
 
+	o.staleTickers = telemetryStaleTickers(providerPrices, o.staleTickers)
+
+	deviating := countDeviatingTickers(providerPrices, o.deviations, o.deviationFilter)
+	for providerName, sample := range qualitySamples {
+		sample.Deviating = deviating[providerName]
+		o.quality.Add(providerName, sample)
+	}
+	for providerName, quality := range o.quality.Qualities() {
+		telemetryProviderQuality(providerName, quality)
+	}
+
 	for name, pairs := range o.derivativePairs {
 		pairsMap := map[string]types.TickerPrice{}
 		for _, pair := range pairs {
@@ -289,16 +472,50 @@ func (o *Oracle) SetPrices(ctx context.Context) error {
 		providerPrices["_derivative"] = pairsMap
 	}
 
-	computedPrices, err := GetComputedPrices(
+	// reference-only providers are excluded from the vote, but still included
+	// in the deviation monitoring, while fallback providers are only voted for
+	// bases the other providers don't price
+	votePrices, referencePrices := splitReferencePrices(providerPrices, o.providerPairs, o.providerRoles)
+
+	// the outliers are removed once, so the spreads only cover the tickers
+	// the prices are computed from
+	filteredPrices, err := FilterTickerDeviations(
 		o.logger,
-		providerPrices,
-		o.providerPairs,
+		votePrices,
 		o.deviations,
+		o.deviationFilter,
+	)
+	if err != nil {
+		return err
+	}
+
+	computedPrices, err := convertFilteredTickersToUSD(
+		o.logger,
+		filteredPrices,
+		o.providerPairs,
+		o.quotePriority,
+		o.aggregations,
 	)
 	if err != nil {
 		return err
 	}
 
+	for base := range computedPrices {
+		if !o.hasMinimumProviders(base, skippedProviders) {
+			o.logger.Error().
+				Str("base", base).
+				Msg("not enough constructed providers, skipping price")
+			delete(computedPrices, base)
+		}
+	}
+
+	// the plausibility bounds run after the deviation filter since they must
+	// catch prices all providers agree on
+	computedPrices = FilterPriceBounds(o.logger, computedPrices, o.priceBounds)
+
+	referenceDeviations := ComputeReferenceDeviations(computedPrices, referencePrices, o.providerPairs)
+	telemetryReferenceDeviations(referenceDeviations)
+
 	if len(computedPrices) != len(requiredRates) {
 		missingPrices := []string{}
 		for base := range requiredRates {
@@ -312,11 +529,73 @@ func (o *Oracle) SetPrices(ctx context.Context) error {
 		)
 	}
 
-	o.prices = computedPrices
+	spreads := ComputeSpreads(filteredPrices, o.providerPairs)
+	for denom, spread := range spreads {
+		telemetry.SetGaugeWithLabels(
+			[]string{"spread"},
+			float32(spread.MustFloat64()),
+			[]metrics.Label{telemetry.NewLabel("denom", denom)},
+		)
+	}
+
+	o.prices = ApplySlewLimits(
+		o.logger,
+		NormalizePriceUnits(computedPrices, o.priceUnits),
+		o.lastVotedPrices,
+		o.slewLimits,
+	)
+	o.publishedDenoms = telemetryPrices(
+		o.prices,
+		computePriceDeviations(providerPrices, o.providerPairs),
+		o.publishedDenoms,
+	)
+	o.spreads = spreads
+	o.referenceDeviations = referenceDeviations
 
 	return nil
 }
 
+// hasMinimumProviders returns whether enough of the providers configured for
+// the base were constructed to safely compute its price.
+func (o *Oracle) hasMinimumProviders(base string, skippedProviders map[provider.Name]struct{}) bool {
+	if o.providerMinOverride || len(skippedProviders) == 0 {
+		return true
+	}
+
+	constructed := 0
+	for providerName := range o.baseProviders[base] {
+		if _, ok := skippedProviders[providerName]; !ok {
+			constructed++
+		}
+	}
+
+	// denoms configured with less providers, ex.: derivatives or the mock
+	// provider, require all of them
+	required := len(o.baseProviders[base])
+	if required > minimumProviders {
+		required = minimumProviders
+	}
+
+	return constructed >= required
+}
+
+// NormalizePriceUnits converts the computed prices, which are always expressed
+// per whole token, into the price unit configured for each denom.
+func NormalizePriceUnits(
+	prices map[string]sdk.Dec,
+	units map[string]types.PriceUnit,
+) map[string]sdk.Dec {
+	normalized := make(map[string]sdk.Dec, len(prices))
+	for denom, price := range prices {
+		unit, ok := units[denom]
+		if !ok {
+			unit = types.PriceUnitWhole
+		}
+		normalized[denom] = types.ConvertPrice(price, types.PriceUnitWhole, unit)
+	}
+	return normalized
+}
+
 // GetComputedPrices gets the candle and ticker prices and computes it.
 // It returns candles' TVWAP if possible, if not possible (not available
 // or due to some staleness) it will use the most recent ticker prices
@@ -326,12 +605,18 @@ func GetComputedPrices(
 	providerPrices provider.AggregatedProviderPrices,
 	providerPairs map[provider.Name][]types.CurrencyPair,
 	deviations map[string]sdk.Dec,
+	deviationFilter string,
+	quotePriority []string,
+	aggregations map[string]Aggregation,
 ) (prices map[string]sdk.Dec, err error) {
 	rates, err := convertTickersToUSD(
 		logger,
 		providerPrices,
 		providerPairs,
 		deviations,
+		deviationFilter,
+		quotePriority,
+		aggregations,
 	)
 	if err != nil {
 		return nil, err
@@ -420,54 +705,200 @@ func NewProvider(
 	providerLogger := logger.With().Str("provider", providerName.String()).Logger()
 	switch providerName {
 
+	case provider.ProviderAerodrome:
+		return provider.NewAerodromeProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderAlphaVantage:
+		return provider.NewAlphaVantageProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderAscendex:
+		return provider.NewAscendexProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderAstroport:
+		return provider.NewAstroportProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderAstrovault:
+		return provider.NewAstrovaultProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderBackpack:
+		return provider.NewBackpackProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderBalancer:
+		return provider.NewBalancerProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderBand:
+		return provider.NewBandProvider(ctx, providerLogger, endpoint, providerPairs...)
 	case provider.ProviderBinance, provider.ProviderBinanceUS:
 		return provider.NewBinanceProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderBinanceFutures:
+		return provider.NewBinanceFuturesProvider(ctx, providerLogger, endpoint, providerPairs...)
 	case provider.ProviderBitfinex:
 		return provider.NewBitfinexProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderBitflyer:
+		return provider.NewBitflyerProvider(ctx, providerLogger, endpoint, providerPairs...)
 	case provider.ProviderBitget:
 		return provider.NewBitgetProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderBithumb:
+		return provider.NewBithumbProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderBitstamp:
+		return provider.NewBitstampProvider(ctx, providerLogger, endpoint, providerPairs...)
 	case provider.ProviderBkex:
 		return provider.NewBkexProvider(ctx, providerLogger, endpoint, providerPairs...)
 	case provider.ProviderBitmart:
 		return provider.NewBitmartProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderBtse:
+		return provider.NewBtseProvider(ctx, providerLogger, endpoint, providerPairs...)
 	case provider.ProviderBybit:
 		return provider.NewBybitProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderCamelot:
+		return provider.NewCamelotProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderCfBenchmarks:
+		return provider.NewCfBenchmarksProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderChainlink:
+		return provider.NewChainlinkProvider(ctx, providerLogger, endpoint, providerPairs...)
 	case provider.ProviderCoinbase:
 		return provider.NewCoinbaseProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderCoinGecko:
+		return provider.NewCoinGeckoProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderCoinMarketCap:
+		return provider.NewCoinMarketCapProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderCosmwasmPool:
+		return provider.NewCosmwasmPoolProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderCrescent:
+		return provider.NewCrescentProvider(ctx, providerLogger, endpoint, providerPairs...)
 	case provider.ProviderCrypto:
 		return provider.NewCryptoProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderCryptoCompare:
+		return provider.NewCryptoCompareProvider(ctx, providerLogger, endpoint, providerPairs...)
 	case provider.ProviderCurve:
 		return provider.NewCurveProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderCurvePools:
+		return provider.NewCurvePoolsProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderDemex:
+		return provider.NewDemexProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderDeribit:
+		return provider.NewDeribitProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderDexter:
+		return provider.NewDexterProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderDia:
+		return provider.NewDiaProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderDrift:
+		return provider.NewDriftProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderDydx:
+		return provider.NewDydxProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderEcb:
+		return provider.NewEcbProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderEvmCall:
+		return provider.NewEvmCallProvider(ctx, providerLogger, endpoint, providerPairs...)
 	case provider.ProviderFin:
 		return provider.NewFinProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderFinRPC:
+		return provider.NewFinRPCProvider(ctx, providerLogger, endpoint, providerPairs...)
 	case provider.ProviderFinUsk:
 		return provider.NewFinUskProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderFixer:
+		return provider.NewFixerProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderFrankfurter:
+		return provider.NewFrankfurterProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderFx:
+		return provider.NewFxProvider(ctx, providerLogger, endpoint, providerPairs...)
 	case provider.ProviderGate:
 		return provider.NewGateProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderGemini:
+		return provider.NewGeminiProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderGmx:
+		return provider.NewGmxProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderGraphQL:
+		return provider.NewGraphQLProvider(ctx, providerLogger, endpoint, providerPairs...)
 	case provider.ProviderHitBtc:
 		return provider.NewHitBtcProvider(ctx, providerLogger, endpoint, providerPairs...)
 	case provider.ProviderHuobi:
 		return provider.NewHuobiProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderHyperliquid:
+		return provider.NewHyperliquidProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderInjective:
+		return provider.NewInjectiveProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderJupiter:
+		return provider.NewJupiterProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderKaiko:
+		return provider.NewKaikoProvider(ctx, providerLogger, endpoint, providerPairs...)
 	case provider.ProviderKraken:
 		return provider.NewKrakenProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderKrakenFutures:
+		return provider.NewKrakenFuturesProvider(ctx, providerLogger, endpoint, providerPairs...)
 	case provider.ProviderKucoin:
 		return provider.NewKucoinProvider(ctx, providerLogger, endpoint, providerPairs...)
 	case provider.ProviderLbank:
 		return provider.NewLbankProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderLevana:
+		return provider.NewLevanaProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderLido:
+		return provider.NewLidoProvider(ctx, providerLogger, endpoint, providerPairs...)
 	case provider.ProviderMexc:
 		return provider.NewMexcProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderMilkyway:
+		return provider.NewMilkywayProvider(ctx, providerLogger, endpoint, providerPairs...)
 	case provider.ProviderMock:
 		return provider.NewMockProvider(ctx, providerLogger, endpoint, providerPairs...)
 	case provider.ProviderOkx:
 		return provider.NewOkxProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderOkxIndex:
+		return provider.NewOkxIndexProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderOpenExchangeRates:
+		return provider.NewOpenExchangeRatesProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderOraidex:
+		return provider.NewOraidexProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderOrca:
+		return provider.NewOrcaProvider(ctx, providerLogger, endpoint, providerPairs...)
 	case provider.ProviderOsmosis:
 		return provider.NewOsmosisProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderOsmosisRPC:
+		return provider.NewOsmosisRPCProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderOsmosisTwap:
+		return provider.NewOsmosisTwapProvider(ctx, providerLogger, endpoint, providerPairs...)
 	case provider.ProviderOsmosisV2:
 		return provider.NewOsmosisV2Provider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderPancake:
+		return provider.NewPancakeProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderPersistence:
+		return provider.NewPersistenceProvider(ctx, providerLogger, endpoint, providerPairs...)
 	case provider.ProviderPhemex:
 		return provider.NewPhemexProvider(ctx, providerLogger, endpoint, providerPairs...)
 	case provider.ProviderPoloniex:
 		return provider.NewPoloniexProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderProbit:
+		return provider.NewProbitProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderPstake:
+		return provider.NewPstakeProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderPyth:
+		return provider.NewPythProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderRaydium:
+		return provider.NewRaydiumProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderRedstone:
+		return provider.NewRedstoneProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderRestJSON:
+		return provider.NewRestJSONProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderShade:
+		return provider.NewShadeProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderStride:
+		return provider.NewStrideProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderSushi:
+		return provider.NewSushiProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderSwissquote:
+		return provider.NewSwissquoteProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderTerraswap:
+		return provider.NewTerraswapProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderThorchain:
+		return provider.NewThorchainProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderTraderJoe:
+		return provider.NewTraderJoeProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderTreasury:
+		return provider.NewTreasuryProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderUniswapV2:
+		return provider.NewUniswapV2Provider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderUniswapV3:
+		return provider.NewUniswapV3Provider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderUpbit:
+		return provider.NewUpbitProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderVelodrome:
+		return provider.NewVelodromeProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderWhitebit:
+		return provider.NewWhitebitProvider(ctx, providerLogger, endpoint, providerPairs...)
+	case provider.ProviderWhiteWhale:
+		return provider.NewWhiteWhaleProvider(ctx, providerLogger, endpoint, providerPairs...)
 	case provider.ProviderXt:
 		return provider.NewXtProvider(ctx, providerLogger, endpoint, providerPairs...)
 	case provider.ProviderZero:
@@ -527,6 +958,8 @@ func (o *Oracle) tick(ctx context.Context) error {
 		return nil
 	}
 
+	o.expireLastVotedPrices(currentVotePeriod)
+
 	if err := o.SetPrices(ctx); err != nil {
 		return err
 	}
@@ -553,7 +986,8 @@ func (o *Oracle) tick(ctx context.Context) error {
 		return err
 	}
 
-	exchangeRatesStr := GenerateExchangeRatesString(o.GetPrices())
+	prices := o.GetPrices()
+	exchangeRatesStr := GenerateExchangeRatesString(prices)
 	hash := oracletypes.GetAggregateVoteHash(salt, exchangeRatesStr, valAddr)
 	preVoteMsg := &oracletypes.MsgAggregateExchangeRatePrevote{
 		Hash:      hash.String(), // hash of prices from the oracle
@@ -586,6 +1020,10 @@ func (o *Oracle) tick(ctx context.Context) error {
 			Salt:              salt,
 			ExchangeRates:     exchangeRatesStr,
 			SubmitBlockHeight: currentHeight,
+			Prices:            make(map[string]sdk.Dec, len(prices)),
+		}
+		for _, price := range prices {
+			o.previousPrevote.Prices[price.Denom] = price.Amount
 		}
 	} else {
 		// otherwise, we're in the next voting period and thus we vote
@@ -609,6 +1047,10 @@ func (o *Oracle) tick(ctx context.Context) error {
 			return err
 		}
 
+		// denoms abstained from in this vote are no longer slew limited
+		o.lastVotedPrices = o.previousPrevote.Prices
+		o.lastVotedPeriod = currentVotePeriod
+
 		o.previousPrevote = nil
 		o.previousVotePeriod = 0
 		o.healthchecksPing()
@@ -627,6 +1069,57 @@ func (o *Oracle) healthchecksPing() {
 	}
 }
 
+// expireLastVotedPrices drops the last voted prices unless they were voted
+// in the current or the previous vote period, so a denom returning after a
+// longer pause isn't clamped against an outdated price for many periods.
+func (o *Oracle) expireLastVotedPrices(currentVotePeriod float64) {
+	if len(o.lastVotedPrices) > 0 && currentVotePeriod-o.lastVotedPeriod > 1 {
+		o.logger.Debug().
+			Float64("last_voted_period", o.lastVotedPeriod).
+			Msg("last voted prices expired")
+		o.lastVotedPrices = make(map[string]sdk.Dec)
+	}
+}
+
+// ApplySlewLimits clamps each price with a slew limit to at most the limit's
+// relative change from its last voted price, ex.: with a limit of 0.05, a
+// price of 120 after a last vote of 100 is voted as 105. Subsequent rounds
+// keep moving towards the aggregated price at the same rate.
+func ApplySlewLimits(
+	logger zerolog.Logger,
+	prices map[string]sdk.Dec,
+	lastVotedPrices map[string]sdk.Dec,
+	slewLimits map[string]sdk.Dec,
+) map[string]sdk.Dec {
+	limited := make(map[string]sdk.Dec, len(prices))
+	for denom, price := range prices {
+		amount := price
+		slewLimit, hasLimit := slewLimits[denom]
+		lastVoted, hasVoted := lastVotedPrices[denom]
+		if hasLimit && hasVoted {
+			lower := lastVoted.Mul(sdk.OneDec().Sub(slewLimit))
+			upper := lastVoted.Mul(sdk.OneDec().Add(slewLimit))
+			if amount.LT(lower) {
+				amount = lower
+			} else if amount.GT(upper) {
+				amount = upper
+			}
+			if !amount.Equal(price) {
+				logger.Info().
+					Str("denom", denom).
+					Str("price", price.String()).
+					Str("last_voted", lastVoted.String()).
+					Str("limited", amount.String()).
+					Msg("price change exceeds slew limit")
+			}
+		}
+		if amount.IsPositive() {
+			limited[denom] = amount
+		}
+	}
+	return limited
+}
+
 // GenerateSalt generates a random salt, size length/2,  as a HEX encoded string.
 func GenerateSalt(length int) (string, error) {
 	if length == 0 {
@@ -93,6 +93,9 @@ func (ots *OracleTestSuite) SetupSuite() {
 		},
 		time.Millisecond*100,
 		make(map[string]sdk.Dec),
+		"",
+		make(map[string]types.PriceBound),
+		[]string{},
 		make(map[provider.Name]provider.Endpoint),
 		map[string]derivative.Derivative{},
 		map[string][]types.CurrencyPair{},
@@ -101,6 +104,8 @@ func (ots *OracleTestSuite) SetupSuite() {
 			{URL: "https://hc-ping.com/HEALTHCHECK-UUID", Timeout: "200ms"},
 		},
 		history,
+		false,
+		false,
 	)
 }
 
@@ -405,6 +410,9 @@ func TestSuccessGetComputedPricesTickers(t *testing.T) {
 		providerPrices,
 		providerPair,
 		make(map[string]sdk.Dec),
+		"",
+		nil,
+		nil,
 	)
 
 	require.NoError(t, err, "It should successfully get computed ticker prices")
@@ -482,6 +490,9 @@ func TestGetComputedPricesTickersConversion(t *testing.T) {
 		providerPrices,
 		providerPair,
 		make(map[string]sdk.Dec),
+		"",
+		nil,
+		nil,
 	)
 
 	require.NoError(t, err,
@@ -493,3 +504,310 @@ func TestGetComputedPricesTickersConversion(t *testing.T) {
 		prices[btcPair.Base],
 	)
 }
+
+func TestSetPrices_ProviderConstructionFailure(t *testing.T) {
+	history, err := history.NewPriceHistory(":memory:", zerolog.Nop())
+	require.NoError(t, err)
+
+	atomTicker := types.TickerPrice{
+		Price:  sdk.MustNewDecFromStr("10"),
+		Volume: sdk.MustNewDecFromStr("1000"),
+		Time:   time.Now(),
+	}
+	broken := provider.Name("broken")
+
+	newOracle := func(providers []provider.Name, skipFailedProviders bool) *Oracle {
+		o := New(
+			zerolog.Nop(),
+			client.OracleClient{},
+			[]config.CurrencyPair{
+				{
+					Base:      "ATOM",
+					Quote:     "USD",
+					Providers: providers,
+				},
+			},
+			time.Millisecond*100,
+			make(map[string]sdk.Dec),
+			"",
+			make(map[string]types.PriceBound),
+			[]string{},
+			make(map[provider.Name]provider.Endpoint),
+			map[string]derivative.Derivative{},
+			map[string][]types.CurrencyPair{},
+			map[string]struct{}{},
+			[]config.Healthchecks{},
+			history,
+			false,
+			skipFailedProviders,
+		)
+		for _, providerName := range providers {
+			if providerName == broken {
+				continue
+			}
+			o.priceProviders[providerName] = mockProvider{
+				prices: map[string]types.TickerPrice{"ATOMUSD": atomTicker},
+			}
+		}
+		return o
+	}
+
+	providers := []provider.Name{
+		provider.ProviderBinance,
+		provider.ProviderKraken,
+		provider.ProviderHuobi,
+		broken,
+	}
+
+	// fail-fast
+	o := newOracle(providers, false)
+	require.ErrorContains(t, o.SetPrices(context.TODO()), "provider broken not found")
+	require.Empty(t, o.GetPrices())
+
+	// skip-and-continue
+	o = newOracle(providers, true)
+	require.NoError(t, o.SetPrices(context.TODO()))
+	require.Equal(t, atomTicker.Price, o.GetPrices().AmountOf("ATOM"))
+
+	// skipping the broken provider leaves less than the minimum providers
+	o = newOracle(providers[1:], true)
+	require.NoError(t, o.SetPrices(context.TODO()))
+	require.Empty(t, o.GetPrices())
+}
+
+func TestSetPrices_ReferenceOnlyProvider(t *testing.T) {
+	history, err := history.NewPriceHistory(":memory:", zerolog.Nop())
+	require.NoError(t, err)
+
+	providers := []provider.Name{
+		provider.ProviderBinance,
+		provider.ProviderKraken,
+		provider.ProviderHuobi,
+		provider.ProviderBitget,
+	}
+
+	o := New(
+		zerolog.Nop(),
+		client.OracleClient{},
+		[]config.CurrencyPair{
+			{
+				Base:      "ATOM",
+				Quote:     "USD",
+				Providers: providers,
+			},
+		},
+		time.Millisecond*100,
+		// a wide threshold keeps the reference price from being filtered
+		map[string]sdk.Dec{"ATOMUSD": sdk.MustNewDecFromStr("5")},
+		"",
+		make(map[string]types.PriceBound),
+		[]string{},
+		map[provider.Name]provider.Endpoint{
+			provider.ProviderBitget: {
+				Name: provider.ProviderBitget,
+				Role: provider.RoleReferenceOnly,
+			},
+		},
+		map[string]derivative.Derivative{},
+		map[string][]types.CurrencyPair{},
+		map[string]struct{}{},
+		[]config.Healthchecks{},
+		history,
+		false,
+		false,
+	)
+	for _, providerName := range providers {
+		price := sdk.MustNewDecFromStr("10")
+		if providerName == provider.ProviderBitget {
+			price = sdk.MustNewDecFromStr("20")
+		}
+		o.priceProviders[providerName] = mockProvider{
+			prices: map[string]types.TickerPrice{
+				"ATOMUSD": {
+					Price:  price,
+					Volume: sdk.MustNewDecFromStr("1000"),
+					Time:   time.Now(),
+				},
+			},
+		}
+	}
+
+	require.NoError(t, o.SetPrices(context.TODO()))
+	require.Equal(t, sdk.MustNewDecFromStr("10"), o.GetPrices().AmountOf("ATOM"))
+
+	// the reference-only provider is still monitored
+	deviations := o.GetReferenceDeviations()
+	require.Len(t, deviations, 1)
+	require.Equal(t, sdk.OneDec(), deviations[provider.ProviderBitget]["ATOM"])
+}
+
+func TestSetPrices_SpreadsExcludeDeviatingProviders(t *testing.T) {
+	history, err := history.NewPriceHistory(":memory:", zerolog.Nop())
+	require.NoError(t, err)
+
+	providers := []provider.Name{
+		provider.ProviderBinance,
+		provider.ProviderKraken,
+		provider.ProviderHuobi,
+		provider.ProviderBitget,
+	}
+
+	o := New(
+		zerolog.Nop(),
+		client.OracleClient{},
+		[]config.CurrencyPair{
+			{
+				Base:      "ATOM",
+				Quote:     "USD",
+				Providers: providers,
+			},
+		},
+		time.Millisecond*100,
+		make(map[string]sdk.Dec),
+		"",
+		make(map[string]types.PriceBound),
+		[]string{},
+		map[provider.Name]provider.Endpoint{},
+		map[string]derivative.Derivative{},
+		map[string][]types.CurrencyPair{},
+		map[string]struct{}{},
+		[]config.Healthchecks{},
+		history,
+		false,
+		false,
+	)
+	for _, providerName := range providers {
+		price := sdk.MustNewDecFromStr("10")
+		spread := sdk.MustNewDecFromStr("0.001")
+		if providerName == provider.ProviderBitget {
+			price = sdk.MustNewDecFromStr("20")
+			spread = sdk.MustNewDecFromStr("0.5")
+		}
+		o.priceProviders[providerName] = mockProvider{
+			prices: map[string]types.TickerPrice{
+				"ATOMUSD": {
+					Price:  price,
+					Volume: sdk.MustNewDecFromStr("1000"),
+					Time:   time.Now(),
+					Spread: spread,
+				},
+			},
+		}
+	}
+
+	// the deviating provider is rejected from both the price and the spread
+	require.NoError(t, o.SetPrices(context.TODO()))
+	require.Equal(t, sdk.MustNewDecFromStr("10"), o.GetPrices().AmountOf("ATOM"))
+	require.Equal(t, sdk.MustNewDecFromStr("0.001"), o.GetSpreads()["ATOM"])
+}
+
+func TestSetPrices_SlewLimit(t *testing.T) {
+	history, err := history.NewPriceHistory(":memory:", zerolog.Nop())
+	require.NoError(t, err)
+
+	providers := []provider.Name{
+		provider.ProviderBinance,
+		provider.ProviderKraken,
+		provider.ProviderHuobi,
+	}
+
+	o := New(
+		zerolog.Nop(),
+		client.OracleClient{},
+		[]config.CurrencyPair{
+			{
+				Base:      "ATOM",
+				Quote:     "USD",
+				Providers: providers,
+				SlewLimit: "0.05",
+			},
+		},
+		time.Millisecond*100,
+		make(map[string]sdk.Dec),
+		"",
+		make(map[string]types.PriceBound),
+		[]string{},
+		make(map[provider.Name]provider.Endpoint),
+		map[string]derivative.Derivative{},
+		map[string][]types.CurrencyPair{},
+		map[string]struct{}{},
+		[]config.Healthchecks{},
+		history,
+		false,
+		false,
+	)
+	for _, providerName := range providers {
+		o.priceProviders[providerName] = mockProvider{
+			prices: map[string]types.TickerPrice{
+				"ATOMUSD": {
+					Price:  sdk.MustNewDecFromStr("120"),
+					Volume: sdk.MustNewDecFromStr("1000"),
+					Time:   time.Now(),
+				},
+			},
+		}
+	}
+	o.lastVotedPrices = map[string]sdk.Dec{"ATOM": sdk.MustNewDecFromStr("100")}
+	o.lastVotedPeriod = 10
+
+	// the prices reported by the oracle are the limited prices being voted
+	o.expireLastVotedPrices(11)
+	require.NoError(t, o.SetPrices(context.TODO()))
+	require.Equal(t, sdk.MustNewDecFromStr("105"), o.GetPrices().AmountOf("ATOM"))
+
+	// prices not voted in the previous vote period no longer limit the price
+	o.expireLastVotedPrices(12)
+	require.Empty(t, o.lastVotedPrices)
+	require.NoError(t, o.SetPrices(context.TODO()))
+	require.Equal(t, sdk.MustNewDecFromStr("120"), o.GetPrices().AmountOf("ATOM"))
+}
+
+func TestApplySlewLimits(t *testing.T) {
+	lastVoted := map[string]sdk.Dec{
+		"ATOM": sdk.MustNewDecFromStr("100"),
+		"UMEE": sdk.MustNewDecFromStr("1"),
+	}
+	slewLimits := map[string]sdk.Dec{
+		"ATOM": sdk.MustNewDecFromStr("0.05"),
+		"OSMO": sdk.MustNewDecFromStr("0.05"),
+	}
+
+	// within the slew limit
+	prices := ApplySlewLimits(
+		zerolog.Nop(),
+		map[string]sdk.Dec{"ATOM": sdk.MustNewDecFromStr("103")},
+		lastVoted,
+		slewLimits,
+	)
+	require.Equal(t, sdk.MustNewDecFromStr("103"), prices["ATOM"])
+
+	// beyond the slew limit in both directions
+	prices = ApplySlewLimits(
+		zerolog.Nop(),
+		map[string]sdk.Dec{"ATOM": sdk.MustNewDecFromStr("120")},
+		lastVoted,
+		slewLimits,
+	)
+	require.Equal(t, sdk.MustNewDecFromStr("105"), prices["ATOM"])
+
+	prices = ApplySlewLimits(
+		zerolog.Nop(),
+		map[string]sdk.Dec{"ATOM": sdk.MustNewDecFromStr("50")},
+		lastVoted,
+		slewLimits,
+	)
+	require.Equal(t, sdk.MustNewDecFromStr("95"), prices["ATOM"])
+
+	// denoms without a limit or a previous vote are not limited
+	prices = ApplySlewLimits(
+		zerolog.Nop(),
+		map[string]sdk.Dec{
+			"UMEE": sdk.MustNewDecFromStr("2"),
+			"OSMO": sdk.MustNewDecFromStr("0.8"),
+		},
+		lastVoted,
+		slewLimits,
+	)
+	require.Equal(t, sdk.MustNewDecFromStr("2"), prices["UMEE"])
+	require.Equal(t, sdk.MustNewDecFromStr("0.8"), prices["OSMO"])
+}
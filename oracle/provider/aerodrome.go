@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	"github.com/rs/zerolog"
+)
+
+const (
+	// aerodromeRouter defines the address of the Aerodrome router on Base.
+	aerodromeRouter = "0xcf77a3ba9a5ca399b7c97c74d54e5b1beb874e43"
+	// aerodromeFactory defines the address of the Aerodrome pool factory on
+	// Base.
+	aerodromeFactory = "0x420dd381b31aef6683db6b902084cb0ffece40da"
+)
+
+var (
+	_                         Provider = (*AerodromeProvider)(nil)
+	aerodromeDefaultEndpoints          = Endpoint{
+		Name:         ProviderAerodrome,
+		Urls:         []string{"https://mainnet.base.org"},
+		PollInterval: 15 * time.Second,
+	}
+)
+
+type (
+	// AerodromeProvider defines an oracle provider quoting Aerodrome pools on
+	// Base. Aerodrome is a fork of Velodrome V2, so the pools are quoted like
+	// the ones of the VelodromeProvider.
+	//
+	// REF: https://github.com/aerodrome-finance/contracts
+	AerodromeProvider struct {
+		VelodromeProvider
+	}
+)
+
+func NewAerodromeProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*AerodromeProvider, error) {
+	provider := &AerodromeProvider{
+		VelodromeProvider{
+			router:   aerodromeRouter,
+			factory:  aerodromeFactory,
+			decimals: newEvmDecimalsCache(),
+		},
+	}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		nil,
+		nil,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+)
+
+var (
+	_                            Provider = (*AlphaVantageProvider)(nil)
+	alphaVantageDefaultEndpoints          = Endpoint{
+		Name:         ProviderAlphaVantage,
+		Urls:         []string{"https://www.alphavantage.co"},
+		PollInterval: 30 * time.Second,
+	}
+)
+
+type (
+	// AlphaVantageProvider defines an oracle provider polling Alpha Vantage,
+	// which requires the `api_key` of the provider endpoints. Denoms mapped
+	// to a stock symbol by the `contracts` of the provider endpoints, ex.:
+	// {"TSLAX": "TSLA"}, are priced by the latest quote of the stock in USD
+	// and report its daily volume. All other pairs are priced by the real
+	// time exchange rate of their currencies and report a volume of one.
+	// Every pair is requested on every poll, which exceeds the quota of the
+	// free plan, so a premium key is required.
+	//
+	// REF: https://www.alphavantage.co/documentation
+	AlphaVantageProvider struct {
+		provider
+	}
+
+	AlphaVantageResponse struct {
+		ExchangeRate *AlphaVantageExchangeRate `json:"Realtime Currency Exchange Rate"`
+		GlobalQuote  *AlphaVantageGlobalQuote  `json:"Global Quote"`
+		Error        string                    `json:"Error Message"`
+		Note         string                    `json:"Note"`
+		Information  string                    `json:"Information"`
+	}
+
+	AlphaVantageExchangeRate struct {
+		Rate string `json:"5. Exchange Rate"` // ex.: "1.07230000"
+	}
+
+	AlphaVantageGlobalQuote struct {
+		Price  string `json:"05. price"`  // ex.: "187.4400"
+		Volume string `json:"06. volume"` // ex.: "60108400"
+	}
+)
+
+func NewAlphaVantageProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*AlphaVantageProvider, error) {
+	if endpoints.ApiKey == "" {
+		return nil, fmt.Errorf("%s requires an api key", ProviderAlphaVantage)
+	}
+
+	provider := &AlphaVantageProvider{}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		nil,
+		nil,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
+
+func (p *AlphaVantageProvider) Poll() error {
+	for symbol, pair := range p.pairs {
+		ticker, err := p.getTicker(pair)
+		if err != nil {
+			p.logger.Warn().Err(err).Str("pair", symbol).Msg("failed to get ticker")
+			continue
+		}
+
+		p.mtx.Lock()
+		p.tickers[symbol] = ticker
+		p.mtx.Unlock()
+	}
+
+	p.logger.Debug().Msg("updated tickers")
+	return nil
+}
+
+func (p *AlphaVantageProvider) getTicker(pair types.CurrencyPair) (types.TickerPrice, error) {
+	query := url.Values{}
+	stock, isStock := p.endpoints.Contracts[pair.Base]
+	if isStock {
+		if pair.Quote != "USD" {
+			return types.TickerPrice{}, fmt.Errorf("stocks are only quoted in USD")
+		}
+		query.Set("function", "GLOBAL_QUOTE")
+		query.Set("symbol", stock)
+	} else {
+		query.Set("function", "CURRENCY_EXCHANGE_RATE")
+		query.Set("from_currency", pair.Base)
+		query.Set("to_currency", pair.Quote)
+	}
+	query.Set("apikey", p.endpoints.ApiKey)
+
+	content, err := p.httpGet("/query?" + query.Encode())
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	var response AlphaVantageResponse
+	err = json.Unmarshal(content, &response)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	// errors and exceeded quotas are reported with a successful status
+	for _, message := range []string{response.Error, response.Note, response.Information} {
+		if message != "" {
+			return types.TickerPrice{}, fmt.Errorf("alphavantage: %s", message)
+		}
+	}
+
+	ticker := types.TickerPrice{
+		Volume: sdk.OneDec(),
+		Time:   time.Now(),
+	}
+	var price string
+	switch {
+	case isStock && response.GlobalQuote != nil && response.GlobalQuote.Price != "":
+		price = response.GlobalQuote.Price
+		// the volume is kept at one if it's missing
+		if volume, err := decFromString(response.GlobalQuote.Volume); err == nil {
+			ticker.Volume = volume
+		}
+	case !isStock && response.ExchangeRate != nil && response.ExchangeRate.Rate != "":
+		price = response.ExchangeRate.Rate
+	default:
+		return types.TickerPrice{}, fmt.Errorf("no quote received")
+	}
+
+	ticker.Price, err = decFromString(price)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	if !ticker.Price.IsPositive() {
+		return types.TickerPrice{}, fmt.Errorf("invalid price: %s", ticker.Price)
+	}
+	return ticker, nil
+}
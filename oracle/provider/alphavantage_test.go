@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAlphaVantageProvider_Poll(t *testing.T) {
+	server := newTestServer(func(rw http.ResponseWriter, req *http.Request) {
+		var response string
+		query := req.URL.Query()
+		switch query.Get("function") {
+		case "GLOBAL_QUOTE":
+			if query.Get("symbol") == "AAPL" {
+				response = `{"Global Quote":{"01. symbol":"AAPL","05. price":"189.9800","06. volume":""}}`
+				break
+			}
+			response = `{"Global Quote":{"01. symbol":"TSLA","05. price":"237.4100","06. volume":"132342400"}}`
+		case "CURRENCY_EXCHANGE_RATE":
+			if query.Get("from_currency") == "JPY" {
+				response = `{"Note":"Thank you for using Alpha Vantage! Our standard API rate limit is 25 requests per day."}`
+				break
+			}
+			response = `{"Realtime Currency Exchange Rate":{"1. From_Currency Code":"EUR","5. Exchange Rate":"1.07230000"}}`
+		}
+		_, _ = rw.Write([]byte(response))
+	})
+	defer server.Close()
+
+	tslaxUsd := types.CurrencyPair{Base: "TSLAX", Quote: "USD"}
+	eurUsd := types.CurrencyPair{Base: "EUR", Quote: "USD"}
+	jpyUsd := types.CurrencyPair{Base: "JPY", Quote: "USD"}
+	aaplxUsd := types.CurrencyPair{Base: "AAPLX", Quote: "USD"}
+
+	p := newTestProvider(t, NewAlphaVantageProvider, server, Endpoint{
+		Name:      ProviderAlphaVantage,
+		ApiKey:    "key",
+		Contracts: map[string]string{"TSLAX": "TSLA", "AAPLX": "AAPL"},
+	}, tslaxUsd, eurUsd, jpyUsd, aaplxUsd)
+
+	require.NoError(t, p.Poll())
+	require.Equal(t, sdk.MustNewDecFromStr("237.41"), p.tickers["TSLAXUSD"].Price)
+	require.Equal(t, sdk.NewDec(132342400), p.tickers["TSLAXUSD"].Volume)
+	require.Equal(t, sdk.MustNewDecFromStr("1.0723"), p.tickers["EURUSD"].Price)
+	require.Equal(t, sdk.OneDec(), p.tickers["EURUSD"].Volume)
+	require.NotContains(t, p.tickers, "JPYUSD")
+
+	// a missing volume falls back to one
+	require.Equal(t, sdk.MustNewDecFromStr("189.98"), p.tickers["AAPLXUSD"].Price)
+	require.Equal(t, sdk.OneDec(), p.tickers["AAPLXUSD"].Volume)
+
+	requests := server.Requests()
+	require.Len(t, requests, 4)
+	for _, req := range requests {
+		query := req.URL.Query()
+		require.Equal(t, "/query", req.URL.Path)
+		require.Equal(t, "key", query.Get("apikey"))
+		switch query.Get("function") {
+		case "GLOBAL_QUOTE":
+			require.Contains(t, []string{"TSLA", "AAPL"}, query.Get("symbol"))
+		case "CURRENCY_EXCHANGE_RATE":
+			require.Contains(t, []string{"EUR", "JPY"}, query.Get("from_currency"))
+			require.Equal(t, "USD", query.Get("to_currency"))
+		default:
+			t.Fatalf("unexpected function: %s", query.Get("function"))
+		}
+	}
+}
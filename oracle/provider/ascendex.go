@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+)
+
+var (
+	_                        Provider = (*AscendexProvider)(nil)
+	ascendexDefaultEndpoints          = Endpoint{
+		Name:         ProviderAscendex,
+		Urls:         []string{"https://ascendex.com"},
+		PollInterval: 3 * time.Second,
+	}
+)
+
+type (
+	// AscendexProvider defines an oracle provider implemented by the AscendEX
+	// public API.
+	//
+	// REF: https://ascendex.github.io/ascendex-pro-api/#ticker
+	AscendexProvider struct {
+		provider
+	}
+
+	// AscendexTickersResponse defines the tickers response, whose data is a
+	// single ticker object if only one symbol is requested and a list of
+	// tickers otherwise.
+	AscendexTickersResponse struct {
+		Code int64           `json:"code"` // ex.: 0
+		Data json.RawMessage `json:"data"`
+	}
+
+	AscendexTicker struct {
+		Symbol string    `json:"symbol"` // ex.: "ATOM/USDT"
+		Price  string    `json:"close"`  // ex.: "13.61"
+		Volume string    `json:"volume"` // ex.: "433812.95"
+		Bid    [2]string `json:"bid"`    // ex.: ["13.6", "150.1"]
+		Ask    [2]string `json:"ask"`    // ex.: ["13.62", "53.7"]
+	}
+)
+
+func NewAscendexProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*AscendexProvider, error) {
+	provider := &AscendexProvider{}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		nil,
+		nil,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
+
+func (p *AscendexProvider) Poll() error {
+	symbols := make([]string, 0, len(p.pairs))
+	for _, pair := range p.pairs {
+		symbols = append(symbols, p.CurrencyPairToProviderPair(pair))
+	}
+
+	content, err := p.httpGet("/api/pro/v1/spot/ticker?symbol=" + strings.Join(symbols, ","))
+	if err != nil {
+		return err
+	}
+
+	var tickers AscendexTickersResponse
+	err = json.Unmarshal(content, &tickers)
+	if err != nil {
+		return err
+	}
+
+	if tickers.Code != 0 {
+		return fmt.Errorf("invalid code: %d", tickers.Code)
+	}
+
+	data, err := tickers.tickers()
+	if err != nil {
+		return err
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	timestamp := time.Now()
+
+	for _, ticker := range data {
+		symbol := strings.ReplaceAll(ticker.Symbol, "/", "")
+		if _, ok := p.pairs[symbol]; !ok {
+			continue
+		}
+
+		price, err := decFromString(ticker.Price)
+		if err != nil {
+			p.logger.Warn().Err(err).Str("pair", symbol).Msg("failed to parse price")
+			continue
+		}
+		volume, err := decFromString(ticker.Volume)
+		if err != nil {
+			p.logger.Warn().Err(err).Str("pair", symbol).Msg("failed to parse volume")
+			continue
+		}
+
+		// the spread is left unset without a valid order book top
+		spread := sdk.Dec{}
+		bid, bidErr := decFromString(ticker.Bid[0])
+		ask, askErr := decFromString(ticker.Ask[0])
+		if bidErr == nil && askErr == nil {
+			spread = computeSpread(bid, ask)
+		}
+
+		p.tickers[symbol] = types.TickerPrice{
+			Price:  price,
+			Volume: volume,
+			Time:   timestamp,
+			Spread: spread,
+		}
+	}
+
+	p.logger.Debug().Msg("updated tickers")
+	return nil
+}
+
+// tickers decodes the data of the response as a list of tickers.
+func (r AscendexTickersResponse) tickers() ([]AscendexTicker, error) {
+	data := bytes.TrimSpace(r.Data)
+	if len(data) > 0 && data[0] == '{' {
+		var ticker AscendexTicker
+		if err := json.Unmarshal(data, &ticker); err != nil {
+			return nil, err
+		}
+		return []AscendexTicker{ticker}, nil
+	}
+
+	var tickers []AscendexTicker
+	if err := json.Unmarshal(data, &tickers); err != nil {
+		return nil, err
+	}
+	return tickers, nil
+}
+
+func (p *AscendexProvider) CurrencyPairToProviderPair(pair types.CurrencyPair) string {
+	return pair.Join("/")
+}
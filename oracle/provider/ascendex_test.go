@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAscendexProvider_Poll(t *testing.T) {
+	atomTicker := `{"symbol":"ATOM/USDT","close":"13.61","volume":"433812.95","bid":["13.6","150.1"],"ask":["13.62","53.7"]}`
+	osmoTicker := `{"symbol":"OSMO/USDT","close":"0.41","volume":"1000","bid":["0.4","10"],"ask":["0.42","10"]}`
+	junoTicker := `{"symbol":"JUNO/USDT","close":"0.3","volume":"","bid":["",""],"ask":["",""]}`
+
+	testCases := map[string]struct {
+		response string
+		pairs    []types.CurrencyPair
+		tickers  int
+	}{
+		"single symbol": {
+			response: `{"code":0,"data":` + atomTicker + `}`,
+			pairs:    []types.CurrencyPair{{Base: "ATOM", Quote: "USDT"}},
+			tickers:  1,
+		},
+		"several symbols": {
+			response: `{"code":0,"data":[` + atomTicker + `,` + osmoTicker + `]}`,
+			pairs: []types.CurrencyPair{
+				{Base: "ATOM", Quote: "USDT"},
+				{Base: "OSMO", Quote: "USDT"},
+			},
+			tickers: 2,
+		},
+		"unparsable volume": {
+			response: `{"code":0,"data":[` + atomTicker + `,` + junoTicker + `]}`,
+			pairs: []types.CurrencyPair{
+				{Base: "ATOM", Quote: "USDT"},
+				{Base: "JUNO", Quote: "USDT"},
+			},
+			tickers: 1,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			server := newTestServer(func(rw http.ResponseWriter, req *http.Request) {
+				_, _ = rw.Write([]byte(tc.response))
+			})
+			defer server.Close()
+
+			p := newTestProvider(t, NewAscendexProvider, server, Endpoint{
+				Name: ProviderAscendex,
+			}, tc.pairs...)
+
+			require.NoError(t, p.Poll())
+			requests := server.Requests()
+			require.Len(t, requests, 1)
+			require.Equal(t, "/api/pro/v1/spot/ticker", requests[0].URL.Path)
+			require.Len(t, p.tickers, tc.tickers)
+			require.Equal(t, sdk.MustNewDecFromStr("13.61"), p.tickers["ATOMUSDT"].Price)
+			require.Equal(t, sdk.MustNewDecFromStr("433812.95"), p.tickers["ATOMUSDT"].Volume)
+		})
+	}
+}
@@ -0,0 +1,244 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	"github.com/rs/zerolog"
+)
+
+var (
+	_                         Provider = (*AstroportProvider)(nil)
+	astroportDefaultEndpoints          = Endpoint{
+		Name:         ProviderAstroport,
+		Urls:         []string{"https://rest.cosmos.directory/terra2"},
+		PollInterval: 10 * time.Second,
+	}
+)
+
+type (
+	// AstroportProvider defines an oracle provider querying Astroport pair
+	// contracts directly using the REST endpoint of a node, so no indexer or
+	// hardcoded list of pairs is involved. The price is simulated for one base
+	// token and includes the spread and commission, which equals the spot
+	// price of the pool. The `contracts` of the provider endpoints map the
+	// denoms to their chain denoms or "cw20:" prefixed token addresses and the
+	// pairs to their pair contracts. The base reserve of the pool is reported
+	// as volume.
+	//
+	// REF: https://docs.astroport.fi/docs/develop/smart-contracts/pools/xyk-pair#simulation
+	AstroportProvider struct {
+		provider
+		// newSimulation returns the response of the simulation query of forks
+		// reporting different fees, ex.: White Whale
+		newSimulation func() cosmwasmSimulation
+		// factory resolves the pair contracts which aren't configured, unless
+		// it's configured as "factory" contract
+		factory       string
+		pairContracts map[string]string
+	}
+
+	AstroportPairResponse struct {
+		ContractAddr string `json:"contract_addr"` // ex.: "terra1fd68ah02gr2y8ze7tm9te7m70zlmc7vjyyhs6xlhsdmqqcjud4dql4wpxr"
+	}
+
+	AstroportPoolResponse struct {
+		Assets []CosmwasmAsset `json:"assets"`
+	}
+
+	AstroportSimulationResponse struct {
+		ReturnAmount     string `json:"return_amount"`     // ex.: "1214235"
+		SpreadAmount     string `json:"spread_amount"`     // ex.: "12"
+		CommissionAmount string `json:"commission_amount"` // ex.: "3653"
+	}
+
+	// cosmwasmSimulation defines the response of a simulation query, which
+	// returns the amounts making up the spot price of the simulated swap.
+	cosmwasmSimulation interface {
+		amounts() []string
+	}
+)
+
+func NewAstroportProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*AstroportProvider, error) {
+	provider := &AstroportProvider{}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		nil,
+		nil,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
+
+func (p *AstroportProvider) Poll() error {
+	for symbol, pair := range p.pairs {
+		ticker, err := p.getTicker(pair)
+		if err != nil {
+			p.logger.Warn().Err(err).Str("pair", symbol).Msg("failed to query pair")
+			continue
+		}
+
+		p.mtx.Lock()
+		p.tickers[symbol] = ticker
+		p.mtx.Unlock()
+	}
+
+	p.logger.Debug().Msg("updated tickers")
+	return nil
+}
+
+func (p *AstroportProvider) getTicker(pair types.CurrencyPair) (types.TickerPrice, error) {
+	contract, err := p.getPairContract(pair)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+	base, ok := p.endpoints.Contracts[pair.Base]
+	if !ok {
+		return types.TickerPrice{}, fmt.Errorf("no denom configured for %s", pair.Base)
+	}
+
+	return p.queryPair(
+		p.wasmQuery,
+		contract,
+		newCosmwasmAssetInfo(base),
+		nil,
+		p.cosmosDecimals(pair.Base),
+		p.cosmosDecimals(pair.Quote),
+	)
+}
+
+// queryPair returns the ticker of the pair contract using the query function,
+// which allows querying pairs on other nodes. The quote asset is optional and
+// only verified to be part of the pair.
+func (p *AstroportProvider) queryPair(
+	query wasmQueryFunc,
+	contract string,
+	baseInfo CosmwasmAssetInfo,
+	quoteInfo *CosmwasmAssetInfo,
+	baseDecimals int64,
+	quoteDecimals int64,
+) (types.TickerPrice, error) {
+	var pool AstroportPoolResponse
+	err := query(contract, map[string]interface{}{"pool": struct{}{}}, &pool)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	var reserve *big.Int
+	hasQuote := quoteInfo == nil
+	for _, asset := range pool.Assets {
+		if asset.Info.Equal(baseInfo) {
+			reserve, err = cosmosParseAmount(asset.Amount)
+			if err != nil {
+				return types.TickerPrice{}, err
+			}
+		}
+		if quoteInfo != nil && asset.Info.Equal(*quoteInfo) {
+			hasQuote = true
+		}
+	}
+	if reserve == nil || !hasQuote {
+		return types.TickerPrice{}, fmt.Errorf("pair %s doesn't contain the configured assets", contract)
+	}
+
+	// simulate swapping one whole base token
+	offerAmount := new(big.Int).Exp(big.NewInt(10), big.NewInt(baseDecimals), nil)
+	var simulation cosmwasmSimulation = &AstroportSimulationResponse{}
+	if p.newSimulation != nil {
+		simulation = p.newSimulation()
+	}
+	err = query(contract, map[string]interface{}{
+		"simulation": map[string]interface{}{
+			"offer_asset": CosmwasmAsset{
+				Info:   baseInfo,
+				Amount: offerAmount.String(),
+			},
+		},
+	}, simulation)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	amount := big.NewInt(0)
+	for _, value := range simulation.amounts() {
+		if value == "" {
+			continue
+		}
+		parsed, err := cosmosParseAmount(value)
+		if err != nil {
+			return types.TickerPrice{}, err
+		}
+		amount.Add(amount, parsed)
+	}
+
+	return types.TickerPrice{
+		Price:  bigIntToDec(amount, quoteDecimals),
+		Volume: bigIntToDec(reserve, baseDecimals),
+		Time:   time.Now(),
+	}, nil
+}
+
+// getPairContract returns the configured pair contract, or resolves it using
+// the factory and caches it.
+func (p *AstroportProvider) getPairContract(pair types.CurrencyPair) (string, error) {
+	if contract, ok := p.endpoints.Contracts[pair.String()]; ok {
+		return contract, nil
+	}
+	if contract, ok := p.pairContracts[pair.String()]; ok {
+		return contract, nil
+	}
+
+	factory, ok := p.endpoints.Contracts["factory"]
+	if !ok {
+		factory = p.factory
+	}
+	if factory == "" {
+		return "", fmt.Errorf("no contract configured for %s", pair.String())
+	}
+	base, ok := p.endpoints.Contracts[pair.Base]
+	if !ok {
+		return "", fmt.Errorf("no denom configured for %s", pair.Base)
+	}
+	quote, ok := p.endpoints.Contracts[pair.Quote]
+	if !ok {
+		return "", fmt.Errorf("no denom configured for %s", pair.Quote)
+	}
+
+	var response AstroportPairResponse
+	err := p.wasmQuery(factory, map[string]interface{}{
+		"pair": map[string]interface{}{
+			"asset_infos": []CosmwasmAssetInfo{
+				newCosmwasmAssetInfo(base),
+				newCosmwasmAssetInfo(quote),
+			},
+		},
+	}, &response)
+	if err != nil {
+		return "", err
+	}
+	if response.ContractAddr == "" {
+		return "", fmt.Errorf("no pair found for %s", pair.String())
+	}
+
+	if p.pairContracts == nil {
+		p.pairContracts = map[string]string{}
+	}
+	p.pairContracts[pair.String()] = response.ContractAddr
+	return response.ContractAddr, nil
+}
+
+func (r AstroportSimulationResponse) amounts() []string {
+	return []string{r.ReturnAmount, r.SpreadAmount, r.CommissionAmount}
+}
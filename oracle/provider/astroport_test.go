@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAstroportProvider_Poll(t *testing.T) {
+	const (
+		pair = "terra1fd68ah02gr2y8ze7tm9te7m70zlmc7vjyyhs6xlhsdmqqcjud4dql4wpxr"
+		usdc = "ibc/B3504E092456BA618CC28AC671A71FB08C6CA0FD0BE7C8A5B5A3E2DD933CC9E4"
+	)
+
+	server := newWasmTestServer(func(contract string, query map[string]json.RawMessage) interface{} {
+		switch {
+		case query["pool"] != nil:
+			return AstroportPoolResponse{Assets: []CosmwasmAsset{
+				{Info: newCosmwasmAssetInfo("uluna"), Amount: "500000000000"},
+				{Info: newCosmwasmAssetInfo(usdc), Amount: "250000000000"},
+			}}
+		case query["simulation"] != nil:
+			return AstroportSimulationResponse{
+				ReturnAmount:     "498500",
+				SpreadAmount:     "1",
+				CommissionAmount: "1499",
+			}
+		}
+		return nil
+	})
+	defer server.Close()
+
+	lunaUsdc := types.CurrencyPair{Base: "LUNA", Quote: "USDC"}
+
+	p := newTestProvider(t, NewAstroportProvider, server, Endpoint{
+		Name:      ProviderAstroport,
+		Contracts: map[string]string{"LUNA": "uluna", "USDC": usdc, "LUNAUSDC": pair},
+	}, lunaUsdc)
+
+	require.NoError(t, p.Poll())
+	require.Equal(t, sdk.MustNewDecFromStr("0.5"), p.tickers["LUNAUSDC"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("500000"), p.tickers["LUNAUSDC"].Volume)
+
+	for _, query := range wasmTestQueries(t, server) {
+		require.Equal(t, pair, query.contract)
+		if query.query["simulation"] != nil {
+			require.JSONEq(t, `{"offer_asset":{"info":{"native_token":{"denom":"uluna"}},"amount":"1000000"}}`, string(query.query["simulation"]))
+		}
+	}
+}
@@ -0,0 +1,190 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+)
+
+const (
+	// astrovaultStablePrefix defines the prefix of stable pools in the
+	// configured contracts, ex.: "stable:archway1...".
+	astrovaultStablePrefix = "stable:"
+)
+
+var (
+	_                          Provider = (*AstrovaultProvider)(nil)
+	astrovaultDefaultEndpoints          = Endpoint{
+		Name:         ProviderAstrovault,
+		Urls:         []string{"https://rest.cosmos.directory/archway"},
+		PollInterval: 10 * time.Second,
+	}
+)
+
+type (
+	// AstrovaultProvider defines an oracle provider querying Astrovault pool
+	// contracts on Archway directly using the REST endpoint of a node. The
+	// price of standard pools is derived from their reserves, while stable
+	// pools simulate swapping one base token including the fees. The
+	// `contracts` of the provider endpoints map the denoms to their chain
+	// denoms or "cw20:" prefixed token addresses and the pairs to their pool
+	// contracts, with stable pools being prefixed by "stable:". The base
+	// reserve of the pool is reported as volume.
+	//
+	// REF: https://docs.astrovault.io/developers/smart-contracts
+	AstrovaultProvider struct {
+		provider
+	}
+
+	AstrovaultPoolResponse struct {
+		Assets []CosmwasmAsset `json:"assets"`
+	}
+
+	AstrovaultSwapSimulationResponse struct {
+		SwapToAssetsAmount []string `json:"swap_to_assets_amount"` // ex.: ["0", "998512"]
+		AssetsFeeAmount    []string `json:"assets_fee_amount"`     // ex.: ["0", "1000"]
+	}
+)
+
+func NewAstrovaultProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*AstrovaultProvider, error) {
+	provider := &AstrovaultProvider{}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		nil,
+		nil,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
+
+func (p *AstrovaultProvider) Poll() error {
+	for symbol, pair := range p.pairs {
+		ticker, err := p.getTicker(pair)
+		if err != nil {
+			p.logger.Warn().Err(err).Str("pair", symbol).Msg("failed to query pool")
+			continue
+		}
+
+		p.mtx.Lock()
+		p.tickers[symbol] = ticker
+		p.mtx.Unlock()
+	}
+
+	p.logger.Debug().Msg("updated tickers")
+	return nil
+}
+
+func (p *AstrovaultProvider) getTicker(pair types.CurrencyPair) (types.TickerPrice, error) {
+	contract, ok := p.endpoints.Contracts[pair.String()]
+	if !ok {
+		return types.TickerPrice{}, fmt.Errorf("no contract configured for %s", pair.String())
+	}
+	stable := strings.HasPrefix(contract, astrovaultStablePrefix)
+	contract = strings.TrimPrefix(contract, astrovaultStablePrefix)
+
+	base, ok := p.endpoints.Contracts[pair.Base]
+	if !ok {
+		return types.TickerPrice{}, fmt.Errorf("no denom configured for %s", pair.Base)
+	}
+	quote, ok := p.endpoints.Contracts[pair.Quote]
+	if !ok {
+		return types.TickerPrice{}, fmt.Errorf("no denom configured for %s", pair.Quote)
+	}
+	baseDecimals := p.cosmosDecimals(pair.Base)
+	quoteDecimals := p.cosmosDecimals(pair.Quote)
+
+	var pool AstrovaultPoolResponse
+	err := p.wasmQuery(contract, map[string]interface{}{"pool": struct{}{}}, &pool)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	baseIndex, quoteIndex := -1, -1
+	reserves := make([]*big.Int, len(pool.Assets))
+	for i, asset := range pool.Assets {
+		reserves[i], err = cosmosParseAmount(asset.Amount)
+		if err != nil {
+			return types.TickerPrice{}, err
+		}
+		switch {
+		case asset.Info.Equal(newCosmwasmAssetInfo(base)):
+			baseIndex = i
+		case asset.Info.Equal(newCosmwasmAssetInfo(quote)):
+			quoteIndex = i
+		}
+	}
+	if baseIndex < 0 || quoteIndex < 0 {
+		return types.TickerPrice{}, fmt.Errorf("pool %s doesn't contain %s", contract, pair.String())
+	}
+	if reserves[baseIndex].Sign() == 0 {
+		return types.TickerPrice{}, fmt.Errorf("pool %s is empty", contract)
+	}
+	volume := bigIntToDec(reserves[baseIndex], baseDecimals)
+
+	var price sdk.Dec
+	if stable {
+		price, err = p.getStablePrice(contract, baseIndex, quoteIndex, baseDecimals, quoteDecimals)
+		if err != nil {
+			return types.TickerPrice{}, err
+		}
+	} else {
+		price = bigIntToDec(reserves[quoteIndex], quoteDecimals).Quo(volume)
+	}
+
+	return types.TickerPrice{
+		Price:  price,
+		Volume: volume,
+		Time:   time.Now(),
+	}, nil
+}
+
+// getStablePrice simulates swapping one whole base token in a stable pool and
+// returns the received amount including the fees.
+func (p *AstrovaultProvider) getStablePrice(
+	contract string,
+	baseIndex int,
+	quoteIndex int,
+	baseDecimals int64,
+	quoteDecimals int64,
+) (sdk.Dec, error) {
+	offerAmount := new(big.Int).Exp(big.NewInt(10), big.NewInt(baseDecimals), nil)
+	var simulation AstrovaultSwapSimulationResponse
+	err := p.wasmQuery(contract, map[string]interface{}{
+		"swap_simulation": map[string]interface{}{
+			"amount":                offerAmount.String(),
+			"swap_from_asset_index": baseIndex,
+			"swap_to_asset_index":   quoteIndex,
+		},
+	}, &simulation)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	amount := big.NewInt(0)
+	for _, amounts := range [][]string{simulation.SwapToAssetsAmount, simulation.AssetsFeeAmount} {
+		if len(amounts) <= quoteIndex {
+			return sdk.Dec{}, fmt.Errorf("invalid simulation of %s", contract)
+		}
+		parsed, err := cosmosParseAmount(amounts[quoteIndex])
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		amount.Add(amount, parsed)
+	}
+	return bigIntToDec(amount, quoteDecimals), nil
+}
@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAstrovaultProvider_Poll(t *testing.T) {
+	const (
+		standardPool = "archway1standard"
+		stablePool   = "archway1stable"
+		usdc         = "ibc/43897B9739BD63E3A08A88191999C632E052724AB96BD4C74AE31375C991F48D"
+		stusdc       = "cw20:archway1stusdc"
+	)
+
+	server := newWasmTestServer(func(contract string, query map[string]json.RawMessage) interface{} {
+		switch {
+		case contract == standardPool && query["pool"] != nil:
+			return AstrovaultPoolResponse{Assets: []CosmwasmAsset{
+				{Info: newCosmwasmAssetInfo(usdc), Amount: "50000000000"},
+				{Info: newCosmwasmAssetInfo("aarch"), Amount: "1000000000000000000000000"},
+			}}
+		case contract == stablePool && query["pool"] != nil:
+			return AstrovaultPoolResponse{Assets: []CosmwasmAsset{
+				{Info: newCosmwasmAssetInfo(usdc), Amount: "1000000000000"},
+				{Info: newCosmwasmAssetInfo(stusdc), Amount: "900000000000"},
+			}}
+		case contract == stablePool && query["swap_simulation"] != nil:
+			return AstrovaultSwapSimulationResponse{
+				SwapToAssetsAmount: []string{"1049000", "0"},
+				AssetsFeeAmount:    []string{"1000", "0"},
+			}
+		}
+		return nil
+	})
+	defer server.Close()
+
+	archUsdc := types.CurrencyPair{Base: "ARCH", Quote: "USDC"}
+	stusdcUsdc := types.CurrencyPair{Base: "STUSDC", Quote: "USDC"}
+
+	p := newTestProvider(t, NewAstrovaultProvider, server, Endpoint{
+		Name: ProviderAstrovault,
+		Contracts: map[string]string{
+			"ARCH":       "aarch",
+			"STUSDC":     stusdc,
+			"USDC":       usdc,
+			"ARCHUSDC":   standardPool,
+			"STUSDCUSDC": astrovaultStablePrefix + stablePool,
+		},
+		Decimals: map[string]int64{"ARCH": 18},
+	}, archUsdc, stusdcUsdc)
+
+	require.NoError(t, p.Poll())
+	require.Equal(t, sdk.MustNewDecFromStr("0.05"), p.tickers["ARCHUSDC"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("1000000"), p.tickers["ARCHUSDC"].Volume)
+	require.Equal(t, sdk.MustNewDecFromStr("1.05"), p.tickers["STUSDCUSDC"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("900000"), p.tickers["STUSDCUSDC"].Volume)
+
+	for _, query := range wasmTestQueries(t, server) {
+		if query.query["swap_simulation"] != nil {
+			require.Equal(t, stablePool, query.contract)
+			require.JSONEq(t, `{"amount":"1000000","swap_from_asset_index":1,"swap_to_asset_index":0}`, string(query.query["swap_simulation"]))
+		}
+	}
+}
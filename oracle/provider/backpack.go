@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	"github.com/rs/zerolog"
+)
+
+var (
+	_                        Provider = (*BackpackProvider)(nil)
+	backpackDefaultEndpoints          = Endpoint{
+		Name:      ProviderBackpack,
+		Urls:      []string{"https://api.backpack.exchange"},
+		Websocket: "ws.backpack.exchange",
+	}
+)
+
+type (
+	// BackpackProvider defines an oracle provider implemented by the Backpack
+	// exchange public websocket API.
+	//
+	// REF: https://docs.backpack.exchange/#tag/Streams/Public/Ticker
+	BackpackProvider struct {
+		provider
+	}
+
+	BackpackSubscriptionMsg struct {
+		Method string   `json:"method"` // ex.: "SUBSCRIBE"
+		Params []string `json:"params"` // ex.: ["ticker.SOL_USDC"]
+	}
+
+	// BackpackTickerMsg defines a ticker update or error, ex.:
+	// {"stream":"ticker.SOL_USDC","data":{"e":"ticker","E":1694687692980000,"s":"SOL_USDC","c":"19.24","v":"32123"}}
+	BackpackTickerMsg struct {
+		Stream string         `json:"stream"`
+		Data   BackpackTicker `json:"data"`
+		Error  *BackpackError `json:"error"`
+	}
+
+	BackpackTicker struct {
+		Event  string `json:"e"` // ex.: "ticker"
+		Time   int64  `json:"E"` // ex.: 1694687692980000
+		Symbol string `json:"s"` // ex.: "SOL_USDC"
+		Price  string `json:"c"` // ex.: "19.24"
+		Volume string `json:"v"` // ex.: "32123"
+	}
+
+	BackpackError struct {
+		Code    int64  `json:"code"`
+		Message string `json:"message"`
+	}
+)
+
+func NewBackpackProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*BackpackProvider, error) {
+	provider := &BackpackProvider{}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		provider.messageReceived,
+		provider.getSubscriptionMsgs,
+	)
+	return provider, nil
+}
+
+func (p *BackpackProvider) getSubscriptionMsgs(pairs ...types.CurrencyPair) []interface{} {
+	params := make([]string, len(pairs))
+	for i, pair := range pairs {
+		params[i] = "ticker." + p.CurrencyPairToProviderPair(pair)
+	}
+	return []interface{}{
+		BackpackSubscriptionMsg{
+			Method: "SUBSCRIBE",
+			Params: params,
+		},
+	}
+}
+
+func (p *BackpackProvider) messageReceived(messageType int, bz []byte) {
+	var tickerMsg BackpackTickerMsg
+	err := json.Unmarshal(bz, &tickerMsg)
+	if err != nil {
+		p.logger.Error().Err(err).Msg("failed to unmarshal message")
+		return
+	}
+
+	if tickerMsg.Error != nil {
+		p.logger.Error().
+			Int64("code", tickerMsg.Error.Code).
+			Str("msg", tickerMsg.Error.Message).
+			Msg("received error message")
+		return
+	}
+
+	if tickerMsg.Data.Event != "ticker" {
+		return
+	}
+
+	price, err := decFromString(tickerMsg.Data.Price)
+	if err != nil {
+		p.logger.Error().Err(err).Msg("failed to parse price")
+		return
+	}
+	volume, err := decFromString(tickerMsg.Data.Volume)
+	if err != nil {
+		p.logger.Error().Err(err).Msg("failed to parse volume")
+		return
+	}
+
+	symbol := p.ProviderPairToCurrencyPair(tickerMsg.Data.Symbol).String()
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	if _, ok := p.pairs[symbol]; !ok {
+		return
+	}
+
+	p.tickers[symbol] = types.TickerPrice{
+		Price:  price,
+		Volume: volume,
+		Time:   time.UnixMicro(tickerMsg.Data.Time),
+	}
+}
+
+func (p *BackpackProvider) CurrencyPairToProviderPair(pair types.CurrencyPair) string {
+	return pair.Join("_")
+}
+
+func (p *BackpackProvider) ProviderPairToCurrencyPair(pair string) types.CurrencyPair {
+	tokens := strings.Split(pair, "_")
+	if len(tokens) != 2 {
+		p.logger.Warn().Str("pair", pair).Msg("failed to convert to currency pair")
+		return types.CurrencyPair{}
+	}
+	return types.CurrencyPair{
+		Base:  tokens[0],
+		Quote: tokens[1],
+	}
+}
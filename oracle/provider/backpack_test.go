@@ -0,0 +1,30 @@
+package provider
+
+import (
+	"testing"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackpackProvider_MessageReceived(t *testing.T) {
+	solUsdc := types.CurrencyPair{Base: "SOL", Quote: "USDC"}
+
+	p := &BackpackProvider{}
+	p.logger = zerolog.Nop()
+	p.pairs = map[string]types.CurrencyPair{solUsdc.String(): solUsdc}
+	p.tickers = map[string]types.TickerPrice{}
+
+	// ignores errors and drops tickers with unparsable numbers
+	p.messageReceived(1, []byte(`{"id":null,"error":{"code":4005,"message":"Invalid stream"}}`))
+	p.messageReceived(1, []byte(`{"stream":"ticker.SOL_USDC","data":{"e":"ticker","E":1694687692980000,"s":"SOL_USDC","c":"19.24","v":""}}`))
+	require.Empty(t, p.tickers)
+
+	p.messageReceived(1, []byte(`{"stream":"ticker.SOL_USDC","data":{"e":"ticker","E":1694687692980000,"s":"SOL_USDC","c":"19.24","v":"32123"}}`))
+	require.Equal(t, sdk.MustNewDecFromStr("19.24"), p.tickers["SOLUSDC"].Price)
+	require.Equal(t, sdk.NewDec(32123), p.tickers["SOLUSDC"].Volume)
+	require.Equal(t, int64(1694687692980000), p.tickers["SOLUSDC"].Time.UnixMicro())
+}
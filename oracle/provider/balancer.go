@@ -0,0 +1,218 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+)
+
+const (
+	// balancerVault defines the address of the Balancer V2 vault, which is the
+	// same on all chains.
+	balancerVault = "0xba12222222228d8ba445958a75a0704d566be2c8"
+)
+
+var (
+	_                        Provider = (*BalancerProvider)(nil)
+	balancerDefaultEndpoints          = Endpoint{
+		Name:         ProviderBalancer,
+		Urls:         []string{"https://cloudflare-eth.com"},
+		PollInterval: 15 * time.Second,
+	}
+)
+
+type (
+	// BalancerProvider defines an oracle provider reading the spot prices of
+	// Balancer V2 weighted pools using the JSON-RPC endpoint of any EVM chain.
+	// The pool balances are read from the vault and the price of the base token
+	// is (quote balance / quote weight) / (base balance / base weight), which
+	// excludes the swap fee. The `contracts` of the provider endpoints map the
+	// denoms to their token addresses and the pairs to their pool addresses,
+	// ex.: {"BAL": "0xba10...", "WETH": "0xc02a...", "BALWETH": "0x5c6e..."}.
+	// The base token balance of the pool is reported as volume.
+	//
+	// REF: https://docs.balancer.fi/concepts/explore-available-balancer-pools/weighted-pool/weighted-math.html
+	BalancerProvider struct {
+		provider
+		decimals *evmDecimalsCache
+		// poolIds caches the vault pool ids of the pools
+		poolIds    map[string][]byte
+		poolIdsMtx sync.Mutex
+	}
+)
+
+func NewBalancerProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*BalancerProvider, error) {
+	provider := &BalancerProvider{
+		decimals: newEvmDecimalsCache(),
+		poolIds:  map[string][]byte{},
+	}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		nil,
+		nil,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
+
+func (p *BalancerProvider) Poll() error {
+	for symbol, pair := range p.pairs {
+		ticker, err := p.getTicker(pair)
+		if err != nil {
+			p.logger.Warn().Err(err).Str("pair", symbol).Msg("failed to get pool")
+			continue
+		}
+
+		p.mtx.Lock()
+		p.tickers[symbol] = ticker
+		p.mtx.Unlock()
+	}
+
+	p.logger.Debug().Msg("updated tickers")
+	return nil
+}
+
+func (p *BalancerProvider) getTicker(pair types.CurrencyPair) (types.TickerPrice, error) {
+	pool, ok := p.endpoints.Contracts[pair.String()]
+	if !ok {
+		return types.TickerPrice{}, fmt.Errorf("no contract configured for %s", pair.String())
+	}
+	base, ok := p.endpoints.Contracts[pair.Base]
+	if !ok {
+		return types.TickerPrice{}, fmt.Errorf("no contract configured for %s", pair.Base)
+	}
+	quote, ok := p.endpoints.Contracts[pair.Quote]
+	if !ok {
+		return types.TickerPrice{}, fmt.Errorf("no contract configured for %s", pair.Quote)
+	}
+	base = strings.ToLower(base)
+	quote = strings.ToLower(quote)
+
+	poolId, err := p.getPoolId(pool)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	// getPoolTokens returns the arrays of tokens and balances
+	tokens, err := p.evmCall(balancerVault, evmEncodeCall("getPoolTokens(bytes32)", poolId))
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+	tokensIndex, length, err := evmDecodeArray(tokens, 0)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+	balancesIndex, _, err := evmDecodeArray(tokens, 1)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	weights, err := p.evmCall(pool, evmEncodeCall("getNormalizedWeights()"))
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+	weightsIndex, weightsLength, err := evmDecodeArray(weights, 0)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+	if weightsLength != length {
+		return types.TickerPrice{}, fmt.Errorf("invalid weights of pool %s", pool)
+	}
+
+	i, j := -1, -1
+	for index := 0; index < length; index++ {
+		token, err := evmDecodeAddress(tokens, tokensIndex+index)
+		if err != nil {
+			return types.TickerPrice{}, err
+		}
+		switch token {
+		case base:
+			i = index
+		case quote:
+			j = index
+		}
+	}
+	if i < 0 || j < 0 {
+		return types.TickerPrice{}, fmt.Errorf("pool %s doesn't contain %s", pool, pair.String())
+	}
+
+	baseBalance, err := p.getBalance(tokens, balancesIndex+i, pair.Base, base)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+	quoteBalance, err := p.getBalance(tokens, balancesIndex+j, pair.Quote, quote)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+	if !baseBalance.IsPositive() || !quoteBalance.IsPositive() {
+		return types.TickerPrice{}, fmt.Errorf("no liquidity in pool %s", pool)
+	}
+
+	baseWeight, err := evmDecodeUint(weights, weightsIndex+i)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+	quoteWeight, err := evmDecodeUint(weights, weightsIndex+j)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	price := quoteBalance.Mul(bigIntToDec(baseWeight, 18)).
+		Quo(baseBalance.Mul(bigIntToDec(quoteWeight, 18)))
+
+	return types.TickerPrice{
+		Price:  price,
+		Volume: baseBalance,
+		Time:   time.Now(),
+	}, nil
+}
+
+// getBalance returns the balance at the index of the getPoolTokens result
+// scaled down by the decimals of the token.
+func (p *BalancerProvider) getBalance(result []byte, index int, denom, token string) (sdk.Dec, error) {
+	balance, err := evmDecodeUint(result, index)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	decimals, err := p.decimals.get(&p.provider, denom, token)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return bigIntToDec(balance, decimals), nil
+}
+
+func (p *BalancerProvider) getPoolId(pool string) ([]byte, error) {
+	p.poolIdsMtx.Lock()
+	defer p.poolIdsMtx.Unlock()
+
+	if poolId, ok := p.poolIds[pool]; ok {
+		return poolId, nil
+	}
+
+	result, err := p.evmCall(pool, evmEncodeCall("getPoolId()"))
+	if err != nil {
+		return nil, err
+	}
+	poolId, err := evmWord(result, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	p.poolIds[pool] = poolId
+	return poolId, nil
+}
@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBalancerProvider_Poll(t *testing.T) {
+	const (
+		bal  = "0xba100000625a3754423978a60c9317c58a424e3d"
+		weth = "0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2"
+		pool = "0x5c6ee304399dbdb9c8ef030ab642b10820db8f56"
+	)
+
+	poolId, err := hex.DecodeString("5c6ee304399dbdb9c8ef030ab642b10820db8f56000200000000000000000014")
+	require.NoError(t, err)
+	balWord, err := evmEncodeAddress(bal)
+	require.NoError(t, err)
+	wethWord, err := evmEncodeAddress(weth)
+	require.NoError(t, err)
+
+	word := func(value string) []byte {
+		i, ok := new(big.Int).SetString(value, 10)
+		require.True(t, ok)
+		return evmEncodeInt(i)
+	}
+
+	poolTokens := bytes.Join([][]byte{
+		word("96"), word("192"), word("17000000"),
+		word("2"), balWord, wethWord,
+		word("2"), word("8000000000000000000000000"), word("1000000000000000000000"),
+	}, nil)
+	weights := bytes.Join([][]byte{
+		word("32"), word("2"), word("800000000000000000"), word("200000000000000000"),
+	}, nil)
+
+	server := newEvmTestServer(func(params EvmCallParams) []byte {
+		data := "0x" + hex.EncodeToString(evmEncodeCall("getPoolTokens(bytes32)", poolId))
+		switch {
+		case params.To == pool && params.Data == "0x"+hex.EncodeToString(evmEncodeCall("getPoolId()")):
+			return poolId
+		case params.To == balancerVault && params.Data == data:
+			return poolTokens
+		case params.To == pool && params.Data == "0x"+hex.EncodeToString(evmEncodeCall("getNormalizedWeights()")):
+			return weights
+		}
+		return nil
+	})
+	defer server.Close()
+
+	balWeth := types.CurrencyPair{Base: "BAL", Quote: "WETH"}
+
+	p := newTestProvider(t, NewBalancerProvider, server, Endpoint{
+		Name:      ProviderBalancer,
+		Contracts: map[string]string{"BAL": bal, "WETH": weth, "BALWETH": pool},
+		Decimals:  map[string]int64{"BAL": 18, "WETH": 18},
+	}, balWeth)
+
+	require.NoError(t, p.Poll())
+	require.Equal(t, sdk.MustNewDecFromStr("0.0005"), p.tickers["BALWETH"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("8000000"), p.tickers["BALWETH"].Volume)
+}
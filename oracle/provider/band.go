@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+)
+
+const (
+	// bandDefaultMaxAge defines the default age of the reference prices
+	// after which they're considered stale.
+	bandDefaultMaxAge = 10 * time.Minute
+)
+
+var (
+	_                    Provider = (*BandProvider)(nil)
+	bandDefaultEndpoints          = Endpoint{
+		Name:         ProviderBand,
+		Urls:         []string{"https://laozi1.bandchain.org"},
+		PollInterval: 15 * time.Second,
+	}
+)
+
+type (
+	// BandProvider defines an oracle provider querying the reference prices
+	// of the BandChain standard dataset, which are all quoted in USD. Pairs
+	// quoted in other currencies are derived from the USD prices of their
+	// base and quote, like the reference data of the Band contracts. Prices
+	// older than the `max_age` of the provider endpoints, which defaults to
+	// ten minutes, are rejected. The prices don't have a volume, so their
+	// tickers are reported with a volume of one.
+	//
+	// REF: https://docs.bandchain.org/develop/api-endpoints
+	BandProvider struct {
+		provider
+	}
+
+	BandPricesResponse struct {
+		PriceResults []BandPriceResult `json:"price_results"`
+	}
+
+	BandPriceResult struct {
+		Symbol      string `json:"symbol"`       // ex.: "ATOM"
+		Multiplier  string `json:"multiplier"`   // ex.: "1000000000"
+		Px          string `json:"px"`           // ex.: "9870000000"
+		ResolveTime string `json:"resolve_time"` // ex.: "1700000000"
+	}
+)
+
+func NewBandProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*BandProvider, error) {
+	provider := &BandProvider{}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		nil,
+		nil,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
+
+func (p *BandProvider) Poll() error {
+	symbols := map[string]struct{}{}
+	for _, pair := range p.pairs {
+		symbols[pair.Base] = struct{}{}
+		if pair.Quote != "USD" {
+			symbols[pair.Quote] = struct{}{}
+		}
+	}
+	if len(symbols) == 0 {
+		return nil
+	}
+
+	query := url.Values{}
+	for symbol := range symbols {
+		query.Add("symbols", symbol)
+	}
+	query.Set("min_count", "3")
+	query.Set("ask_count", "4")
+
+	content, err := p.httpGet("/api/oracle/v1/request_prices?" + query.Encode())
+	if err != nil {
+		return err
+	}
+
+	var response BandPricesResponse
+	err = json.Unmarshal(content, &response)
+	if err != nil {
+		return err
+	}
+
+	maxAge := bandDefaultMaxAge
+	if p.endpoints.MaxAge != 0 {
+		maxAge = p.endpoints.MaxAge
+	}
+
+	prices := map[string]sdk.Dec{
+		"USD": sdk.OneDec(),
+	}
+	for _, result := range response.PriceResults {
+		resolveTime, err := strconv.ParseInt(result.ResolveTime, 10, 64)
+		if err != nil {
+			p.logger.Warn().Err(err).Str("symbol", result.Symbol).Msg("invalid resolve time")
+			continue
+		}
+		if time.Since(time.Unix(resolveTime, 0)) > maxAge {
+			p.logger.Warn().Str("symbol", result.Symbol).Msg("reference price is stale")
+			continue
+		}
+		multiplier, err := decFromString(result.Multiplier)
+		if err != nil || !multiplier.IsPositive() {
+			p.logger.Warn().Str("symbol", result.Symbol).Msg("invalid multiplier")
+			continue
+		}
+		px, err := decFromString(result.Px)
+		if err != nil {
+			p.logger.Warn().Err(err).Str("symbol", result.Symbol).Msg("invalid price")
+			continue
+		}
+		prices[result.Symbol] = px.Quo(multiplier)
+	}
+
+	timestamp := time.Now()
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	for symbol, pair := range p.pairs {
+		base, ok := prices[pair.Base]
+		if !ok || !base.IsPositive() {
+			continue
+		}
+		quote, ok := prices[pair.Quote]
+		if !ok || !quote.IsPositive() {
+			continue
+		}
+		p.tickers[symbol] = types.TickerPrice{
+			Price:  base.Quo(quote),
+			Volume: sdk.OneDec(),
+			Time:   timestamp,
+		}
+	}
+
+	p.logger.Debug().Msg("updated tickers")
+	return nil
+}
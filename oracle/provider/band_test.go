@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBandProvider_Poll(t *testing.T) {
+	now := time.Now().Unix()
+
+	server := newTestServer(func(rw http.ResponseWriter, req *http.Request) {
+		_, _ = fmt.Fprintf(rw, `{"price_results":[
+			{"symbol":"ATOM","multiplier":"1000000000","px":"9870000000","resolve_time":"%d"},
+			{"symbol":"BTC","multiplier":"1000000000","px":"37000000000000","resolve_time":"%d"},
+			{"symbol":"OSMO","multiplier":"1000000000","px":"550000000","resolve_time":"%d"},
+			{"symbol":"TIA","multiplier":"1000000000","px":"","resolve_time":"%d"}
+		]}`, now-60, now-60, now-3600, now-60)
+	})
+	defer server.Close()
+
+	atomUsd := types.CurrencyPair{Base: "ATOM", Quote: "USD"}
+	btcAtom := types.CurrencyPair{Base: "BTC", Quote: "ATOM"}
+	osmoUsd := types.CurrencyPair{Base: "OSMO", Quote: "USD"}
+	tiaUsd := types.CurrencyPair{Base: "TIA", Quote: "USD"}
+
+	p := newTestProvider(t, NewBandProvider, server, Endpoint{
+		Name: ProviderBand,
+	}, atomUsd, btcAtom, osmoUsd, tiaUsd)
+
+	require.NoError(t, p.Poll())
+	require.Equal(t, sdk.MustNewDecFromStr("9.87"), p.tickers["ATOMUSD"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("37000").Quo(sdk.MustNewDecFromStr("9.87")), p.tickers["BTCATOM"].Price)
+	require.NotContains(t, p.tickers, "OSMOUSD")
+	require.NotContains(t, p.tickers, "TIAUSD")
+
+	requests := server.Requests()
+	require.Len(t, requests, 1)
+	require.Equal(t, "/api/oracle/v1/request_prices", requests[0].URL.Path)
+	require.ElementsMatch(t, []string{"ATOM", "BTC", "OSMO", "TIA"}, requests[0].URL.Query()["symbols"])
+}
@@ -12,6 +12,12 @@ import (
 	"github.com/rs/zerolog"
 )
 
+const (
+	// binanceFallbackCutoff defines the age of the websocket tickers after
+	// which they're polled from the REST API instead.
+	binanceFallbackCutoff = 30 * time.Second
+)
+
 var (
 	_                       Provider = (*BinanceProvider)(nil)
 	binanceDefaultEndpoints          = Endpoint{
@@ -23,23 +29,47 @@ var (
 			"https://api4.binance.com",
 			"https://api.binance.com",
 		},
-		PollInterval: 6 * time.Second,
+		PollInterval:  6 * time.Second,
+		Websocket:     "stream.binance.com:9443",
+		WebsocketPath: "/ws",
 	}
 	binanceUSDefaultEndpoints = Endpoint{
-		Name:         ProviderBinanceUS,
-		Urls:         []string{"https://api.binance.us"},
-		PollInterval: 6 * time.Second,
+		Name:          ProviderBinanceUS,
+		Urls:          []string{"https://api.binance.us"},
+		PollInterval:  6 * time.Second,
+		Websocket:     "stream.binance.us:9443",
+		WebsocketPath: "/ws",
 	}
 )
 
 type (
 	// BinanceProvider defines an Oracle provider implemented by the Binance public
-	// API.
+	// API. The tickers are streamed using the mini ticker websocket streams and
+	// polled from the REST API if they're stale. Binance.US shares the API of
+	// Binance, but lists fewer symbols, so only symbols listed on the
+	// configured endpoints are polled.
 	//
 	// REF: https://binance-docs.github.io/apidocs/spot/en/#individual-symbol-mini-ticker-stream
-	// REF: https://binance-docs.github.io/apidocs/spot/en/#kline-candlestick-streams
+	// REF: https://binance-docs.github.io/apidocs/spot/en/#exchange-information
 	BinanceProvider struct {
 		provider
+		symbols *symbolCache
+	}
+
+	BinanceSubscriptionMsg struct {
+		Method string   `json:"method"` // ex.: "SUBSCRIBE"
+		Params []string `json:"params"` // ex.: ["btcusdt@miniTicker"]
+		Id     int64    `json:"id"`     // ex.: 1
+	}
+
+	// BinanceMiniTickerMsg defines a mini ticker update, ex.:
+	// {"e":"24hrMiniTicker","E":1672515782136,"s":"BNBBTC","c":"0.0025","v":"10000"}
+	BinanceMiniTickerMsg struct {
+		Event  string `json:"e"` // ex.: "24hrMiniTicker"
+		Time   int64  `json:"E"` // ex.: 1672515782136
+		Symbol string `json:"s"` // ex.: "BNBBTC"
+		Price  string `json:"c"` // ex.: "0.0025"
+		Volume string `json:"v"` // ex.: "10000"
 	}
 
 	BinanceTicker struct {
@@ -47,6 +77,15 @@ type (
 		LastPrice string `json:"lastPrice"` // Last price ex.: 0.0025
 		Volume    string `json:"volume"`    // Total traded base asset volume ex.: 1000
 	}
+
+	BinanceExchangeInfoResponse struct {
+		Symbols []BinanceSymbol `json:"symbols"`
+	}
+
+	BinanceSymbol struct {
+		Symbol string `json:"symbol"` // ex.: "BTCUSDT"
+		Status string `json:"status"` // ex.: "TRADING"
+	}
 )
 
 func NewBinanceProvider(
@@ -61,20 +100,89 @@ func NewBinanceProvider(
 		endpoints,
 		logger,
 		pairs,
-		nil,
-		nil,
+		provider.messageReceived,
+		provider.getSubscriptionMsgs,
 	)
-	go startPolling(provider, provider.endpoints.PollInterval, logger)
+
+	symbols, err := newSymbolCache(
+		provider.logger,
+		provider.endpoints.SymbolsTTL,
+		provider.getSymbols,
+	)
+	if err != nil {
+		return nil, err
+	}
+	provider.symbols = symbols
+
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
 	return provider, nil
 }
 
+func (p *BinanceProvider) getSubscriptionMsgs(pairs ...types.CurrencyPair) []interface{} {
+	params := make([]string, len(pairs))
+	for i, pair := range pairs {
+		params[i] = strings.ToLower(pair.String()) + "@miniTicker"
+	}
+	return []interface{}{
+		BinanceSubscriptionMsg{
+			Method: "SUBSCRIBE",
+			Params: params,
+			Id:     1,
+		},
+	}
+}
+
+func (p *BinanceProvider) messageReceived(messageType int, bz []byte) {
+	var tickerMsg BinanceMiniTickerMsg
+	err := json.Unmarshal(bz, &tickerMsg)
+	if err != nil {
+		p.logger.Error().Err(err).Msg("failed to unmarshal message")
+		return
+	}
+
+	// subscription results don't have an event type
+	if tickerMsg.Event != "24hrMiniTicker" {
+		return
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	if _, ok := p.pairs[tickerMsg.Symbol]; !ok {
+		return
+	}
+
+	p.tickers[tickerMsg.Symbol] = types.TickerPrice{
+		Price:  strToDec(tickerMsg.Price),
+		Volume: strToDec(tickerMsg.Volume),
+		Time:   time.UnixMilli(tickerMsg.Time),
+	}
+}
+
+// Poll updates the tickers which weren't updated via the websocket recently
+// using the REST API.
 func (p *BinanceProvider) Poll() error {
-	symbols := make([]string, len(p.pairs))
-	i := 0
+	binanceSymbols := p.symbols.Symbols()
+
+	p.mtx.RLock()
+	symbols := []string{}
 	for symbol := range p.pairs {
-		symbols[i] = symbol
-		i++
+		if _, ok := binanceSymbols[symbol]; !ok {
+			p.logger.Warn().Str("pair", symbol).Msg("symbol not found")
+			p.symbols.Invalidate()
+			continue
+		}
+		ticker, ok := p.tickers[symbol]
+		if !ok || time.Since(ticker.Time) > binanceFallbackCutoff {
+			symbols = append(symbols, symbol)
+		}
+	}
+	p.mtx.RUnlock()
+
+	if len(symbols) == 0 {
+		return nil
 	}
+
 	path := fmt.Sprintf(
 		"/api/v3/ticker?type=MINI&symbols=[\"%s\"]",
 		strings.Join(symbols, "\",\""),
@@ -101,6 +209,30 @@ func (p *BinanceProvider) Poll() error {
 		}
 	}
 
-	p.logger.Debug().Msg("updated tickers")
+	p.logger.Debug().Int("tickers", len(tickers)).Msg("updated stale tickers")
 	return nil
 }
+
+// getSymbols returns the symbols currently trading on the configured
+// endpoints, ex.: {"BTCUSDT": "BTCUSDT"}.
+func (p *BinanceProvider) getSymbols() (map[string]string, error) {
+	content, err := p.httpGet("/api/v3/exchangeInfo?permissions=SPOT")
+	if err != nil {
+		return nil, err
+	}
+
+	var exchangeInfo BinanceExchangeInfoResponse
+	err = json.Unmarshal(content, &exchangeInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	symbols := map[string]string{}
+	for _, symbol := range exchangeInfo.Symbols {
+		if symbol.Status != "TRADING" {
+			continue
+		}
+		symbols[symbol.Symbol] = symbol.Symbol
+	}
+	return symbols, nil
+}
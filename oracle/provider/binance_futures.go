@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+)
+
+var (
+	_                              Provider = (*BinanceFuturesProvider)(nil)
+	binanceFuturesDefaultEndpoints          = Endpoint{
+		Name:          ProviderBinanceFutures,
+		Urls:          []string{"https://fapi.binance.com"},
+		PollInterval:  time.Minute,
+		Websocket:     "fstream.binance.com",
+		WebsocketPath: "/ws",
+	}
+)
+
+type (
+	// BinanceFuturesProvider defines an oracle provider streaming the mark
+	// prices of the Binance USDⓈ-M perpetual futures. The 24h volumes of the
+	// contracts are polled from the REST API.
+	//
+	// REF: https://binance-docs.github.io/apidocs/futures/en/#mark-price-stream
+	// REF: https://binance-docs.github.io/apidocs/futures/en/#24hr-ticker-price-change-statistics
+	BinanceFuturesProvider struct {
+		provider
+		volumes map[string]sdk.Dec
+	}
+
+	// BinanceMarkPriceMsg defines a mark price update, ex.:
+	// {"e":"markPriceUpdate","E":1562305380000,"s":"BTCUSDT","p":"11794.15","i":"11784.62"}
+	BinanceMarkPriceMsg struct {
+		Event     string `json:"e"` // ex.: "markPriceUpdate"
+		Time      int64  `json:"E"` // ex.: 1562305380000
+		Symbol    string `json:"s"` // ex.: "BTCUSDT"
+		MarkPrice string `json:"p"` // ex.: "11794.15"
+	}
+)
+
+func NewBinanceFuturesProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*BinanceFuturesProvider, error) {
+	provider := &BinanceFuturesProvider{
+		volumes: make(map[string]sdk.Dec, len(pairs)),
+	}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		provider.messageReceived,
+		provider.getSubscriptionMsgs,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
+
+func (p *BinanceFuturesProvider) getSubscriptionMsgs(pairs ...types.CurrencyPair) []interface{} {
+	params := make([]string, len(pairs))
+	for i, pair := range pairs {
+		params[i] = strings.ToLower(pair.String()) + "@markPrice@1s"
+	}
+	return []interface{}{
+		BinanceSubscriptionMsg{
+			Method: "SUBSCRIBE",
+			Params: params,
+			Id:     1,
+		},
+	}
+}
+
+func (p *BinanceFuturesProvider) messageReceived(messageType int, bz []byte) {
+	var markPriceMsg BinanceMarkPriceMsg
+	err := json.Unmarshal(bz, &markPriceMsg)
+	if err != nil {
+		p.logger.Error().Err(err).Msg("failed to unmarshal message")
+		return
+	}
+
+	// subscription results don't have an event type
+	if markPriceMsg.Event != "markPriceUpdate" {
+		return
+	}
+
+	price, err := decFromString(markPriceMsg.MarkPrice)
+	if err != nil {
+		p.logger.Error().Err(err).Msg("failed to parse mark price")
+		return
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	if _, ok := p.pairs[markPriceMsg.Symbol]; !ok {
+		return
+	}
+
+	volume, ok := p.volumes[markPriceMsg.Symbol]
+	if !ok {
+		volume = sdk.ZeroDec()
+	}
+
+	p.tickers[markPriceMsg.Symbol] = types.TickerPrice{
+		Price:  price,
+		Volume: volume,
+		Time:   time.UnixMilli(markPriceMsg.Time),
+	}
+}
+
+// Poll updates the 24h volumes of the contracts.
+func (p *BinanceFuturesProvider) Poll() error {
+	content, err := p.httpGet("/fapi/v1/ticker/24hr")
+	if err != nil {
+		return err
+	}
+
+	var tickers []BinanceTicker
+	err = json.Unmarshal(content, &tickers)
+	if err != nil {
+		return err
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	for _, ticker := range tickers {
+		if _, ok := p.pairs[ticker.Symbol]; !ok {
+			continue
+		}
+		volume, err := decFromString(ticker.Volume)
+		if err != nil {
+			p.logger.Warn().Err(err).Str("pair", ticker.Symbol).Msg("failed to parse volume")
+			continue
+		}
+		p.volumes[ticker.Symbol] = volume
+	}
+
+	p.logger.Debug().Msg("updated volumes")
+	return nil
+}
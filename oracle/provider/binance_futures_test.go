@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBinanceFuturesProvider_Poll(t *testing.T) {
+	server := newTestServer(func(rw http.ResponseWriter, req *http.Request) {
+		_, _ = rw.Write([]byte(`[
+			{"symbol":"BTCUSDT","lastPrice":"11794.1","volume":"1000"},
+			{"symbol":"ETHUSDT","lastPrice":"1800.1","volume":""}
+		]`))
+	})
+	defer server.Close()
+
+	btcUsdt := types.CurrencyPair{Base: "BTC", Quote: "USDT"}
+	ethUsdt := types.CurrencyPair{Base: "ETH", Quote: "USDT"}
+
+	p := newTestProvider(t, NewBinanceFuturesProvider, server, Endpoint{
+		Name: ProviderBinanceFutures,
+	}, btcUsdt, ethUsdt)
+
+	// unparsable volumes are skipped
+	require.NoError(t, p.Poll())
+	require.Equal(t, sdk.NewDec(1000), p.volumes["BTCUSDT"])
+	require.NotContains(t, p.volumes, "ETHUSDT")
+
+	// mark prices with an unparsable price are dropped
+	p.messageReceived(1, []byte(`{"e":"markPriceUpdate","E":1562305380000,"s":"BTCUSDT","p":""}`))
+	require.Empty(t, p.tickers)
+
+	p.messageReceived(1, []byte(`{"e":"markPriceUpdate","E":1562305380000,"s":"BTCUSDT","p":"11794.15"}`))
+	require.Equal(t, sdk.MustNewDecFromStr("11794.15"), p.tickers["BTCUSDT"].Price)
+	require.Equal(t, sdk.NewDec(1000), p.tickers["BTCUSDT"].Volume)
+	require.Equal(t, int64(1562305380000), p.tickers["BTCUSDT"].Time.UnixMilli())
+
+	requests := server.Requests()
+	require.Len(t, requests, 1)
+	require.Equal(t, "/fapi/v1/ticker/24hr", requests[0].URL.Path)
+}
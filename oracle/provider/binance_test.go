@@ -1,7 +1,7 @@
 package provider
 
 import (
-	"context"
+	"net/http"
 	"testing"
 
 	"price-feeder/oracle/types"
@@ -12,13 +12,11 @@ import (
 )
 
 func TestBinanceProvider_GetTickerPrices(t *testing.T) {
-	p, err := NewBinanceProvider(
-		context.TODO(),
-		zerolog.Nop(),
-		Endpoint{},
-		testAtomUsdtCurrencyPair,
-	)
-	require.NoError(t, err)
+	p := &BinanceProvider{}
+	p.logger = zerolog.Nop()
+	p.pairs = map[string]types.CurrencyPair{
+		testAtomUsdtCurrencyPair.String(): testAtomUsdtCurrencyPair,
+	}
 
 	t.Run("valid_request_single_ticker", func(t *testing.T) {
 		p.tickers = testTickersAtom
@@ -72,3 +70,47 @@ func TestBinanceProvider_GetTickerPrices(t *testing.T) {
 		require.Equal(t, map[string]types.TickerPrice{}, prices)
 	})
 }
+
+func TestBinanceProvider_MessageReceived(t *testing.T) {
+	p := &BinanceProvider{}
+	p.logger = zerolog.Nop()
+	p.pairs = map[string]types.CurrencyPair{
+		testAtomUsdtCurrencyPair.String(): testAtomUsdtCurrencyPair,
+	}
+	p.tickers = map[string]types.TickerPrice{}
+
+	// ignores subscription results
+	p.messageReceived(1, []byte(`{"result":null,"id":1}`))
+	require.Empty(t, p.tickers)
+
+	p.messageReceived(1, []byte(`{"e":"24hrMiniTicker","E":1672515782136,"s":"ATOMUSDT","c":"13.61","o":"13.2","h":"13.9","l":"13.1","v":"433812.95","q":"5904191.25"}`))
+	require.Equal(t, sdk.MustNewDecFromStr("13.61"), p.tickers["ATOMUSDT"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("433812.95"), p.tickers["ATOMUSDT"].Volume)
+	require.Equal(t, int64(1672515782136), p.tickers["ATOMUSDT"].Time.UnixMilli())
+}
+
+func TestBinanceProvider_PollListedSymbols(t *testing.T) {
+	server := newTestServer(func(rw http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/api/v3/exchangeInfo":
+			_, _ = rw.Write([]byte(`{"symbols":[{"symbol":"ATOMUSDT","status":"TRADING"}]}`))
+		case "/api/v3/ticker":
+			_, _ = rw.Write([]byte(`[{"symbol":"ATOMUSDT","lastPrice":"13.61","volume":"433812.95"}]`))
+		}
+	})
+	defer server.Close()
+
+	p := newTestProvider(t, NewBinanceProvider, server, Endpoint{
+		Name: ProviderBinance,
+	}, testAtomUsdtCurrencyPair, testBtcUsdtCurrencyPair)
+
+	require.NoError(t, p.Poll())
+	require.Len(t, p.tickers, 1)
+	require.Equal(t, sdk.MustNewDecFromStr("13.61"), p.tickers["ATOMUSDT"].Price)
+
+	// Binance rejects the whole request if any symbol isn't listed
+	requests := server.Requests()
+	require.Len(t, requests, 2)
+	require.Equal(t, "/api/v3/ticker", requests[1].URL.Path)
+	require.Equal(t, `["ATOMUSDT"]`, requests[1].URL.Query().Get("symbols"))
+}
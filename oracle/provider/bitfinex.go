@@ -14,21 +14,45 @@ import (
 var (
 	_                        Provider = (*BitfinexProvider)(nil)
 	bitfinexDefaultEndpoints          = Endpoint{
-		Name:         ProviderBitfinex,
-		Urls:         []string{"https://api-pub.bitfinex.com"},
-		PollInterval: 2500 * time.Millisecond,
+		Name:          ProviderBitfinex,
+		Urls:          []string{"https://api-pub.bitfinex.com"},
+		Websocket:     "api-pub.bitfinex.com",
+		WebsocketPath: "/ws/2",
 	}
 )
 
 type (
 	// BitfinexProvider defines an oracle provider implemented by the Bitfinex
-	// public API.
+	// public websocket API.
 	//
-	// REF: https://docs.bitfinex.com/docs
+	// REF: https://docs.bitfinex.com/reference/ws-public-ticker
 	BitfinexProvider struct {
 		provider
-		symbols map[string]string
+		// channels maps the channel ids of the subscriptions to their symbol
+		channels map[int64]string
 	}
+
+	BitfinexSubscriptionMsg struct {
+		Event   string `json:"event"`   // ex.: "subscribe"
+		Channel string `json:"channel"` // ex.: "ticker"
+		Symbol  string `json:"symbol"`  // ex.: "tATOMUSD"
+	}
+
+	// BitfinexEventMsg defines the info, subscribed and error events, ex.:
+	// {"event":"subscribed","channel":"ticker","chanId":224555,"symbol":"tATOMUSD","pair":"ATOMUSD"}
+	BitfinexEventMsg struct {
+		Event   string `json:"event"`
+		ChanId  int64  `json:"chanId"`
+		Symbol  string `json:"symbol"`
+		Code    int64  `json:"code"`
+		Message string `json:"msg"`
+	}
+
+	// BitfinexTicker defines the fields of a ticker update, ex.:
+	// [224555,[13.6,2000,13.61,1500,0.1,0.0074,13.605,86413.2,13.9,13.3]]
+	// [BID, BID_SIZE, ASK, ASK_SIZE, DAILY_CHANGE, DAILY_CHANGE_RELATIVE,
+	//  LAST_PRICE, VOLUME, HIGH, LOW]
+	BitfinexTicker [10]float64
 )
 
 func NewBitfinexProvider(
@@ -37,99 +61,136 @@ func NewBitfinexProvider(
 	endpoints Endpoint,
 	pairs ...types.CurrencyPair,
 ) (*BitfinexProvider, error) {
-	provider := &BitfinexProvider{}
+	provider := &BitfinexProvider{
+		channels: map[int64]string{},
+	}
 	provider.Init(
 		ctx,
 		endpoints,
 		logger,
 		pairs,
-		nil,
-		nil,
+		provider.messageReceived,
+		provider.getSubscriptionMsgs,
 	)
+	return provider, nil
+}
 
-	content, err := provider.httpGet("/v2/conf/pub:list:pair:exchange")
-	if err != nil {
-		return nil, err
-	}
-
-	var bitfinexPairs [1][]string
-	err = json.Unmarshal(content, &bitfinexPairs)
-	if err != nil {
-		return nil, err
+func (p *BitfinexProvider) getSubscriptionMsgs(pairs ...types.CurrencyPair) []interface{} {
+	msgs := make([]interface{}, len(pairs))
+	for i, pair := range pairs {
+		msgs[i] = BitfinexSubscriptionMsg{
+			Event:   "subscribe",
+			Channel: "ticker",
+			Symbol:  "t" + p.CurrencyPairToProviderPair(pair),
+		}
 	}
+	return msgs
+}
 
-	provider.symbols = map[string]string{}
-	for _, pair := range bitfinexPairs[0] {
-		symbol := pair
-		symbol = strings.Replace(symbol, "LUNA:", "LUNC:", 1)
-		symbol = strings.Replace(symbol, "LUNA2:", "LUNA:", 1)
-		symbol = strings.Replace(symbol, ":", "", 1)
-		provider.symbols[symbol] = pair
+func (p *BitfinexProvider) messageReceived(messageType int, bz []byte) {
+	// events are sent as objects, channel updates as arrays
+	if len(bz) > 0 && bz[0] == '{' {
+		p.eventReceived(bz)
+		return
 	}
 
-	go startPolling(provider, provider.endpoints.PollInterval, logger)
-	return provider, nil
-}
-
-func (p *BitfinexProvider) Poll() error {
-	symbols := make(map[string]string, len(p.pairs))
-	for _, pair := range p.pairs {
-		bitfinexSymbol := p.symbols[pair.String()]
-		symbols["t"+bitfinexSymbol] = pair.String()
+	var update []json.RawMessage
+	err := json.Unmarshal(bz, &update)
+	if err != nil || len(update) != 2 {
+		p.logger.Error().Err(err).Msg("failed to unmarshal message")
+		return
 	}
 
-	content, err := p.httpGet("/v2/tickers?symbols=ALL")
+	var chanId int64
+	err = json.Unmarshal(update[0], &chanId)
 	if err != nil {
-		return err
+		p.logger.Error().Err(err).Msg("failed to parse channel id")
+		return
 	}
 
-	var tickersResponse [][11]json.RawMessage
-	err = json.Unmarshal(content, &tickersResponse)
+	// heartbeats are sent as [CHANNEL_ID, "hb"]
+	var ticker BitfinexTicker
+	err = json.Unmarshal(update[1], &ticker)
 	if err != nil {
-		return err
+		return
 	}
 
 	p.mtx.Lock()
 	defer p.mtx.Unlock()
 
-	timestamp := time.Now()
+	symbol, ok := p.channels[chanId]
+	if !ok {
+		return
+	}
 
-	for _, ticker := range tickersResponse {
-		var (
-			tickerSymbol string
-			tickerPrice  float64
-			tickerVolume float64
-		)
+	p.tickers[symbol] = types.TickerPrice{
+		Price:  floatToDec(ticker[6]),
+		Volume: floatToDec(ticker[7]),
+		Time:   time.Now(),
+		Spread: computeSpread(floatToDec(ticker[0]), floatToDec(ticker[2])),
+	}
+}
 
-		err := json.Unmarshal(ticker[0], &tickerSymbol)
-		if err != nil {
-			p.logger.Error().Msg("failed to parse ticker")
-			continue
-		}
+func (p *BitfinexProvider) eventReceived(bz []byte) {
+	var eventMsg BitfinexEventMsg
+	err := json.Unmarshal(bz, &eventMsg)
+	if err != nil {
+		p.logger.Error().Err(err).Msg("failed to unmarshal event")
+		return
+	}
 
-		symbol, ok := symbols[tickerSymbol]
-		if !ok {
-			continue
-		}
+	switch eventMsg.Event {
+	case "subscribed":
+		symbol := p.ProviderPairToCurrencyPair(strings.TrimPrefix(eventMsg.Symbol, "t")).String()
 
-		err = json.Unmarshal(ticker[7], &tickerPrice)
-		if err != nil {
-			p.logger.Error().Msg("failed to parse price")
-			continue
-		}
+		p.mtx.Lock()
+		defer p.mtx.Unlock()
 
-		err = json.Unmarshal(ticker[8], &tickerVolume)
-		if err != nil {
-			p.logger.Error().Msg("failed to parse volume")
-			continue
-		}
+		p.channels[eventMsg.ChanId] = symbol
+	case "error":
+		p.logger.Error().
+			Int64("code", eventMsg.Code).
+			Str("msg", eventMsg.Message).
+			Str("symbol", eventMsg.Symbol).
+			Msg("received error event")
+	}
+}
 
-		p.tickers[symbol] = types.TickerPrice{
-			Price:  floatToDec(tickerPrice),
-			Volume: floatToDec(tickerVolume),
-			Time:   timestamp,
-		}
+// CurrencyPairToProviderPair returns the Bitfinex pair, which is separated by
+// a colon if any of the currencies is longer than three characters, ex.:
+// "ATOMUSD" -> "ATOM:USD", "BTCUSD" -> "BTCUSD".
+func (p *BitfinexProvider) CurrencyPairToProviderPair(pair types.CurrencyPair) string {
+	base := pair.Base
+	switch base {
+	case "LUNC":
+		base = "LUNA"
+	case "LUNA":
+		base = "LUNA2"
+	}
+	if len(base) > 3 || len(pair.Quote) > 3 {
+		return base + ":" + pair.Quote
+	}
+	return base + pair.Quote
+}
+
+func (p *BitfinexProvider) ProviderPairToCurrencyPair(pair string) types.CurrencyPair {
+	var base, quote string
+	if tokens := strings.Split(pair, ":"); len(tokens) == 2 {
+		base, quote = tokens[0], tokens[1]
+	} else if len(pair) == 6 {
+		base, quote = pair[:3], pair[3:]
+	} else {
+		p.logger.Warn().Str("pair", pair).Msg("failed to convert to currency pair")
+		return types.CurrencyPair{}
+	}
+	switch base {
+	case "LUNA":
+		base = "LUNC"
+	case "LUNA2":
+		base = "LUNA"
+	}
+	return types.CurrencyPair{
+		Base:  base,
+		Quote: quote,
 	}
-	p.logger.Debug().Msg("updated tickers")
-	return nil
 }
@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	"github.com/rs/zerolog"
+)
+
+var (
+	_                        Provider = (*BitflyerProvider)(nil)
+	bitflyerDefaultEndpoints          = Endpoint{
+		Name:          ProviderBitflyer,
+		Urls:          []string{"https://api.bitflyer.com"},
+		Websocket:     "ws.lightstream.bitflyer.com",
+		WebsocketPath: "/json-rpc",
+	}
+)
+
+type (
+	// BitflyerProvider defines an oracle provider implemented by the bitFlyer
+	// Lightning realtime API. Its markets are quoted in JPY, which is converted
+	// to USD using the JPY/USD rate of the fx provider.
+	//
+	// REF: https://bf-lightning-api.readme.io/docs/realtime-ticker
+	BitflyerProvider struct {
+		provider
+	}
+
+	BitflyerSubscriptionMsg struct {
+		Method string                     `json:"method"` // ex.: "subscribe"
+		Params BitflyerSubscriptionParams `json:"params"`
+	}
+
+	BitflyerSubscriptionParams struct {
+		Channel string `json:"channel"` // ex.: "lightning_ticker_BTC_JPY"
+	}
+
+	BitflyerTickerMsg struct {
+		Method string               `json:"method"` // ex.: "channelMessage"
+		Params BitflyerTickerParams `json:"params"`
+	}
+
+	BitflyerTickerParams struct {
+		Channel string         `json:"channel"` // ex.: "lightning_ticker_BTC_JPY"
+		Message BitflyerTicker `json:"message"`
+	}
+
+	BitflyerTicker struct {
+		ProductCode string  `json:"product_code"`      // ex.: "BTC_JPY"
+		Timestamp   string  `json:"timestamp"`         // ex.: "2019-04-11T05:14:12.3739915Z"
+		Price       float64 `json:"ltp"`               // ex.: 580006
+		Volume      float64 `json:"volume_by_product"` // ex.: 4322.52
+		Bid         float64 `json:"best_bid"`          // ex.: 580006
+		Ask         float64 `json:"best_ask"`          // ex.: 580007
+	}
+)
+
+func NewBitflyerProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*BitflyerProvider, error) {
+	provider := &BitflyerProvider{}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		provider.messageReceived,
+		provider.getSubscriptionMsgs,
+	)
+	return provider, nil
+}
+
+func (p *BitflyerProvider) getSubscriptionMsgs(pairs ...types.CurrencyPair) []interface{} {
+	msgs := make([]interface{}, len(pairs))
+	for i, pair := range pairs {
+		msgs[i] = BitflyerSubscriptionMsg{
+			Method: "subscribe",
+			Params: BitflyerSubscriptionParams{
+				Channel: "lightning_ticker_" + p.CurrencyPairToProviderPair(pair),
+			},
+		}
+	}
+	return msgs
+}
+
+func (p *BitflyerProvider) messageReceived(messageType int, bz []byte) {
+	var tickerMsg BitflyerTickerMsg
+	err := json.Unmarshal(bz, &tickerMsg)
+	if err != nil {
+		p.logger.Error().Err(err).Msg("failed to unmarshal message")
+		return
+	}
+
+	// subscription results are sent as responses without a method
+	if tickerMsg.Method != "channelMessage" {
+		return
+	}
+
+	ticker := tickerMsg.Params.Message
+	timestamp, err := time.Parse(time.RFC3339Nano, ticker.Timestamp)
+	if err != nil {
+		p.logger.Error().Err(err).Msg("failed parsing timestamp")
+		return
+	}
+
+	symbol := p.ProviderPairToCurrencyPair(ticker.ProductCode).String()
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	if _, ok := p.pairs[symbol]; !ok {
+		return
+	}
+
+	p.tickers[symbol] = types.TickerPrice{
+		Price:  floatToDec(ticker.Price),
+		Volume: floatToDec(ticker.Volume),
+		Time:   timestamp,
+		Spread: computeSpread(floatToDec(ticker.Bid), floatToDec(ticker.Ask)),
+	}
+}
+
+func (p *BitflyerProvider) CurrencyPairToProviderPair(pair types.CurrencyPair) string {
+	return pair.Join("_")
+}
+
+func (p *BitflyerProvider) ProviderPairToCurrencyPair(pair string) types.CurrencyPair {
+	tokens := strings.Split(pair, "_")
+	if len(tokens) != 2 {
+		p.logger.Warn().Str("pair", pair).Msg("failed to convert to currency pair")
+		return types.CurrencyPair{}
+	}
+	return types.CurrencyPair{
+		Base:  tokens[0],
+		Quote: tokens[1],
+	}
+}
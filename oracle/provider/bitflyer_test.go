@@ -0,0 +1,29 @@
+package provider
+
+import (
+	"testing"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBitflyerProvider_MessageReceived(t *testing.T) {
+	btcJpy := types.CurrencyPair{Base: "BTC", Quote: "JPY"}
+
+	p := &BitflyerProvider{}
+	p.logger = zerolog.Nop()
+	p.pairs = map[string]types.CurrencyPair{btcJpy.String(): btcJpy}
+	p.tickers = map[string]types.TickerPrice{}
+
+	// ignores subscription results
+	p.messageReceived(1, []byte(`{"jsonrpc":"2.0","id":1,"result":true}`))
+	require.Empty(t, p.tickers)
+
+	p.messageReceived(1, []byte(`{"jsonrpc":"2.0","method":"channelMessage","params":{"channel":"lightning_ticker_BTC_JPY","message":{"product_code":"BTC_JPY","timestamp":"2019-04-11T05:14:12.3739915Z","best_bid":580006,"best_ask":580007,"ltp":580006.5,"volume_by_product":4322.52}}}`))
+	require.Equal(t, sdk.MustNewDecFromStr("580006.5"), p.tickers["BTCJPY"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("4322.52"), p.tickers["BTCJPY"].Volume)
+	require.Equal(t, int64(1554959652373), p.tickers["BTCJPY"].Time.UnixMilli())
+}
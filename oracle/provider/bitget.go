@@ -3,43 +3,80 @@ package provider
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"strconv"
 	"time"
 
 	"price-feeder/oracle/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gorilla/websocket"
 	"github.com/rs/zerolog"
 )
 
 var (
 	_                      Provider = (*BitgetProvider)(nil)
 	bitgetDefaultEndpoints          = Endpoint{
-		Name:         ProviderBitget,
-		Urls:         []string{"https://api.bitget.com"},
-		PollInterval: 2 * time.Second,
+		Name:          ProviderBitget,
+		Urls:          []string{"https://api.bitget.com"},
+		PollInterval:  time.Minute,
+		Websocket:     "ws.bitget.com",
+		WebsocketPath: "/spot/v1/stream",
+		PingDuration:  25 * time.Second,
+		PingType:      websocket.TextMessage,
+		PingMessage:   "ping",
 	}
 )
 
 type (
-	// BitgetProvider defines an oracle provider implemented by the XT.COM
-	// public API.
+	// BitgetProvider defines an oracle provider implemented by the Bitget
+	// public websocket API. The 24h volumes are summed up from the hourly
+	// candles of the REST API.
 	//
-	// REF: https://bitgetlimited.github.io/apidoc/en/spot
+	// REF: https://bitgetlimited.github.io/apidoc/en/spot/#tickers-channel
+	// REF: https://bitgetlimited.github.io/apidoc/en/spot/#get-candle-data
 	BitgetProvider struct {
 		provider
+		volumes map[string]sdk.Dec
 	}
 
-	BitgetTickersResponse struct {
-		Code    string         `json:"code"`
+	BitgetSubscriptionMsg struct {
+		Op   string                  `json:"op"` // ex.: "subscribe"
+		Args []BitgetSubscriptionArg `json:"args"`
+	}
+
+	BitgetSubscriptionArg struct {
+		InstType string `json:"instType"` // ex.: "SP"
+		Channel  string `json:"channel"`  // ex.: "ticker"
+		InstId   string `json:"instId"`   // ex.: "BTCUSDT"
+	}
+
+	// BitgetTickerMsg defines a ticker update, subscription ack or error, ex.:
+	// {"event":"subscribe","arg":{"instType":"SP","channel":"ticker","instId":"BTCUSDT"}}
+	BitgetTickerMsg struct {
+		Event   string         `json:"event"`
+		Code    int64          `json:"code"`
 		Message string         `json:"msg"`
 		Data    []BitgetTicker `json:"data"`
 	}
 
 	BitgetTicker struct {
-		Symbol string `json:"symbol"`  // ex.: "BTCUSD"
-		Price  string `json:"close"`   // ex.: "24014.11"
+		InstId string `json:"instId"`     // ex.: "BTCUSDT"
+		Price  string `json:"last"`       // ex.: "24014.11"
+		Volume string `json:"baseVolume"` // ex.: "7421.5009"
+		Bid    string `json:"bestBid"`    // ex.: "24014.1"
+		Ask    string `json:"bestAsk"`    // ex.: "24014.12"
+		Time   int64  `json:"ts"`         // ex.: 1660704288118
+	}
+
+	BitgetCandlesResponse struct {
+		Code string         `json:"code"`
+		Data []BitgetCandle `json:"data"`
+	}
+
+	BitgetCandle struct {
 		Volume string `json:"baseVol"` // ex.: "7421.5009"
-		Time   string `json:"ts"`      // ex.: "1660704288118"
+		Time   string `json:"ts"`      // ex.: "1660704000000"
 	}
 )
 
@@ -49,53 +86,124 @@ func NewBitgetProvider(
 	endpoints Endpoint,
 	pairs ...types.CurrencyPair,
 ) (*BitgetProvider, error) {
-	provider := &BitgetProvider{}
+	provider := &BitgetProvider{
+		volumes: make(map[string]sdk.Dec, len(pairs)),
+	}
 	provider.Init(
 		ctx,
 		endpoints,
 		logger,
 		pairs,
-		nil,
-		nil,
+		provider.messageReceived,
+		provider.getSubscriptionMsgs,
 	)
-	go startPolling(provider, provider.endpoints.PollInterval, logger)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
 	return provider, nil
 }
 
-func (p *BitgetProvider) Poll() error {
-	content, err := p.httpGet("/api/spot/v1/market/tickers")
-	if err != nil {
-		return err
+func (p *BitgetProvider) getSubscriptionMsgs(pairs ...types.CurrencyPair) []interface{} {
+	args := make([]BitgetSubscriptionArg, len(pairs))
+	for i, pair := range pairs {
+		args[i] = BitgetSubscriptionArg{
+			InstType: "SP",
+			Channel:  "ticker",
+			InstId:   pair.String(),
+		}
+	}
+	return []interface{}{
+		BitgetSubscriptionMsg{
+			Op:   "subscribe",
+			Args: args,
+		},
 	}
+}
 
-	var tickers BitgetTickersResponse
-	err = json.Unmarshal(content, &tickers)
+func (p *BitgetProvider) messageReceived(messageType int, bz []byte) {
+	var tickerMsg BitgetTickerMsg
+	err := json.Unmarshal(bz, &tickerMsg)
 	if err != nil {
-		return err
+		p.logger.Error().Err(err).Msg("failed to unmarshal message")
+		return
+	}
+
+	if tickerMsg.Event == "error" {
+		p.logger.Error().
+			Int64("code", tickerMsg.Code).
+			Str("msg", tickerMsg.Message).
+			Msg("received error message")
+		return
 	}
 
 	p.mtx.Lock()
 	defer p.mtx.Unlock()
-	for _, ticker := range tickers.Data {
-		_, ok := p.pairs[ticker.Symbol]
-		if !ok {
+
+	for _, ticker := range tickerMsg.Data {
+		if _, ok := p.pairs[ticker.InstId]; !ok {
 			continue
 		}
 
-		timestamp, err := strconv.ParseInt(ticker.Time, 0, 64)
-		if err != nil {
-			p.logger.
-				Err(err).
-				Msg("failed parsing timestamp")
-			continue
+		volume, ok := p.volumes[ticker.InstId]
+		if !ok {
+			volume = strToDec(ticker.Volume)
 		}
 
-		p.tickers[ticker.Symbol] = types.TickerPrice{
+		p.tickers[ticker.InstId] = types.TickerPrice{
 			Price:  strToDec(ticker.Price),
-			Volume: strToDec(ticker.Volume),
-			Time:   time.UnixMilli(timestamp),
+			Volume: volume,
+			Time:   time.UnixMilli(ticker.Time),
+			Spread: computeSpread(strToDec(ticker.Bid), strToDec(ticker.Ask)),
 		}
 	}
-	p.logger.Debug().Msg("updated tickers")
+}
+
+// Poll updates the 24h volumes using the hourly candles of every pair.
+func (p *BitgetProvider) Poll() error {
+	for symbol, pair := range p.pairs {
+		volume, err := p.getVolume(pair)
+		if err != nil {
+			p.logger.Warn().Err(err).Str("pair", symbol).Msg("failed to get volume")
+			continue
+		}
+
+		p.mtx.Lock()
+		p.volumes[symbol] = volume
+		p.mtx.Unlock()
+	}
+	p.logger.Debug().Msg("updated volumes")
 	return nil
 }
+
+// getVolume sums up the volume of the hourly candles of the last 24h.
+func (p *BitgetProvider) getVolume(pair types.CurrencyPair) (sdk.Dec, error) {
+	path := fmt.Sprintf(
+		"/api/spot/v1/market/candles?symbol=%s&period=1h&limit=24",
+		p.CurrencyPairToProviderPair(pair),
+	)
+	content, err := p.httpGet(path)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	var candles BitgetCandlesResponse
+	err = json.Unmarshal(content, &candles)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	cutoff := time.Now().Add(-24 * time.Hour).UnixMilli()
+	volume := sdk.ZeroDec()
+	for _, candle := range candles.Data {
+		timestamp, err := strconv.ParseInt(candle.Time, 10, 64)
+		if err != nil || timestamp < cutoff {
+			continue
+		}
+		volume = volume.Add(strToDec(candle.Volume))
+	}
+	return volume, nil
+}
+
+// CurrencyPairToProviderPair returns the symbol of the REST API, ex.:
+// "BTCUSDT_SPBL". The websocket API uses the plain symbol, ex.: "BTCUSDT".
+func (p *BitgetProvider) CurrencyPairToProviderPair(pair types.CurrencyPair) string {
+	return pair.String() + "_SPBL"
+}
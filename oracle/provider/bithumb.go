@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	"github.com/rs/zerolog"
+)
+
+var (
+	_                       Provider = (*BithumbProvider)(nil)
+	bithumbDefaultEndpoints          = Endpoint{
+		Name:         ProviderBithumb,
+		Urls:         []string{"https://api.bithumb.com"},
+		PollInterval: 3 * time.Second,
+	}
+)
+
+type (
+	// BithumbProvider defines an oracle provider implemented by the Bithumb
+	// public API. Its markets are quoted in KRW, which is converted to USD
+	// using the KRW/USD rate of the fx provider.
+	//
+	// REF: https://apidocs.bithumb.com/reference
+	BithumbProvider struct {
+		provider
+	}
+
+	// BithumbTickersResponse defines the tickers of all markets of a quote,
+	// with the "date" field mixed into the tickers, ex.:
+	// {"status":"0000","data":{"ATOM":{...},"date":"1677666151422"}}
+	BithumbTickersResponse struct {
+		Status  string                     `json:"status"` // ex.: "0000"
+		Message string                     `json:"message"`
+		Data    map[string]json.RawMessage `json:"data"`
+	}
+
+	BithumbTicker struct {
+		Price  string `json:"closing_price"`    // ex.: "14470"
+		Volume string `json:"units_traded_24H"` // ex.: "162378.79874311"
+	}
+)
+
+func NewBithumbProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*BithumbProvider, error) {
+	provider := &BithumbProvider{}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		nil,
+		nil,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
+
+func (p *BithumbProvider) Poll() error {
+	quotes := map[string]struct{}{}
+	for _, pair := range p.pairs {
+		quotes[pair.Quote] = struct{}{}
+	}
+
+	for quote := range quotes {
+		err := p.pollQuote(quote)
+		if err != nil {
+			return err
+		}
+	}
+
+	p.logger.Debug().Msg("updated tickers")
+	return nil
+}
+
+// pollQuote updates the tickers of all markets of the given quote.
+func (p *BithumbProvider) pollQuote(quote string) error {
+	content, err := p.httpGet("/public/ticker/ALL_" + quote)
+	if err != nil {
+		return err
+	}
+
+	var tickersResponse BithumbTickersResponse
+	err = json.Unmarshal(content, &tickersResponse)
+	if err != nil {
+		return err
+	}
+
+	if tickersResponse.Status != "0000" {
+		return fmt.Errorf(
+			"invalid status: %s: %s",
+			tickersResponse.Status,
+			tickersResponse.Message,
+		)
+	}
+
+	var date string
+	err = json.Unmarshal(tickersResponse.Data["date"], &date)
+	if err != nil {
+		return err
+	}
+
+	timestamp, err := strconv.ParseInt(date, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	for base, bz := range tickersResponse.Data {
+		symbol := base + quote
+		if _, ok := p.pairs[symbol]; !ok {
+			continue
+		}
+
+		var ticker BithumbTicker
+		err = json.Unmarshal(bz, &ticker)
+		if err != nil {
+			p.logger.Error().Err(err).Str("pair", symbol).Msg("failed to unmarshal ticker")
+			continue
+		}
+
+		p.tickers[symbol] = types.TickerPrice{
+			Price:  strToDec(ticker.Price),
+			Volume: strToDec(ticker.Volume),
+			Time:   time.UnixMilli(timestamp),
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBithumbProvider_Poll(t *testing.T) {
+	server := newTestServer(func(rw http.ResponseWriter, req *http.Request) {
+		_, _ = rw.Write([]byte(`{"status":"0000","data":{"ATOM":{"closing_price":"14470","units_traded_24H":"162378.79"},"BTC":{"closing_price":"30000000","units_traded_24H":"1000"},"date":"1677666151422"}}`))
+	})
+	defer server.Close()
+
+	atomKrw := types.CurrencyPair{Base: "ATOM", Quote: "KRW"}
+
+	p := newTestProvider(t, NewBithumbProvider, server, Endpoint{
+		Name: ProviderBithumb,
+	}, atomKrw)
+
+	require.NoError(t, p.Poll())
+	require.Len(t, p.tickers, 1)
+	require.Equal(t, sdk.MustNewDecFromStr("14470"), p.tickers["ATOMKRW"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("162378.79"), p.tickers["ATOMKRW"].Volume)
+	require.Equal(t, int64(1677666151422), p.tickers["ATOMKRW"].Time.UnixMilli())
+
+	requests := server.Requests()
+	require.Len(t, requests, 1)
+	require.Equal(t, "/public/ticker/ALL_KRW", requests[0].URL.Path)
+}
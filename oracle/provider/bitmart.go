@@ -3,31 +3,70 @@ package provider
 import (
 	"context"
 	"encoding/json"
+	"strings"
 	"time"
 
 	"price-feeder/oracle/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gorilla/websocket"
 	"github.com/rs/zerolog"
 )
 
+const (
+	// bitmartFallbackCutoff defines the age of the websocket tickers after
+	// which they're replaced by the REST tickers.
+	bitmartFallbackCutoff = 30 * time.Second
+)
+
 var (
 	_                       Provider = (*BitmartProvider)(nil)
 	bitmartDefaultEndpoints          = Endpoint{
-		Name:         ProviderBitmart,
-		Urls:         []string{"https://api-cloud.bitmart.com"},
-		PollInterval: 6 * time.Second,
+		Name:          ProviderBitmart,
+		Urls:          []string{"https://api-cloud.bitmart.com"},
+		PollInterval:  10 * time.Second,
+		Websocket:     "ws-manager-compress.bitmart.com",
+		WebsocketPath: "/api",
+		PingDuration:  15 * time.Second,
+		PingType:      websocket.TextMessage,
+		PingMessage:   "ping",
 	}
 )
 
 type (
 	// BitmartProvider defines an oracle provider implemented by the BitMart
-	// public API.
+	// public websocket API. Tickers without recent websocket updates, ex.:
+	// right after subscribing, are backfilled from the REST API.
 	//
-	// REF: https://developer-pro.bitmart.com/en/spot
+	// REF: https://developer-pro.bitmart.com/en/spot/#public-ticker-channel
+	// REF: https://developer-pro.bitmart.com/en/spot/#get-ticker-of-all-pairs-v2
 	BitmartProvider struct {
 		provider
 	}
 
+	BitmartSubscriptionMsg struct {
+		Op   string   `json:"op"`   // ex.: "subscribe"
+		Args []string `json:"args"` // ex.: ["spot/ticker:BTC_USDT"]
+	}
+
+	// BitmartTickerMsg defines a ticker update or error, ex.:
+	// {"event":"subscribe","errorCode":"90004","errorMessage":"..."}
+	BitmartTickerMsg struct {
+		Table        string            `json:"table"` // ex.: "spot/ticker"
+		ErrorCode    string            `json:"errorCode"`
+		ErrorMessage string            `json:"errorMessage"`
+		Data         []BitmartWsTicker `json:"data"`
+	}
+
+	BitmartWsTicker struct {
+		Symbol string `json:"symbol"`          // ex.: "BTC_USDT"
+		Price  string `json:"last_price"`      // ex.: "23117.40"
+		Volume string `json:"base_volume_24h"` // ex.: "20674.35254"
+		Bid    string `json:"best_bid"`        // ex.: "23117.39"
+		Ask    string `json:"best_ask"`        // ex.: "23117.41"
+		Time   int64  `json:"ms_t"`            // ex.: 1675862097605
+	}
+
 	BitmartTickersResponse struct {
 		Data BitmartTickersData `json:"data"`
 	}
@@ -56,13 +95,67 @@ func NewBitmartProvider(
 		endpoints,
 		logger,
 		pairs,
-		nil,
-		nil,
+		provider.messageReceived,
+		provider.getSubscriptionMsgs,
 	)
-	go startPolling(provider, provider.endpoints.PollInterval, logger)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
 	return provider, nil
 }
 
+func (p *BitmartProvider) getSubscriptionMsgs(pairs ...types.CurrencyPair) []interface{} {
+	args := make([]string, len(pairs))
+	for i, pair := range pairs {
+		args[i] = "spot/ticker:" + pair.Join("_")
+	}
+	return []interface{}{
+		BitmartSubscriptionMsg{
+			Op:   "subscribe",
+			Args: args,
+		},
+	}
+}
+
+func (p *BitmartProvider) messageReceived(messageType int, bz []byte) {
+	var tickerMsg BitmartTickerMsg
+	err := json.Unmarshal(bz, &tickerMsg)
+	if err != nil {
+		p.logger.Error().Err(err).Msg("failed to unmarshal message")
+		return
+	}
+
+	if tickerMsg.ErrorCode != "" {
+		p.logger.Error().
+			Str("code", tickerMsg.ErrorCode).
+			Str("msg", tickerMsg.ErrorMessage).
+			Msg("received error message")
+		return
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	for _, ticker := range tickerMsg.Data {
+		symbol := p.ProviderPairToCurrencyPair(ticker.Symbol).String()
+		if _, ok := p.pairs[symbol]; !ok {
+			continue
+		}
+
+		spread := sdk.Dec{}
+		if ticker.Bid != "" && ticker.Ask != "" {
+			spread = computeSpread(strToDec(ticker.Bid), strToDec(ticker.Ask))
+		}
+
+		p.tickers[symbol] = types.TickerPrice{
+			Price:  strToDec(ticker.Price),
+			Volume: strToDec(ticker.Volume),
+			Time:   time.UnixMilli(ticker.Time),
+			Spread: spread,
+		}
+	}
+}
+
+// Poll backfills the tickers which weren't updated via the websocket recently
+// using the REST API.
 func (p *BitmartProvider) Poll() error {
 	symbols := make(map[string]string, len(p.pairs))
 	for _, pair := range p.pairs {
@@ -89,12 +182,29 @@ func (p *BitmartProvider) Poll() error {
 			continue
 		}
 
+		current, ok := p.tickers[symbol]
+		if ok && time.Since(current.Time) <= bitmartFallbackCutoff {
+			continue
+		}
+
 		p.tickers[symbol] = types.TickerPrice{
 			Price:  strToDec(ticker.Price),
 			Volume: strToDec(ticker.Volume),
 			Time:   time.UnixMilli(ticker.Time),
 		}
 	}
-	p.logger.Debug().Msg("updated tickers")
+	p.logger.Debug().Msg("backfilled tickers")
 	return nil
 }
+
+func (p *BitmartProvider) ProviderPairToCurrencyPair(pair string) types.CurrencyPair {
+	tokens := strings.Split(pair, "_")
+	if len(tokens) != 2 {
+		p.logger.Warn().Str("pair", pair).Msg("failed to convert to currency pair")
+		return types.CurrencyPair{}
+	}
+	return types.CurrencyPair{
+		Base:  tokens[0],
+		Quote: tokens[1],
+	}
+}
@@ -0,0 +1,213 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+)
+
+const (
+	// bitstampFallbackCutoff defines the age of the websocket tickers after
+	// which they're replaced by the REST tickers.
+	bitstampFallbackCutoff = 30 * time.Second
+)
+
+var (
+	_                        Provider = (*BitstampProvider)(nil)
+	bitstampDefaultEndpoints          = Endpoint{
+		Name:         ProviderBitstamp,
+		Urls:         []string{"https://www.bitstamp.net"},
+		PollInterval: 10 * time.Second,
+		Websocket:    "ws.bitstamp.net",
+	}
+)
+
+type (
+	// BitstampProvider defines an oracle provider implemented by the Bitstamp
+	// public API. The prices are updated using the live trades websocket
+	// channel, while the 24h volumes are polled from the REST tickers. Pairs
+	// without any recent trades fall back to the price of the REST tickers.
+	//
+	// REF: https://www.bitstamp.net/websocket/v2
+	// REF: https://www.bitstamp.net/api/#ticker
+	BitstampProvider struct {
+		provider
+	}
+
+	BitstampSubscriptionMsg struct {
+		Event string                   `json:"event"` // ex.: "bts:subscribe"
+		Data  BitstampSubscriptionData `json:"data"`
+	}
+
+	BitstampSubscriptionData struct {
+		Channel string `json:"channel"` // ex.: "live_trades_btcusd"
+	}
+
+	BitstampTradeMsg struct {
+		Event   string        `json:"event"`   // ex.: "trade"
+		Channel string        `json:"channel"` // ex.: "live_trades_btcusd"
+		Data    BitstampTrade `json:"data"`
+	}
+
+	BitstampTrade struct {
+		Price          string `json:"price_str"`      // ex.: "23012.5"
+		MicroTimestamp string `json:"microtimestamp"` // ex.: "1677666151422640"
+	}
+
+	BitstampTicker struct {
+		Pair      string `json:"pair"`      // ex.: "BTC/USD"
+		Price     string `json:"last"`      // ex.: "23012.5"
+		Volume    string `json:"volume"`    // ex.: "1621.20941431"
+		Bid       string `json:"bid"`       // ex.: "23010"
+		Ask       string `json:"ask"`       // ex.: "23014"
+		Timestamp string `json:"timestamp"` // ex.: "1677666151"
+	}
+)
+
+func NewBitstampProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*BitstampProvider, error) {
+	provider := &BitstampProvider{}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		provider.messageReceived,
+		provider.getSubscriptionMsgs,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
+
+func (p *BitstampProvider) getSubscriptionMsgs(pairs ...types.CurrencyPair) []interface{} {
+	msgs := make([]interface{}, len(pairs))
+	for i, pair := range pairs {
+		msgs[i] = BitstampSubscriptionMsg{
+			Event: "bts:subscribe",
+			Data: BitstampSubscriptionData{
+				Channel: "live_trades_" + p.CurrencyPairToProviderPair(pair),
+			},
+		}
+	}
+	return msgs
+}
+
+func (p *BitstampProvider) messageReceived(messageType int, bz []byte) {
+	var tradeMsg BitstampTradeMsg
+	err := json.Unmarshal(bz, &tradeMsg)
+	if err != nil {
+		p.logger.Error().Err(err).Msg("failed to unmarshal message")
+		return
+	}
+
+	// subscription acks are sent as "bts:subscription_succeeded" events
+	if tradeMsg.Event != "trade" {
+		return
+	}
+
+	symbol := strings.ToUpper(strings.TrimPrefix(tradeMsg.Channel, "live_trades_"))
+
+	microTimestamp, err := strconv.ParseInt(tradeMsg.Data.MicroTimestamp, 10, 64)
+	if err != nil {
+		p.logger.Error().Err(err).Msg("failed parsing timestamp")
+		return
+	}
+	price, err := decFromString(tradeMsg.Data.Price)
+	if err != nil {
+		p.logger.Error().Err(err).Msg("failed parsing price")
+		return
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	if _, ok := p.pairs[symbol]; !ok {
+		return
+	}
+
+	// the volume and spread are updated by the REST tickers
+	ticker, ok := p.tickers[symbol]
+	if !ok {
+		ticker.Volume = sdk.ZeroDec()
+	}
+	ticker.Price = price
+	ticker.Time = time.UnixMicro(microTimestamp)
+	p.tickers[symbol] = ticker
+}
+
+// Poll updates the volumes and spreads of the tickers, as well as the prices
+// of tickers without recent trades.
+func (p *BitstampProvider) Poll() error {
+	content, err := p.httpGet("/api/v2/ticker/")
+	if err != nil {
+		return err
+	}
+
+	var tickers []BitstampTicker
+	err = json.Unmarshal(content, &tickers)
+	if err != nil {
+		return err
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	for _, bitstampTicker := range tickers {
+		symbol := strings.ReplaceAll(bitstampTicker.Pair, "/", "")
+		if _, ok := p.pairs[symbol]; !ok {
+			continue
+		}
+
+		volume, err := decFromString(bitstampTicker.Volume)
+		if err != nil {
+			p.logger.Warn().Err(err).Str("pair", symbol).Msg("failed to parse volume")
+			continue
+		}
+		bid, err := decFromString(bitstampTicker.Bid)
+		if err != nil {
+			p.logger.Warn().Err(err).Str("pair", symbol).Msg("failed to parse bid")
+			continue
+		}
+		ask, err := decFromString(bitstampTicker.Ask)
+		if err != nil {
+			p.logger.Warn().Err(err).Str("pair", symbol).Msg("failed to parse ask")
+			continue
+		}
+
+		ticker, ok := p.tickers[symbol]
+		if !ok || time.Since(ticker.Time) > bitstampFallbackCutoff {
+			timestamp, err := strconv.ParseInt(bitstampTicker.Timestamp, 10, 64)
+			if err != nil {
+				p.logger.Error().Err(err).Msg("failed parsing timestamp")
+				continue
+			}
+			price, err := decFromString(bitstampTicker.Price)
+			if err != nil {
+				p.logger.Warn().Err(err).Str("pair", symbol).Msg("failed to parse price")
+				continue
+			}
+			ticker.Price = price
+			ticker.Time = time.Unix(timestamp, 0)
+		}
+		ticker.Volume = volume
+		ticker.Spread = computeSpread(bid, ask)
+		p.tickers[symbol] = ticker
+	}
+	p.logger.Debug().Msg("updated tickers")
+	return nil
+}
+
+func (p *BitstampProvider) CurrencyPairToProviderPair(pair types.CurrencyPair) string {
+	return strings.ToLower(pair.String())
+}
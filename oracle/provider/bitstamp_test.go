@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBitstampProvider_Poll(t *testing.T) {
+	server := newTestServer(func(rw http.ResponseWriter, req *http.Request) {
+		_, _ = rw.Write([]byte(`[
+			{"pair":"ATOM/USD","last":"10.1","volume":"1000","bid":"9.9","ask":"10.1","timestamp":"1677666151"},
+			{"pair":"BTC/USD","last":"23012.5","volume":"1621.2","bid":"","ask":"23014","timestamp":"1677666151"}
+		]`))
+	})
+	defer server.Close()
+
+	atomUsd := types.CurrencyPair{Base: "ATOM", Quote: "USD"}
+	btcUsd := types.CurrencyPair{Base: "BTC", Quote: "USD"}
+
+	p := newTestProvider(t, NewBitstampProvider, server, Endpoint{
+		Name: ProviderBitstamp,
+	}, atomUsd, btcUsd)
+
+	// tickers with an empty bid are skipped
+	require.NoError(t, p.Poll())
+	require.Len(t, p.tickers, 1)
+	require.Equal(t, sdk.MustNewDecFromStr("10.1"), p.tickers["ATOMUSD"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("1000"), p.tickers["ATOMUSD"].Volume)
+	require.Equal(t, sdk.MustNewDecFromStr("0.02"), p.tickers["ATOMUSD"].Spread)
+
+	// trades with an invalid price are ignored
+	p.messageReceived(1, []byte(`{"event":"trade","channel":"live_trades_btcusd","data":{"price_str":"","microtimestamp":"1677666151422640"}}`))
+	require.NotContains(t, p.tickers, "BTCUSD")
+
+	p.messageReceived(1, []byte(`{"event":"trade","channel":"live_trades_btcusd","data":{"price_str":"23012.5","microtimestamp":"1677666151422640"}}`))
+	require.Equal(t, sdk.MustNewDecFromStr("23012.5"), p.tickers["BTCUSD"].Price)
+}
@@ -55,7 +55,7 @@ func NewBkexProvider(
 		nil,
 		nil,
 	)
-	go startPolling(provider, provider.endpoints.PollInterval, logger)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
 	return provider, nil
 }
 
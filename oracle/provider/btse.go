@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	"github.com/rs/zerolog"
+)
+
+var (
+	_                    Provider = (*BtseProvider)(nil)
+	btseDefaultEndpoints          = Endpoint{
+		Name:         ProviderBtse,
+		Urls:         []string{"https://api.btse.com"},
+		PollInterval: 3 * time.Second,
+	}
+)
+
+type (
+	// BtseProvider defines an oracle provider implemented by the BTSE public
+	// spot API.
+	//
+	// REF: https://btsecom.github.io/docs/spotV3_2/en/#market-summary
+	BtseProvider struct {
+		provider
+	}
+
+	BtseTicker struct {
+		Symbol string  `json:"symbol"`     // ex.: "ATOM-USDT"
+		Price  float64 `json:"last"`       // ex.: 13.61
+		Volume float64 `json:"size"`       // Total traded base asset volume ex.: 433812.95
+		Bid    float64 `json:"highestBid"` // ex.: 13.6
+		Ask    float64 `json:"lowestAsk"`  // ex.: 13.62
+	}
+)
+
+func NewBtseProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*BtseProvider, error) {
+	provider := &BtseProvider{}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		nil,
+		nil,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
+
+func (p *BtseProvider) Poll() error {
+	content, err := p.httpGet("/spot/api/v3.2/market_summary")
+	if err != nil {
+		return err
+	}
+
+	var tickers []BtseTicker
+	err = json.Unmarshal(content, &tickers)
+	if err != nil {
+		return err
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	timestamp := time.Now()
+
+	for _, ticker := range tickers {
+		symbol := strings.ReplaceAll(ticker.Symbol, "-", "")
+		if _, ok := p.pairs[symbol]; !ok {
+			continue
+		}
+
+		p.tickers[symbol] = types.TickerPrice{
+			Price:  floatToDec(ticker.Price),
+			Volume: floatToDec(ticker.Volume),
+			Time:   timestamp,
+			Spread: computeSpread(floatToDec(ticker.Bid), floatToDec(ticker.Ask)),
+		}
+	}
+
+	p.logger.Debug().Msg("updated tickers")
+	return nil
+}
+
+func (p *BtseProvider) CurrencyPairToProviderPair(pair types.CurrencyPair) string {
+	return pair.Join("-")
+}
@@ -7,25 +7,59 @@ import (
 
 	"price-feeder/oracle/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gorilla/websocket"
 	"github.com/rs/zerolog"
 )
 
+const (
+	// bybitMaxSubscriptionArgs defines the amount of topics per subscription
+	// message Bybit accepts for spot.
+	bybitMaxSubscriptionArgs = 10
+)
+
 var (
 	_                     Provider = (*BybitProvider)(nil)
 	bybitDefaultEndpoints          = Endpoint{
-		Name:         ProviderBybit,
-		Urls:         []string{"https://api.bybit.com", "https://api.bytick.com"},
-		PollInterval: 2 * time.Second,
+		Name:          ProviderBybit,
+		Urls:          []string{"https://api.bybit.com", "https://api.bytick.com"},
+		PollInterval:  time.Minute,
+		Websocket:     "stream.bybit.com",
+		WebsocketPath: "/v5/public/spot",
+		PingDuration:  20 * time.Second,
+		PingType:      websocket.TextMessage,
+		PingMessage:   `{"op":"ping"}`,
 	}
 )
 
 type (
-	// BybitProvider defines an oracle provider implemented by the ByBit
-	// public API.
+	// BybitProvider defines an oracle provider implemented by the Bybit v5
+	// public websocket API. The 24h volumes are polled from the REST API as
+	// a fallback for tickers without a volume.
 	//
-	// REF: https://docs.kujira.app/dapps-and-infrastructure/fin/coingecko-api
+	// REF: https://bybit-exchange.github.io/docs/v5/websocket/public/ticker
 	BybitProvider struct {
 		provider
+		volumes map[string]sdk.Dec
+	}
+
+	BybitSubscriptionMsg struct {
+		Op   string   `json:"op"`   // ex.: "subscribe"
+		Args []string `json:"args"` // ex.: ["tickers.BTCUSDT"]
+	}
+
+	// BybitOpMsg defines the responses to operations, ex.:
+	// {"success":true,"ret_msg":"pong","op":"ping"}
+	BybitOpMsg struct {
+		Op      string `json:"op"`
+		Success bool   `json:"success"`
+		Message string `json:"ret_msg"`
+	}
+
+	BybitTickerMsg struct {
+		Topic string      `json:"topic"` // ex.: "tickers.BTCUSDT"
+		Time  int64       `json:"ts"`    // ex.: 1673853746003
+		Data  BybitTicker `json:"data"`
 	}
 
 	BybitTickersResponse struct {
@@ -49,19 +83,89 @@ func NewBybitProvider(
 	endpoints Endpoint,
 	pairs ...types.CurrencyPair,
 ) (*BybitProvider, error) {
-	provider := &BybitProvider{}
+	provider := &BybitProvider{
+		volumes: make(map[string]sdk.Dec, len(pairs)),
+	}
 	provider.Init(
 		ctx,
 		endpoints,
 		logger,
 		pairs,
-		nil,
-		nil,
+		provider.messageReceived,
+		provider.getSubscriptionMsgs,
 	)
-	go startPolling(provider, provider.endpoints.PollInterval, logger)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
 	return provider, nil
 }
 
+func (p *BybitProvider) getSubscriptionMsgs(pairs ...types.CurrencyPair) []interface{} {
+	msgs := []interface{}{}
+	for i := 0; i < len(pairs); i += bybitMaxSubscriptionArgs {
+		end := i + bybitMaxSubscriptionArgs
+		if end > len(pairs) {
+			end = len(pairs)
+		}
+		args := []string{}
+		for _, pair := range pairs[i:end] {
+			args = append(args, "tickers."+pair.String())
+		}
+		msgs = append(msgs, BybitSubscriptionMsg{
+			Op:   "subscribe",
+			Args: args,
+		})
+	}
+	return msgs
+}
+
+func (p *BybitProvider) messageReceived(messageType int, bz []byte) {
+	var opMsg BybitOpMsg
+	err := json.Unmarshal(bz, &opMsg)
+	if err != nil {
+		p.logger.Error().Err(err).Msg("failed to unmarshal message")
+		return
+	}
+
+	if opMsg.Op != "" {
+		if !opMsg.Success {
+			p.logger.Error().
+				Str("op", opMsg.Op).
+				Str("msg", opMsg.Message).
+				Msg("operation failed")
+		}
+		return
+	}
+
+	var tickerMsg BybitTickerMsg
+	err = json.Unmarshal(bz, &tickerMsg)
+	if err != nil {
+		p.logger.Error().Err(err).Msg("failed to unmarshal ticker")
+		return
+	}
+
+	ticker := tickerMsg.Data
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	if _, ok := p.pairs[ticker.Symbol]; !ok || ticker.Price == "" {
+		return
+	}
+
+	volume, ok := p.volumes[ticker.Symbol]
+	if ticker.Volume != "" {
+		volume = strToDec(ticker.Volume)
+	} else if !ok {
+		volume = sdk.ZeroDec()
+	}
+
+	p.tickers[ticker.Symbol] = types.TickerPrice{
+		Price:  strToDec(ticker.Price),
+		Volume: volume,
+		Time:   time.UnixMilli(tickerMsg.Time),
+	}
+}
+
+// Poll updates the 24h volumes, which are used for tickers without a volume.
 func (p *BybitProvider) Poll() error {
 	content, err := p.httpGet("/v5/market/tickers?category=spot")
 	if err != nil {
@@ -74,8 +178,6 @@ func (p *BybitProvider) Poll() error {
 		return err
 	}
 
-	timestamp := time.Now()
-
 	p.mtx.Lock()
 	defer p.mtx.Unlock()
 
@@ -85,12 +187,8 @@ func (p *BybitProvider) Poll() error {
 			continue
 		}
 
-		p.tickers[ticker.Symbol] = types.TickerPrice{
-			Price:  strToDec(ticker.Price),
-			Volume: strToDec(ticker.Volume),
-			Time:   timestamp,
-		}
+		p.volumes[ticker.Symbol] = strToDec(ticker.Volume)
 	}
-	p.logger.Debug().Msg("updated tickers")
+	p.logger.Debug().Msg("updated volumes")
 	return nil
 }
@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+)
+
+const (
+	// camelotFactory defines the address of the Camelot V3 (Algebra) factory
+	// on Arbitrum.
+	camelotFactory = "0x1a3c9b1d2f0529d97f2afc5136cc23e58f1fd35b"
+)
+
+var (
+	_                       Provider = (*CamelotProvider)(nil)
+	camelotDefaultEndpoints          = Endpoint{
+		Name:         ProviderCamelot,
+		Urls:         []string{"https://arb1.arbitrum.io/rpc"},
+		PollInterval: 15 * time.Second,
+	}
+)
+
+type (
+	// CamelotProvider defines an oracle provider reading the spot prices of
+	// Camelot V3 pools on Arbitrum, which are Algebra concentrated liquidity
+	// pools with a single pool per pair. The price is derived from the current
+	// sqrt price of the pool's global state. The `contracts` of the provider
+	// endpoints map the denoms to their token addresses. The base token
+	// balance of the pool is reported as volume.
+	//
+	// REF: https://docs.algebra.finance/algebra-integral-documentation/algebra-v1-technical-reference/contracts/core/pool
+	CamelotProvider struct {
+		provider
+		decimals *evmDecimalsCache
+	}
+)
+
+func NewCamelotProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*CamelotProvider, error) {
+	provider := &CamelotProvider{
+		decimals: newEvmDecimalsCache(),
+	}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		nil,
+		nil,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
+
+func (p *CamelotProvider) Poll() error {
+	for symbol, pair := range p.pairs {
+		ticker, err := p.getTicker(pair)
+		if err != nil {
+			p.logger.Warn().Err(err).Str("pair", symbol).Msg("failed to get pool state")
+			continue
+		}
+
+		p.mtx.Lock()
+		p.tickers[symbol] = ticker
+		p.mtx.Unlock()
+	}
+
+	p.logger.Debug().Msg("updated tickers")
+	return nil
+}
+
+func (p *CamelotProvider) getTicker(pair types.CurrencyPair) (types.TickerPrice, error) {
+	base, ok := p.endpoints.Contracts[pair.Base]
+	if !ok {
+		return types.TickerPrice{}, fmt.Errorf("no contract configured for %s", pair.Base)
+	}
+	quote, ok := p.endpoints.Contracts[pair.Quote]
+	if !ok {
+		return types.TickerPrice{}, fmt.Errorf("no contract configured for %s", pair.Quote)
+	}
+	base = strings.ToLower(base)
+	quote = strings.ToLower(quote)
+
+	baseWord, err := evmEncodeAddress(base)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+	quoteWord, err := evmEncodeAddress(quote)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	result, err := p.evmCall(camelotFactory, evmEncodeCall("poolByPair(address,address)", baseWord, quoteWord))
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+	pool, err := evmDecodeAddress(result, 0)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+	if pool == "0x0000000000000000000000000000000000000000" {
+		return types.TickerPrice{}, fmt.Errorf("no pool found")
+	}
+
+	// the first value of the global state is the current sqrt price
+	result, err = p.evmCall(pool, evmEncodeCall("globalState()"))
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+	sqrtPrice, err := evmDecodeUint(result, 0)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+	if sqrtPrice.Sign() == 0 {
+		return types.TickerPrice{}, fmt.Errorf("pool %s isn't initialized", pool)
+	}
+
+	baseDecimals, err := p.decimals.get(&p.provider, pair.Base, base)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+	quoteDecimals, err := p.decimals.get(&p.provider, pair.Quote, quote)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	balance, err := p.evmBalanceOf(base, pool)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	// the token with the lower address is token0
+	var price sdk.Dec
+	if base < quote {
+		price = sqrtPriceToDec(sqrtPrice, 96, baseDecimals-quoteDecimals)
+	} else {
+		price = sqrtPriceToDec(sqrtPrice, 96, quoteDecimals-baseDecimals)
+		if !price.IsPositive() {
+			return types.TickerPrice{}, fmt.Errorf("price of pool %s out of range", pool)
+		}
+		price = sdk.OneDec().Quo(price)
+	}
+
+	return types.TickerPrice{
+		Price:  price,
+		Volume: bigIntToDec(balance, baseDecimals),
+		Time:   time.Now(),
+	}, nil
+}
@@ -0,0 +1,24 @@
+package provider
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSqrtPriceToDec(t *testing.T) {
+	q96 := new(big.Int).Lsh(big.NewInt(1), 96)
+
+	require.Equal(t, sdk.OneDec(), sqrtPriceToDec(q96, 96, 0))
+	require.Equal(t, sdk.NewDec(1000000000000), sqrtPriceToDec(q96, 96, 12))
+	require.Equal(t, sdk.MustNewDecFromStr("0.000000000001"), sqrtPriceToDec(q96, 96, -12))
+
+	// twice the sqrt price quadruples the price
+	require.Equal(t, sdk.NewDec(4), sqrtPriceToDec(new(big.Int).Lsh(q96, 1), 96, 0))
+
+	// Q64.64 sqrt prices
+	q64 := new(big.Int).Lsh(big.NewInt(1), 64)
+	require.Equal(t, sdk.NewDec(1000), sqrtPriceToDec(q64, 64, 3))
+}
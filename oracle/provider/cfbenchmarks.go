@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+)
+
+var (
+	_                            Provider = (*CfBenchmarksProvider)(nil)
+	cfBenchmarksDefaultEndpoints          = Endpoint{
+		Name:         ProviderCfBenchmarks,
+		Urls:         []string{"https://www.cfbenchmarks.com"},
+		PollInterval: 5 * time.Second,
+	}
+)
+
+type (
+	// CfBenchmarksProvider defines an oracle provider implemented by the CF
+	// Benchmarks distribution API, which publishes the CME CF Bitcoin and Ether
+	// benchmarks. The daily reference rates (BRR, ETHUSD_RR) are only
+	// published once a day and would be considered stale, so the real time
+	// indices they're calculated from (BRTI, ETHUSD_RTI) are used instead.
+	// The API requires credentials, which are set as the `api_key` of the
+	// provider endpoints in the "user:password" format. Indices don't have a
+	// volume, so their tickers are reported with a volume of one and are best
+	// used with the `referenceOnly` role.
+	//
+	// REF: https://www.cfbenchmarks.com/data/api
+	CfBenchmarksProvider struct {
+		provider
+	}
+
+	CfBenchmarksValuesResponse struct {
+		Payload []CfBenchmarksValue `json:"payload"`
+	}
+
+	CfBenchmarksValue struct {
+		Value string `json:"value"` // ex.: "23012.34"
+		Time  int64  `json:"time"`  // ex.: 1677666151000
+	}
+)
+
+func NewCfBenchmarksProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*CfBenchmarksProvider, error) {
+	if endpoints.ApiKey == "" {
+		return nil, fmt.Errorf("%s requires an api key", ProviderCfBenchmarks)
+	}
+
+	provider := &CfBenchmarksProvider{}
+	provider.httpHeaders = http.Header{
+		"Authorization": {"Basic " + base64.StdEncoding.EncodeToString([]byte(endpoints.ApiKey))},
+	}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		nil,
+		nil,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
+
+func (p *CfBenchmarksProvider) Poll() error {
+	for symbol, pair := range p.pairs {
+		ticker, err := p.getValue(pair)
+		if err != nil {
+			p.logger.Warn().Err(err).Str("pair", symbol).Msg("failed to get index value")
+			continue
+		}
+
+		p.mtx.Lock()
+		p.tickers[symbol] = ticker
+		p.mtx.Unlock()
+	}
+
+	p.logger.Debug().Msg("updated tickers")
+	return nil
+}
+
+func (p *CfBenchmarksProvider) getValue(pair types.CurrencyPair) (types.TickerPrice, error) {
+	content, err := p.httpGet("/api/v1/values?id=" + p.CurrencyPairToProviderPair(pair))
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	var valuesResponse CfBenchmarksValuesResponse
+	err = json.Unmarshal(content, &valuesResponse)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	if len(valuesResponse.Payload) == 0 {
+		return types.TickerPrice{}, fmt.Errorf("no values received")
+	}
+
+	latest := valuesResponse.Payload[0]
+	for _, value := range valuesResponse.Payload {
+		if value.Time > latest.Time {
+			latest = value
+		}
+	}
+
+	return types.TickerPrice{
+		Price:  strToDec(latest.Value),
+		Volume: sdk.OneDec(),
+		Time:   time.UnixMilli(latest.Time),
+	}, nil
+}
+
+// CurrencyPairToProviderPair returns the id of the real time index, ex.:
+// "BTCUSD" -> "BRTI", "ETHUSD" -> "ETHUSD_RTI".
+func (p *CfBenchmarksProvider) CurrencyPairToProviderPair(pair types.CurrencyPair) string {
+	if pair.String() == "BTCUSD" {
+		return "BRTI"
+	}
+	return pair.String() + "_RTI"
+}
@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+)
+
+const (
+	// chainlinkDefaultMaxAge defines the default age of the latest round
+	// after which it's considered stale, the heartbeat of the major USD
+	// feeds is one hour.
+	chainlinkDefaultMaxAge = 65 * time.Minute
+)
+
+var (
+	_                         Provider = (*ChainlinkProvider)(nil)
+	chainlinkDefaultEndpoints          = Endpoint{
+		Name:         ProviderChainlink,
+		Urls:         []string{"https://cloudflare-eth.com"},
+		PollInterval: 15 * time.Second,
+	}
+
+	// chainlinkDefaultFeeds maps the pairs to the addresses of their
+	// aggregator proxies on Ethereum.
+	chainlinkDefaultFeeds = map[string]string{
+		"BTCUSD": "0xf4030086522a5beea4988f8ca5b36dbc97bee88c",
+		"ETHUSD": "0x5f4ec3df9cbd43714fe2740f5e3616155c5b8419",
+	}
+)
+
+type (
+	// ChainlinkProvider defines an oracle provider reading the latest round
+	// of Chainlink price feeds using the JSON-RPC endpoint of any EVM chain.
+	// The `contracts` of the provider endpoints map the pairs to the
+	// addresses of their aggregator proxies, ex.: {"ETHUSD": "0x5f4e..."}.
+	// Feeds only update on deviations or heartbeats, so rounds are checked
+	// against the `max_age` of the provider endpoints instead of the usual
+	// ticker staleness, defaulting to 65 minutes. The prices don't have a
+	// volume, so their tickers are reported with a volume of one.
+	//
+	// REF: https://docs.chain.link/data-feeds/api-reference#latestrounddata
+	ChainlinkProvider struct {
+		provider
+		decimals *evmDecimalsCache
+	}
+)
+
+func NewChainlinkProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*ChainlinkProvider, error) {
+	provider := &ChainlinkProvider{
+		decimals: newEvmDecimalsCache(),
+	}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		nil,
+		nil,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
+
+func (p *ChainlinkProvider) Poll() error {
+	for symbol := range p.pairs {
+		ticker, err := p.getTicker(symbol)
+		if err != nil {
+			p.logger.Warn().Err(err).Str("pair", symbol).Msg("failed to get latest round")
+			continue
+		}
+
+		p.mtx.Lock()
+		p.tickers[symbol] = ticker
+		p.mtx.Unlock()
+	}
+
+	p.logger.Debug().Msg("updated tickers")
+	return nil
+}
+
+func (p *ChainlinkProvider) getTicker(symbol string) (types.TickerPrice, error) {
+	feed, ok := p.endpoints.Contracts[symbol]
+	if !ok {
+		feed, ok = chainlinkDefaultFeeds[symbol]
+	}
+	if !ok {
+		return types.TickerPrice{}, fmt.Errorf("no contract configured for %s", symbol)
+	}
+	feed = strings.ToLower(feed)
+
+	// the aggregator decimals are cached like token decimals
+	decimals, err := p.decimals.get(&p.provider, symbol, feed)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	// latestRoundData returns roundId, answer, startedAt, updatedAt and
+	// answeredInRound
+	result, err := p.evmCall(feed, evmEncodeCall("latestRoundData()"))
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+	answer, err := evmDecodeInt(result, 1)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+	updatedAt, err := evmDecodeUint(result, 3)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	if answer.Sign() <= 0 {
+		return types.TickerPrice{}, fmt.Errorf("invalid answer: %s", answer)
+	}
+
+	maxAge := chainlinkDefaultMaxAge
+	if p.endpoints.MaxAge != 0 {
+		maxAge = p.endpoints.MaxAge
+	}
+	updated := time.Unix(updatedAt.Int64(), 0)
+	if time.Since(updated) > maxAge {
+		return types.TickerPrice{}, fmt.Errorf("latest round is stale, updated at %s", updated)
+	}
+
+	// the round was validated against the max age, so the ticker is
+	// reported as current
+	return types.TickerPrice{
+		Price:  bigIntToDec(answer, decimals),
+		Volume: sdk.OneDec(),
+		Time:   time.Now(),
+	}, nil
+}
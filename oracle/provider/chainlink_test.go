@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChainlinkProvider_Poll(t *testing.T) {
+	const (
+		ethUsd  = "0x5f4ec3df9cbd43714fe2740f5e3616155c5b8419"
+		atomUsd = "0x0000000000000000000000000000000000000001"
+	)
+
+	latestRoundData := "0x" + hex.EncodeToString(evmEncodeCall("latestRoundData()"))
+	now := time.Now().Unix()
+
+	server := newEvmTestServer(func(params EvmCallParams) []byte {
+		switch {
+		case params.Data == "0x"+hex.EncodeToString(evmEncodeCall("decimals()")):
+			return evmEncodeInt(big.NewInt(8))
+		case params.To == ethUsd && params.Data == latestRoundData:
+			return chainlinkRoundData(300012345678, now-600)
+		case params.To == atomUsd && params.Data == latestRoundData:
+			// updated two hours ago
+			return chainlinkRoundData(987000000, now-7200)
+		}
+		return nil
+	})
+	defer server.Close()
+
+	p := newTestProvider(t, NewChainlinkProvider, server, Endpoint{
+		Name:      ProviderChainlink,
+		Contracts: map[string]string{"ATOMUSD": atomUsd},
+	},
+		types.CurrencyPair{Base: "ETH", Quote: "USD"},
+		types.CurrencyPair{Base: "ATOM", Quote: "USD"},
+	)
+
+	require.NoError(t, p.Poll())
+	require.Len(t, p.tickers, 1)
+	require.Equal(t, sdk.MustNewDecFromStr("3000.12345678"), p.tickers["ETHUSD"].Price)
+
+	// feeds with a longer heartbeat require a longer max age
+	p.endpoints.MaxAge = 25 * time.Hour
+	require.NoError(t, p.Poll())
+	require.Equal(t, sdk.MustNewDecFromStr("9.87"), p.tickers["ATOMUSD"].Price)
+}
+
+func chainlinkRoundData(answer, updatedAt int64) []byte {
+	result := evmEncodeInt(big.NewInt(1))
+	result = append(result, evmEncodeInt(big.NewInt(answer))...)
+	result = append(result, evmEncodeInt(big.NewInt(updatedAt))...)
+	result = append(result, evmEncodeInt(big.NewInt(updatedAt))...)
+	return append(result, evmEncodeInt(big.NewInt(1))...)
+}
@@ -4,35 +4,66 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"price-feeder/oracle/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/rs/zerolog"
 )
 
 var (
 	_                        Provider = (*CoinbaseProvider)(nil)
 	coinbaseDefaultEndpoints          = Endpoint{
-		Name: ProviderCoinbase,
-		Urls: []string{"https://api.exchange.coinbase.com"},
+		Name:      ProviderCoinbase,
+		Urls:      []string{"https://api.exchange.coinbase.com"},
+		Websocket: "advanced-trade-ws.coinbase.com",
 	}
 )
 
 type (
-	// CoinbaseProvider defines an oracle provider implemented by the XT.COM
-	// public API.
+	// CoinbaseProvider defines an oracle provider implemented by the Coinbase
+	// Advanced Trade websocket API. The prices are streamed from the ticker
+	// channel, while the volumes are summed up from the 24h candles of the
+	// public REST API.
 	//
-	// REF: https://bitgetlimited.github.io/apidoc/en/spot
+	// REF: https://docs.cloud.coinbase.com/advanced-trade-api/docs/ws-overview
+	// REF: https://docs.cloud.coinbase.com/exchange/reference/exchangerestapi_getproductcandles
 	CoinbaseProvider struct {
 		provider
+		volumes map[string]sdk.Dec
+	}
+
+	CoinbaseSubscriptionMsg struct {
+		Type       string   `json:"type"`        // ex.: "subscribe"
+		ProductIds []string `json:"product_ids"` // ex.: ["ATOM-USD"]
+		Channel    string   `json:"channel"`     // ex.: "ticker"
+	}
+
+	CoinbaseTickerMsg struct {
+		Channel   string                `json:"channel"`   // ex.: "ticker"
+		Timestamp string                `json:"timestamp"` // ex.: "2023-02-09T20:30:37.167359596Z"
+		Events    []CoinbaseTickerEvent `json:"events"`
+	}
+
+	CoinbaseTickerEvent struct {
+		Type    string           `json:"type"` // ex.: "snapshot"
+		Tickers []CoinbaseTicker `json:"tickers"`
 	}
 
 	CoinbaseTicker struct {
-		Price  string `json:"price"`  // ex.: "24014.11"
-		Volume string `json:"volume"` // ex.: "7421.5009"
-		Time   string `json:"time"`   // ex.: "1660704288118"
+		ProductId string `json:"product_id"`  // ex.: "ATOM-USD"
+		Price     string `json:"price"`       // ex.: "13.61"
+		Volume    string `json:"volume_24_h"` // ex.: "433812.95"
+		Bid       string `json:"best_bid"`    // ex.: "13.60"
+		Ask       string `json:"best_ask"`    // ex.: "13.62"
 	}
+
+	// CoinbaseCandle defines a candle of the REST API, ex.:
+	// [1660704000, 24000.01, 24101.5, 24014.11, 24050.01, 97.26]
+	// [time, low, high, open, close, volume]
+	CoinbaseCandle [6]float64
 )
 
 func NewCoinbaseProvider(
@@ -41,55 +72,108 @@ func NewCoinbaseProvider(
 	endpoints Endpoint,
 	pairs ...types.CurrencyPair,
 ) (*CoinbaseProvider, error) {
-	provider := &CoinbaseProvider{}
+	provider := &CoinbaseProvider{
+		volumes: make(map[string]sdk.Dec, len(pairs)),
+	}
 	provider.Init(
 		ctx,
 		endpoints,
 		logger,
 		pairs,
-		nil,
-		nil,
+		provider.messageReceived,
+		provider.getSubscriptionMsgs,
 	)
 
-	interval := time.Duration(len(pairs)/10*2+1) * time.Second
-
-	go startPolling(provider, interval, logger)
+	// the candles are hourly, so the volumes don't need to be polled often
+	go startPolling(ctx, provider, time.Minute, logger)
 	return provider, nil
 }
 
-func (p *CoinbaseProvider) Poll() error {
-	i := 0
-	for _, pair := range p.pairs {
-		go func(p *CoinbaseProvider, pair types.CurrencyPair) {
-			path := fmt.Sprintf("/products/%s/ticker", pair.Join("-"))
-			content, err := p.httpGet(path)
-			if err != nil {
-				return
+func (p *CoinbaseProvider) getSubscriptionMsgs(pairs ...types.CurrencyPair) []interface{} {
+	productIds := make([]string, len(pairs))
+	for i, pair := range pairs {
+		productIds[i] = pair.Join("-")
+	}
+	return []interface{}{
+		CoinbaseSubscriptionMsg{
+			Type:       "subscribe",
+			ProductIds: productIds,
+			Channel:    "ticker",
+		},
+		// the heartbeats keep the connection open for illiquid pairs
+		CoinbaseSubscriptionMsg{
+			Type:       "subscribe",
+			ProductIds: productIds,
+			Channel:    "heartbeats",
+		},
+	}
+}
+
+func (p *CoinbaseProvider) messageReceived(messageType int, bz []byte) {
+	var tickerMsg CoinbaseTickerMsg
+	err := json.Unmarshal(bz, &tickerMsg)
+	if err != nil {
+		p.logger.Error().Err(err).Msg("failed to unmarshal message")
+		return
+	}
+
+	// subscription acks are sent on the "subscriptions" channel
+	if tickerMsg.Channel != "ticker" {
+		return
+	}
+
+	timestamp, err := time.Parse(time.RFC3339Nano, tickerMsg.Timestamp)
+	if err != nil {
+		p.logger.Error().Err(err).Msg("failed parsing timestamp")
+		return
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	for _, event := range tickerMsg.Events {
+		for _, ticker := range event.Tickers {
+			pair := p.ProviderPairToCurrencyPair(ticker.ProductId)
+			symbol := pair.String()
+			if _, ok := p.pairs[symbol]; !ok {
+				continue
 			}
 
-			var ticker CoinbaseTicker
-			err = json.Unmarshal(content, &ticker)
-			if err != nil {
-				return
+			volume, ok := p.volumes[symbol]
+			if !ok {
+				volume = strToDec(ticker.Volume)
+			}
+
+			spread := sdk.Dec{}
+			if ticker.Bid != "" && ticker.Ask != "" {
+				spread = computeSpread(strToDec(ticker.Bid), strToDec(ticker.Ask))
 			}
 
-			timestamp, err := time.Parse(time.RFC3339, ticker.Time)
+			p.tickers[symbol] = types.TickerPrice{
+				Price:  strToDec(ticker.Price),
+				Volume: volume,
+				Time:   timestamp,
+				Spread: spread,
+			}
+		}
+	}
+}
+
+// Poll updates the 24h volumes using the hourly candles of every pair.
+func (p *CoinbaseProvider) Poll() error {
+	i := 0
+	for _, pair := range p.pairs {
+		go func(p *CoinbaseProvider, pair types.CurrencyPair) {
+			volume, err := p.getVolume(pair)
 			if err != nil {
-				p.logger.
-					Err(err).
-					Msg("failed parsing timestamp")
+				p.logger.Warn().Err(err).Str("pair", pair.String()).Msg("failed to get volume")
 				return
 			}
 
 			p.mtx.Lock()
 			defer p.mtx.Unlock()
 
-			p.tickers[pair.String()] = types.TickerPrice{
-				Price:  strToDec(ticker.Price),
-				Volume: strToDec(ticker.Volume),
-				Time:   timestamp,
-			}
-
+			p.volumes[pair.String()] = volume
 		}(p, pair)
 		// Coinbase has a rate limit of 10req/s, sleeping 1.2s before running
 		// the next batch of requests
@@ -100,6 +184,48 @@ func (p *CoinbaseProvider) Poll() error {
 		}
 	}
 
-	p.logger.Debug().Msg("updated tickers")
+	p.logger.Debug().Msg("updated volumes")
 	return nil
 }
+
+// getVolume sums up the volume of the hourly candles of the last 24h.
+func (p *CoinbaseProvider) getVolume(pair types.CurrencyPair) (sdk.Dec, error) {
+	path := fmt.Sprintf("/products/%s/candles?granularity=3600", pair.Join("-"))
+	content, err := p.httpGet(path)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	var candles []CoinbaseCandle
+	err = json.Unmarshal(content, &candles)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	// candles are sorted by time, most recent first
+	cutoff := float64(time.Now().Add(-24 * time.Hour).Unix())
+	volume := sdk.ZeroDec()
+	for _, candle := range candles {
+		if candle[0] < cutoff {
+			break
+		}
+		volume = volume.Add(floatToDec(candle[5]))
+	}
+	return volume, nil
+}
+
+func (p *CoinbaseProvider) CurrencyPairToProviderPair(pair types.CurrencyPair) string {
+	return pair.Join("-")
+}
+
+func (p *CoinbaseProvider) ProviderPairToCurrencyPair(pair string) types.CurrencyPair {
+	tokens := strings.Split(pair, "-")
+	if len(tokens) != 2 {
+		p.logger.Warn().Str("pair", pair).Msg("failed to convert to currency pair")
+		return types.CurrencyPair{}
+	}
+	return types.CurrencyPair{
+		Base:  tokens[0],
+		Quote: tokens[1],
+	}
+}
@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"testing"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCoinbaseProvider_MessageReceived(t *testing.T) {
+	atomUsd := types.CurrencyPair{Base: "ATOM", Quote: "USD"}
+
+	p := &CoinbaseProvider{volumes: map[string]sdk.Dec{}}
+	p.logger = zerolog.Nop()
+	p.pairs = map[string]types.CurrencyPair{atomUsd.String(): atomUsd}
+	p.tickers = map[string]types.TickerPrice{}
+
+	// ignores subscription acks
+	p.messageReceived(0, []byte(`{"channel":"subscriptions","timestamp":"2023-02-09T20:30:37.167359596Z","events":[{"subscriptions":{"ticker":["ATOM-USD"]}}]}`))
+	require.Empty(t, p.tickers)
+
+	msg := []byte(`{
+		"channel": "ticker",
+		"timestamp": "2023-02-09T20:30:37.167359596Z",
+		"events": [{"type": "snapshot", "tickers": [
+			{"type": "ticker", "product_id": "ATOM-USD", "price": "13.61", "volume_24_h": "433812.95", "best_bid": "13.60", "best_ask": "13.62"},
+			{"type": "ticker", "product_id": "BTC-USD", "price": "21932.98", "volume_24_h": "16038.28"}
+		]}]
+	}`)
+
+	// uses the 24h volume of the ticker until the candles are polled
+	p.messageReceived(0, msg)
+	require.Len(t, p.tickers, 1)
+	require.Equal(t, sdk.MustNewDecFromStr("13.61"), p.tickers["ATOMUSD"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("433812.95"), p.tickers["ATOMUSD"].Volume)
+
+	p.volumes["ATOMUSD"] = sdk.MustNewDecFromStr("400000")
+	p.messageReceived(0, msg)
+	require.Equal(t, sdk.MustNewDecFromStr("400000"), p.tickers["ATOMUSD"].Volume)
+}
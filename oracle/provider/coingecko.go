@@ -0,0 +1,215 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+)
+
+var (
+	_                         Provider = (*CoinGeckoProvider)(nil)
+	coinGeckoDefaultEndpoints          = Endpoint{
+		Name:         ProviderCoinGecko,
+		Urls:         []string{"https://api.coingecko.com"},
+		PollInterval: 30 * time.Second,
+		Role:         RoleFallback,
+	}
+)
+
+type (
+	// CoinGeckoProvider defines an oracle provider polling the CoinGecko
+	// simple price API, which aggregates the prices of all exchanges listing
+	// a coin. The `contracts` of the provider endpoints map the denoms to
+	// their coin ids, ex. "ATOM" = "cosmos", other denoms are resolved once
+	// via the markets endpoint to the coin with the largest market cap using
+	// the symbol. An `api_key` is sent as "x-cg-demo-api-key" header, or as
+	// "x-cg-pro-api-key" header when the urls are set to the pro tier at
+	// "https://pro-api.coingecko.com".
+	//
+	// CoinGecko is meant as a fallback and defaults to the "fallback" role,
+	// so its prices are only voted for bases no other provider prices, ex.:
+	// when all exchanges of a pair fail. Its tickers are reported with a
+	// volume of one.
+	//
+	// REF: https://docs.coingecko.com/reference/simple-price
+	CoinGeckoProvider struct {
+		provider
+		ids map[string]string
+	}
+
+	// CoinGeckoPriceResponse maps the coin ids to their prices, ex.:
+	// {"cosmos":{"usd":9.87,"last_updated_at":1700000000}}
+	CoinGeckoPriceResponse map[string]map[string]float64
+
+	CoinGeckoMarket struct {
+		Id     string `json:"id"`     // ex.: "cosmos"
+		Symbol string `json:"symbol"` // ex.: "atom"
+	}
+)
+
+func NewCoinGeckoProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*CoinGeckoProvider, error) {
+	provider := &CoinGeckoProvider{
+		ids: map[string]string{},
+	}
+	if endpoints.ApiKey != "" {
+		header := "X-Cg-Demo-Api-Key"
+		for _, url := range endpoints.Urls {
+			if strings.Contains(url, "pro-api.coingecko.com") {
+				header = "X-Cg-Pro-Api-Key"
+			}
+		}
+		provider.httpHeaders = http.Header{
+			header: {endpoints.ApiKey},
+		}
+	}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		nil,
+		nil,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
+
+func (p *CoinGeckoProvider) Poll() error {
+	unresolved := map[string]struct{}{}
+	for _, pair := range p.pairs {
+		if p.getId(pair.Base) == "" {
+			unresolved[strings.ToLower(pair.Base)] = struct{}{}
+		}
+	}
+	if len(unresolved) > 0 {
+		err := p.resolveIds(unresolved)
+		if err != nil {
+			p.logger.Warn().Err(err).Msg("failed to resolve coin ids")
+		}
+	}
+
+	ids := map[string]struct{}{}
+	currencies := map[string]struct{}{}
+	for symbol, pair := range p.pairs {
+		id := p.getId(pair.Base)
+		if id == "" {
+			p.logger.Warn().Str("pair", symbol).Msg("no coin id found")
+			continue
+		}
+		ids[id] = struct{}{}
+		currencies[strings.ToLower(pair.Quote)] = struct{}{}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	query := url.Values{}
+	query.Set("ids", joinKeys(ids))
+	query.Set("vs_currencies", joinKeys(currencies))
+	query.Set("include_last_updated_at", "true")
+
+	content, err := p.httpGet("/api/v3/simple/price?" + query.Encode())
+	if err != nil {
+		return err
+	}
+
+	var prices CoinGeckoPriceResponse
+	err = json.Unmarshal(content, &prices)
+	if err != nil {
+		return err
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	for symbol, pair := range p.pairs {
+		coin, ok := prices[p.getId(pair.Base)]
+		if !ok {
+			continue
+		}
+		price, ok := coin[strings.ToLower(pair.Quote)]
+		if !ok || price <= 0 {
+			continue
+		}
+
+		timestamp := time.Now()
+		if updated, ok := coin["last_updated_at"]; ok {
+			timestamp = time.Unix(int64(updated), 0)
+		}
+
+		dec, err := decFromFloat(price)
+		if err != nil {
+			p.logger.Warn().Err(err).Str("pair", symbol).Msg("failed to parse price")
+			continue
+		}
+
+		p.tickers[symbol] = types.TickerPrice{
+			Price:  dec,
+			Volume: sdk.OneDec(),
+			Time:   timestamp,
+		}
+	}
+
+	p.logger.Debug().Msg("updated tickers")
+	return nil
+}
+
+func (p *CoinGeckoProvider) getId(denom string) string {
+	if id, ok := p.endpoints.Contracts[denom]; ok {
+		return id
+	}
+	return p.ids[denom]
+}
+
+// resolveIds looks up the coin ids of the symbols, the markets are sorted by
+// market cap, so the first coin of every symbol is the largest one.
+func (p *CoinGeckoProvider) resolveIds(symbols map[string]struct{}) error {
+	query := url.Values{}
+	query.Set("vs_currency", "usd")
+	query.Set("symbols", joinKeys(symbols))
+	query.Set("order", "market_cap_desc")
+	query.Set("per_page", "250")
+
+	content, err := p.httpGet("/api/v3/coins/markets?" + query.Encode())
+	if err != nil {
+		return err
+	}
+
+	var markets []CoinGeckoMarket
+	err = json.Unmarshal(content, &markets)
+	if err != nil {
+		return err
+	}
+
+	for _, market := range markets {
+		denom := strings.ToUpper(market.Symbol)
+		if _, ok := p.ids[denom]; !ok {
+			p.ids[denom] = market.Id
+		}
+	}
+	return nil
+}
+
+// joinKeys returns the sorted keys of the set joined by commas.
+func joinKeys(set map[string]struct{}) string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return strings.Join(keys, ",")
+}
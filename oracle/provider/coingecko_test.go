@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCoinGeckoProvider_Poll(t *testing.T) {
+	server := newTestServer(func(rw http.ResponseWriter, req *http.Request) {
+		var response string
+		switch req.URL.Path {
+		case "/api/v3/coins/markets":
+			response = `[{"id":"osmosis","symbol":"osmo"},{"id":"osmo-scam","symbol":"osmo"}]`
+		case "/api/v3/simple/price":
+			response = `{
+				"cosmos":{"usd":9.87,"btc":0.0003,"last_updated_at":1700000000},
+				"osmosis":{"usd":0.55,"last_updated_at":1700000010}
+			}`
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = rw.Write([]byte(response))
+	})
+	defer server.Close()
+
+	atomUsd := types.CurrencyPair{Base: "ATOM", Quote: "USD"}
+	atomBtc := types.CurrencyPair{Base: "ATOM", Quote: "BTC"}
+	osmoUsd := types.CurrencyPair{Base: "OSMO", Quote: "USD"}
+
+	p := newTestProvider(t, NewCoinGeckoProvider, server, Endpoint{
+		Name:      ProviderCoinGecko,
+		ApiKey:    "key",
+		Contracts: map[string]string{"ATOM": "cosmos"},
+	}, atomUsd, atomBtc, osmoUsd)
+
+	require.NoError(t, p.Poll())
+	require.Equal(t, "osmosis", p.ids["OSMO"])
+	require.Equal(t, sdk.MustNewDecFromStr("9.87"), p.tickers["ATOMUSD"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("0.0003"), p.tickers["ATOMBTC"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("0.55"), p.tickers["OSMOUSD"].Price)
+	require.Equal(t, sdk.OneDec(), p.tickers["OSMOUSD"].Volume)
+	require.Equal(t, int64(1700000010), p.tickers["OSMOUSD"].Time.Unix())
+
+	requests := server.Requests()
+	require.Len(t, requests, 2)
+	require.Equal(t, "/api/v3/coins/markets", requests[0].URL.Path)
+	require.Equal(t, "osmo", requests[0].URL.Query().Get("symbols"))
+	require.Equal(t, "/api/v3/simple/price", requests[1].URL.Path)
+	require.Equal(t, "cosmos,osmosis", requests[1].URL.Query().Get("ids"))
+	require.Equal(t, "btc,usd", requests[1].URL.Query().Get("vs_currencies"))
+	require.Equal(t, "key", requests[1].Header.Get("X-Cg-Demo-Api-Key"))
+}
@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+)
+
+var (
+	_                             Provider = (*CoinMarketCapProvider)(nil)
+	coinMarketCapDefaultEndpoints          = Endpoint{
+		Name:         ProviderCoinMarketCap,
+		Urls:         []string{"https://pro-api.coinmarketcap.com"},
+		PollInterval: 60 * time.Second,
+		Role:         RoleFallback,
+	}
+)
+
+type (
+	// CoinMarketCapProvider defines an oracle provider polling the latest
+	// quotes of the CoinMarketCap API, which requires the `api_key` of the
+	// provider endpoints. Symbols shared by several coins resolve to the coin
+	// with the best rank, unless the `contracts` of the provider endpoints map
+	// the denom to its CoinMarketCap id, ex. "ATOM" = "3794". Every quote is
+	// requested separately, since the basic plan only converts to one
+	// currency per request.
+	//
+	// Like CoinGecko, CoinMarketCap is meant as a fallback and defaults to the
+	// "fallback" role.
+	//
+	// REF: https://coinmarketcap.com/api/documentation/v1/#operation/getV2CryptocurrencyQuotesLatest
+	CoinMarketCapProvider struct {
+		provider
+	}
+
+	CoinMarketCapQuotesResponse struct {
+		Status CoinMarketCapStatus               `json:"status"`
+		Data   map[string][]CoinMarketCapListing `json:"data"`
+	}
+
+	CoinMarketCapStatus struct {
+		ErrorCode    int    `json:"error_code"`    // ex.: 0
+		ErrorMessage string `json:"error_message"` // ex.: "Invalid value for \"symbol\""
+	}
+
+	CoinMarketCapListing struct {
+		Id     int64                         `json:"id"`       // ex.: 3794
+		Symbol string                        `json:"symbol"`   // ex.: "ATOM"
+		Rank   int64                         `json:"cmc_rank"` // ex.: 22
+		Quote  map[string]CoinMarketCapQuote `json:"quote"`
+	}
+
+	CoinMarketCapQuote struct {
+		Price       float64   `json:"price"`        // ex.: 9.87
+		LastUpdated time.Time `json:"last_updated"` // ex.: "2023-11-14T22:13:00.000Z"
+	}
+)
+
+func NewCoinMarketCapProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*CoinMarketCapProvider, error) {
+	if endpoints.ApiKey == "" {
+		return nil, fmt.Errorf("%s requires an api key", ProviderCoinMarketCap)
+	}
+
+	provider := &CoinMarketCapProvider{}
+	provider.httpHeaders = http.Header{
+		"X-CMC_PRO_API_KEY": {endpoints.ApiKey},
+	}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		nil,
+		nil,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
+
+func (p *CoinMarketCapProvider) Poll() error {
+	quotes := map[string]map[string]struct{}{}
+	for _, pair := range p.pairs {
+		if _, ok := quotes[pair.Quote]; !ok {
+			quotes[pair.Quote] = map[string]struct{}{}
+		}
+		quotes[pair.Quote][pair.Base] = struct{}{}
+	}
+
+	for quote, bases := range quotes {
+		err := p.pollQuote(quote, bases)
+		if err != nil {
+			p.logger.Warn().Err(err).Str("quote", quote).Msg("failed to get quotes")
+		}
+	}
+
+	p.logger.Debug().Msg("updated tickers")
+	return nil
+}
+
+func (p *CoinMarketCapProvider) pollQuote(quote string, bases map[string]struct{}) error {
+	query := url.Values{}
+	query.Set("symbol", joinKeys(bases))
+	query.Set("convert", quote)
+
+	content, err := p.httpGet("/v2/cryptocurrency/quotes/latest?" + query.Encode())
+	if err != nil {
+		return err
+	}
+
+	var response CoinMarketCapQuotesResponse
+	err = json.Unmarshal(content, &response)
+	if err != nil {
+		return err
+	}
+	if response.Status.ErrorCode != 0 {
+		return fmt.Errorf("coinmarketcap error %d: %s",
+			response.Status.ErrorCode, response.Status.ErrorMessage)
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	for base := range bases {
+		listing, ok := p.selectListing(base, response.Data[base])
+		if !ok {
+			continue
+		}
+		price, ok := listing.Quote[quote]
+		if !ok || price.Price <= 0 {
+			continue
+		}
+		dec, err := decFromFloat(price.Price)
+		if err != nil {
+			p.logger.Warn().Err(err).Str("pair", base+quote).Msg("failed to parse price")
+			continue
+		}
+		p.tickers[base+quote] = types.TickerPrice{
+			Price:  dec,
+			Volume: sdk.OneDec(),
+			Time:   price.LastUpdated,
+		}
+	}
+	return nil
+}
+
+// selectListing returns the listing of the id configured for the denom, or
+// the listing with the best rank otherwise. Unranked listings are skipped.
+func (p *CoinMarketCapProvider) selectListing(
+	denom string,
+	listings []CoinMarketCapListing,
+) (CoinMarketCapListing, bool) {
+	if id, ok := p.endpoints.Contracts[denom]; ok {
+		for _, listing := range listings {
+			if strconv.FormatInt(listing.Id, 10) == id {
+				return listing, true
+			}
+		}
+		return CoinMarketCapListing{}, false
+	}
+
+	var (
+		best  CoinMarketCapListing
+		found bool
+	)
+	for _, listing := range listings {
+		if listing.Rank <= 0 {
+			continue
+		}
+		if !found || listing.Rank < best.Rank {
+			best, found = listing, true
+		}
+	}
+	return best, found
+}
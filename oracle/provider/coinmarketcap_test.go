@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCoinMarketCapProvider_Poll(t *testing.T) {
+	server := newTestServer(func(rw http.ResponseWriter, req *http.Request) {
+		_, _ = rw.Write([]byte(`{
+			"status":{"error_code":0,"error_message":null},
+			"data":{
+				"ATOM":[
+					{"id":99999,"symbol":"ATOM","cmc_rank":null,"quote":{"USD":{"price":1.23,"last_updated":"2023-11-14T22:13:00.000Z"}}},
+					{"id":3794,"symbol":"ATOM","cmc_rank":22,"quote":{"USD":{"price":9.87,"last_updated":"2023-11-14T22:13:00.000Z"}}}
+				],
+				"OSMO":[
+					{"id":12220,"symbol":"OSMO","cmc_rank":120,"quote":{"USD":{"price":0.55,"last_updated":"2023-11-14T22:13:00.000Z"}}},
+					{"id":88888,"symbol":"OSMO","cmc_rank":5000,"quote":{"USD":{"price":0.01,"last_updated":"2023-11-14T22:13:00.000Z"}}}
+				]
+			}
+		}`))
+	})
+	defer server.Close()
+
+	atomUsd := types.CurrencyPair{Base: "ATOM", Quote: "USD"}
+	osmoUsd := types.CurrencyPair{Base: "OSMO", Quote: "USD"}
+
+	p := newTestProvider(t, NewCoinMarketCapProvider, server, Endpoint{
+		Name:      ProviderCoinMarketCap,
+		ApiKey:    "key",
+		Contracts: map[string]string{"OSMO": "88888"},
+	}, atomUsd, osmoUsd)
+
+	require.NoError(t, p.Poll())
+	require.Equal(t, sdk.MustNewDecFromStr("9.87"), p.tickers["ATOMUSD"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("0.01"), p.tickers["OSMOUSD"].Price)
+	require.Equal(t, sdk.OneDec(), p.tickers["ATOMUSD"].Volume)
+	require.Equal(t, int64(1699999980), p.tickers["ATOMUSD"].Time.Unix())
+
+	requests := server.Requests()
+	require.Len(t, requests, 1)
+	require.Equal(t, "/v2/cryptocurrency/quotes/latest", requests[0].URL.Path)
+	require.Equal(t, "ATOM,OSMO", requests[0].URL.Query().Get("symbol"))
+	require.Equal(t, "USD", requests[0].URL.Query().Get("convert"))
+	require.Equal(t, "key", requests[0].Header.Get("X-CMC_PRO_API_KEY"))
+}
@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// cosmosDefaultDecimals defines the decimals of Cosmos denoms without
+	// configured decimals, ex.: "uatom".
+	cosmosDefaultDecimals = 6
+	// cosmwasmTokenPrefix defines the prefix of cw20 token addresses in the
+	// configured contracts, ex.: "cw20:terra1...".
+	cosmwasmTokenPrefix = "cw20:"
+)
+
+type (
+	CosmwasmQueryResponse struct {
+		Data json.RawMessage `json:"data"`
+	}
+
+	// CosmwasmAssetInfo defines a native denom or cw20 token as used by the
+	// Astroport and Terraswap style DEXes, ex.:
+	// {"native_token":{"denom":"uluna"}} or {"token":{"contract_addr":"terra1..."}}.
+	CosmwasmAssetInfo struct {
+		NativeToken *CosmwasmNativeToken `json:"native_token,omitempty"`
+		Token       *CosmwasmToken       `json:"token,omitempty"`
+	}
+
+	CosmwasmNativeToken struct {
+		Denom string `json:"denom"`
+	}
+
+	CosmwasmToken struct {
+		ContractAddr string `json:"contract_addr"`
+	}
+
+	CosmwasmAsset struct {
+		Info   CosmwasmAssetInfo `json:"info"`
+		Amount string            `json:"amount"` // ex.: "1000000"
+	}
+
+	// CosmwasmPool defines a pair contract of the CosmwasmPoolProvider, which
+	// is queried using the REST endpoint of its chain.
+	CosmwasmPool struct {
+		Base          string // ex.: "ASTRO"
+		Quote         string // ex.: "LUNA"
+		Url           string // ex.: "https://rest.cosmos.directory/terra2"
+		Contract      string // ex.: "terra1..."
+		BaseDenom     string // ex.: "cw20:terra1..."
+		QuoteDenom    string // ex.: "uluna"
+		BaseDecimals  int64
+		QuoteDecimals int64
+	}
+
+	// wasmQueryFunc executes a smart query of the contract and unmarshals
+	// its data into the result.
+	wasmQueryFunc func(contract string, query interface{}, result interface{}) error
+)
+
+// cosmosDecimals returns the decimals configured for the denom, or the
+// default decimals of Cosmos denoms otherwise.
+func (p *provider) cosmosDecimals(denom string) int64 {
+	if decimals, ok := p.endpoints.Decimals[denom]; ok {
+		return decimals
+	}
+	return cosmosDefaultDecimals
+}
+
+// cosmosScalePrice converts the price of the base chain denom in the quote
+// chain denom, ex.: uatom in uosmo, to the price of the whole tokens.
+func (p *provider) cosmosScalePrice(pair types.CurrencyPair, price sdk.Dec) sdk.Dec {
+	decimals := p.cosmosDecimals(pair.Base) - p.cosmosDecimals(pair.Quote)
+	if decimals >= 0 {
+		return price.Mul(sdk.NewDecFromBigInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(decimals), nil)))
+	}
+	return price.Quo(sdk.NewDecFromBigInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(-decimals), nil)))
+}
+
+// wasmQuery executes a smart query of the contract using the REST endpoint of
+// the node and unmarshals its data.
+func (p *provider) wasmQuery(contract string, query interface{}, result interface{}) error {
+	path, err := wasmQueryPath(contract, query)
+	if err != nil {
+		return err
+	}
+
+	content, err := p.httpGet(path)
+	if err != nil {
+		return err
+	}
+	return wasmQueryResult(contract, content, result)
+}
+
+// wasmQueryPath returns the REST path of a smart query of the contract.
+func wasmQueryPath(contract string, query interface{}) (string, error) {
+	bz, err := json.Marshal(query)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(
+		"/cosmwasm/wasm/v1/contract/%s/smart/%s",
+		contract,
+		base64.StdEncoding.EncodeToString(bz),
+	), nil
+}
+
+// wasmQueryResult unmarshals the data of a smart query response.
+func wasmQueryResult(contract string, content []byte, result interface{}) error {
+	var response CosmwasmQueryResponse
+	err := json.Unmarshal(content, &response)
+	if err != nil {
+		return err
+	}
+	if len(response.Data) == 0 {
+		return fmt.Errorf("no data returned by %s", contract)
+	}
+	return json.Unmarshal(response.Data, result)
+}
+
+// newCosmwasmAssetInfo returns the asset info of a configured contract, which
+// is either a native denom or a cw20 token address with the "cw20:" prefix.
+func newCosmwasmAssetInfo(contract string) CosmwasmAssetInfo {
+	if strings.HasPrefix(contract, cosmwasmTokenPrefix) {
+		return CosmwasmAssetInfo{
+			Token: &CosmwasmToken{ContractAddr: strings.TrimPrefix(contract, cosmwasmTokenPrefix)},
+		}
+	}
+	return CosmwasmAssetInfo{
+		NativeToken: &CosmwasmNativeToken{Denom: contract},
+	}
+}
+
+// Equal returns whether both asset infos refer to the same denom or token.
+func (i CosmwasmAssetInfo) Equal(other CosmwasmAssetInfo) bool {
+	switch {
+	case i.NativeToken != nil && other.NativeToken != nil:
+		return i.NativeToken.Denom == other.NativeToken.Denom
+	case i.Token != nil && other.Token != nil:
+		return i.Token.ContractAddr == other.Token.ContractAddr
+	}
+	return false
+}
+
+// cosmosParseAmount returns the integer amount of a coin or asset.
+func cosmosParseAmount(amount string) (*big.Int, error) {
+	value, ok := new(big.Int).SetString(amount, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid amount: %s", amount)
+	}
+	return value, nil
+}
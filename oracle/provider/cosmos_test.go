@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCosmwasmAssetInfo(t *testing.T) {
+	native := newCosmwasmAssetInfo("uluna")
+	token := newCosmwasmAssetInfo("cw20:terra1nsuqsk6kh58ulczatwev87ttq2z6r3pusulg9r24mfj2fvtzd4uq3exn26")
+
+	bz, err := json.Marshal(native)
+	require.NoError(t, err)
+	require.Equal(t, `{"native_token":{"denom":"uluna"}}`, string(bz))
+
+	bz, err = json.Marshal(token)
+	require.NoError(t, err)
+	require.Equal(t, `{"token":{"contract_addr":"terra1nsuqsk6kh58ulczatwev87ttq2z6r3pusulg9r24mfj2fvtzd4uq3exn26"}}`, string(bz))
+
+	require.True(t, native.Equal(newCosmwasmAssetInfo("uluna")))
+	require.False(t, native.Equal(token))
+}
+
+// wasmTestQuery defines a smart query received by a wasm test server.
+type wasmTestQuery struct {
+	contract string
+	query    map[string]json.RawMessage
+}
+
+// newWasmTestServer returns a REST server answering smart queries with the
+// data returned by the handler for the contract and query message. Malformed
+// queries are answered with a bad request.
+func newWasmTestServer(handler func(contract string, query map[string]json.RawMessage) interface{}) *testServer {
+	return newTestServer(func(rw http.ResponseWriter, req *http.Request) {
+		query, err := decodeWasmTestQuery(req)
+		if err != nil {
+			rw.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		data, _ := json.Marshal(handler(query.contract, query.query))
+		bz, _ := json.Marshal(CosmwasmQueryResponse{Data: data})
+		_, _ = rw.Write(bz)
+	})
+}
+
+// wasmTestQueries returns the smart queries received by the wasm test server.
+func wasmTestQueries(t *testing.T, server *testServer) []wasmTestQuery {
+	queries := []wasmTestQuery{}
+	for _, req := range server.Requests() {
+		query, err := decodeWasmTestQuery(req.Request)
+		require.NoError(t, err)
+		queries = append(queries, query)
+	}
+	return queries
+}
+
+func decodeWasmTestQuery(req *http.Request) (wasmTestQuery, error) {
+	tokens := strings.Split(strings.TrimPrefix(req.URL.Path, "/cosmwasm/wasm/v1/contract/"), "/smart/")
+	if len(tokens) != 2 {
+		return wasmTestQuery{}, fmt.Errorf("invalid path: %s", req.URL.Path)
+	}
+
+	bz, err := base64.StdEncoding.DecodeString(tokens[1])
+	if err != nil {
+		return wasmTestQuery{}, err
+	}
+	var query map[string]json.RawMessage
+	if err := json.Unmarshal(bz, &query); err != nil {
+		return wasmTestQuery{}, err
+	}
+	return wasmTestQuery{contract: tokens[0], query: query}, nil
+}
@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	"github.com/rs/zerolog"
+)
+
+var (
+	_                            Provider = (*CosmwasmPoolProvider)(nil)
+	cosmwasmPoolDefaultEndpoints          = Endpoint{
+		Name:         ProviderCosmwasmPool,
+		PollInterval: 10 * time.Second,
+	}
+)
+
+type (
+	// CosmwasmPoolProvider defines an oracle provider querying the pair
+	// contracts of any Terraswap style DEX, ex.: Astroport forks, using the
+	// standard "pool" and "simulation" smart queries. Every pair is listed
+	// in the `pools` of the provider endpoints with the REST endpoint of its
+	// chain, its contract and its denoms, so new DEXes don't need a provider
+	// of their own. Pools without an url are queried using the `urls` of the
+	// provider endpoints. The base reserve of the pool is reported as volume.
+	CosmwasmPoolProvider struct {
+		AstroportProvider
+	}
+)
+
+func NewCosmwasmPoolProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*CosmwasmPoolProvider, error) {
+	provider := &CosmwasmPoolProvider{}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		nil,
+		nil,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
+
+func (p *CosmwasmPoolProvider) Poll() error {
+	for _, pool := range p.endpoints.Pools {
+		symbol := pool.Base + pool.Quote
+		if _, ok := p.pairs[symbol]; !ok {
+			continue
+		}
+
+		ticker, err := p.getTicker(pool)
+		if err != nil {
+			p.logger.Warn().Err(err).Str("pair", symbol).Msg("failed to query pool")
+			continue
+		}
+
+		p.mtx.Lock()
+		p.tickers[symbol] = ticker
+		p.mtx.Unlock()
+	}
+
+	p.logger.Debug().Msg("updated tickers")
+	return nil
+}
+
+func (p *CosmwasmPoolProvider) getTicker(pool CosmwasmPool) (types.TickerPrice, error) {
+	query := p.wasmQuery
+	if pool.Url != "" {
+		query = func(contract string, query interface{}, result interface{}) error {
+			path, err := wasmQueryPath(contract, query)
+			if err != nil {
+				return err
+			}
+			content, err := p.makeHttpRequest(pool.Url + path)
+			if err != nil {
+				return err
+			}
+			return wasmQueryResult(contract, content, result)
+		}
+	}
+
+	baseDecimals := pool.BaseDecimals
+	if baseDecimals == 0 {
+		baseDecimals = cosmosDefaultDecimals
+	}
+	quoteDecimals := pool.QuoteDecimals
+	if quoteDecimals == 0 {
+		quoteDecimals = cosmosDefaultDecimals
+	}
+	quoteInfo := newCosmwasmAssetInfo(pool.QuoteDenom)
+
+	return p.queryPair(
+		query,
+		pool.Contract,
+		newCosmwasmAssetInfo(pool.BaseDenom),
+		&quoteInfo,
+		baseDecimals,
+		quoteDecimals,
+	)
+}
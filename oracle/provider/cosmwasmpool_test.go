@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCosmwasmPoolProvider_Poll(t *testing.T) {
+	newPoolServer := func(contract, base, quote, returnAmount string) func(string, map[string]json.RawMessage) interface{} {
+		return func(queried string, query map[string]json.RawMessage) interface{} {
+			switch {
+			case queried != contract:
+				return nil
+			case query["pool"] != nil:
+				return AstroportPoolResponse{Assets: []CosmwasmAsset{
+					{Info: newCosmwasmAssetInfo(base), Amount: "1000000000"},
+					{Info: newCosmwasmAssetInfo(quote), Amount: "1000000000"},
+				}}
+			case query["simulation"] != nil:
+				return AstroportSimulationResponse{ReturnAmount: returnAmount}
+			}
+			return nil
+		}
+	}
+
+	terra := newWasmTestServer(newPoolServer("terra1pair", "uluna", "ibc/usdc", "500000"))
+	defer terra.Close()
+	neutron := newWasmTestServer(newPoolServer("neutron1pair", "untrn", "ibc/usdc", "400000"))
+	defer neutron.Close()
+
+	lunaUsdc := types.CurrencyPair{Base: "LUNA", Quote: "USDC"}
+	ntrnUsdc := types.CurrencyPair{Base: "NTRN", Quote: "USDC"}
+
+	p := newTestProvider(t, NewCosmwasmPoolProvider, neutron, Endpoint{
+		Name: ProviderCosmwasmPool,
+		Pools: []CosmwasmPool{
+			{Base: "LUNA", Quote: "USDC", Url: terra.URL, Contract: "terra1pair", BaseDenom: "uluna", QuoteDenom: "ibc/usdc"},
+			// pools without an url use the urls of the endpoint
+			{Base: "NTRN", Quote: "USDC", Contract: "neutron1pair", BaseDenom: "untrn", QuoteDenom: "ibc/usdc"},
+			// pools containing other assets are rejected
+			{Base: "ATOM", Quote: "USDC", Url: terra.URL, Contract: "terra1pair", BaseDenom: "uluna", QuoteDenom: "uusdc"},
+		},
+	}, lunaUsdc, ntrnUsdc, types.CurrencyPair{Base: "ATOM", Quote: "USDC"})
+
+	require.NoError(t, p.Poll())
+	require.Len(t, p.tickers, 2)
+	require.Equal(t, sdk.MustNewDecFromStr("0.5"), p.tickers["LUNAUSDC"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("1000"), p.tickers["LUNAUSDC"].Volume)
+	require.Equal(t, sdk.MustNewDecFromStr("0.4"), p.tickers["NTRNUSDC"].Price)
+}
@@ -0,0 +1,147 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	"github.com/rs/zerolog"
+)
+
+var (
+	_                        Provider = (*CrescentProvider)(nil)
+	crescentDefaultEndpoints          = Endpoint{
+		Name:         ProviderCrescent,
+		Urls:         []string{"https://rest.cosmos.directory/crescent"},
+		PollInterval: 10 * time.Second,
+	}
+)
+
+type (
+	// CrescentProvider defines an oracle provider querying the pools of the
+	// liquidity module of Crescent directly using the REST endpoint of a
+	// node. The `contracts` of the provider endpoints map the denoms to their
+	// chain denoms and the pairs to their pool ids, ex.: {"BCRE": "ubcre",
+	// "CRE": "ucre", "BCRECRE": "1"}. The base reserve of the pool is
+	// reported as volume.
+	//
+	// REF: https://github.com/crescent-network/crescent/tree/main/x/liquidity
+	CrescentProvider struct {
+		provider
+	}
+
+	CrescentPoolResponse struct {
+		Pool CrescentPool `json:"pool"`
+	}
+
+	CrescentPool struct {
+		Id       string           `json:"id"`    // ex.: "1"
+		Price    string           `json:"price"` // ex.: "1.086512741324539473"
+		Balances CrescentBalances `json:"balances"`
+	}
+
+	CrescentBalances struct {
+		BaseCoin  CrescentCoin `json:"base_coin"`
+		QuoteCoin CrescentCoin `json:"quote_coin"`
+	}
+
+	CrescentCoin struct {
+		Denom  string `json:"denom"`  // ex.: "ubcre"
+		Amount string `json:"amount"` // ex.: "1283459812375"
+	}
+)
+
+func NewCrescentProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*CrescentProvider, error) {
+	provider := &CrescentProvider{}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		nil,
+		nil,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
+
+func (p *CrescentProvider) Poll() error {
+	for symbol, pair := range p.pairs {
+		ticker, err := p.getTicker(pair)
+		if err != nil {
+			p.logger.Warn().Err(err).Str("pair", symbol).Msg("failed to query pool")
+			continue
+		}
+
+		p.mtx.Lock()
+		p.tickers[symbol] = ticker
+		p.mtx.Unlock()
+	}
+
+	p.logger.Debug().Msg("updated tickers")
+	return nil
+}
+
+func (p *CrescentProvider) getTicker(pair types.CurrencyPair) (types.TickerPrice, error) {
+	id, ok := p.endpoints.Contracts[pair.String()]
+	if !ok {
+		return types.TickerPrice{}, fmt.Errorf("no pool configured for %s", pair.String())
+	}
+	base, ok := p.endpoints.Contracts[pair.Base]
+	if !ok {
+		return types.TickerPrice{}, fmt.Errorf("no denom configured for %s", pair.Base)
+	}
+	quote, ok := p.endpoints.Contracts[pair.Quote]
+	if !ok {
+		return types.TickerPrice{}, fmt.Errorf("no denom configured for %s", pair.Quote)
+	}
+
+	content, err := p.httpGet("/crescent/liquidity/v1beta1/pools/" + id)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	var response CrescentPoolResponse
+	err = json.Unmarshal(content, &response)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	pool := response.Pool
+	if pool.Price == "" {
+		return types.TickerPrice{}, fmt.Errorf("pool %s has no price", id)
+	}
+	// the price of the pool is the one of its base coin in its quote coin
+	price := strToDec(pool.Price)
+	reserve := pool.Balances.BaseCoin
+	switch {
+	case pool.Balances.BaseCoin.Denom == base && pool.Balances.QuoteCoin.Denom == quote:
+	case pool.Balances.BaseCoin.Denom == quote && pool.Balances.QuoteCoin.Denom == base:
+		if !price.IsPositive() {
+			return types.TickerPrice{}, fmt.Errorf("pool %s has no price", id)
+		}
+		price = strToDec("1").Quo(price)
+		reserve = pool.Balances.QuoteCoin
+	default:
+		return types.TickerPrice{}, fmt.Errorf("pool %s doesn't contain %s", id, pair.String())
+	}
+
+	amount, err := cosmosParseAmount(reserve.Amount)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	return types.TickerPrice{
+		Price:  p.cosmosScalePrice(pair, price),
+		Volume: bigIntToDec(amount, p.cosmosDecimals(pair.Base)),
+		Time:   time.Now(),
+	}, nil
+}
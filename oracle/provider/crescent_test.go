@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCrescentProvider_Poll(t *testing.T) {
+	server := newTestServer(func(rw http.ResponseWriter, req *http.Request) {
+		_, _ = rw.Write([]byte(`{"pool":{"id":"1","price":"0.8","balances":{
+			"base_coin":{"denom":"ucre","amount":"1000000000000"},
+			"quote_coin":{"denom":"ubcre","amount":"800000000000"}
+		}}}`))
+	})
+	defer server.Close()
+
+	bcreCre := types.CurrencyPair{Base: "BCRE", Quote: "CRE"}
+	creBcre := types.CurrencyPair{Base: "CRE", Quote: "BCRE"}
+
+	p := newTestProvider(t, NewCrescentProvider, server, Endpoint{
+		Name:      ProviderCrescent,
+		Contracts: map[string]string{"BCRE": "ubcre", "CRE": "ucre", "BCRECRE": "1", "CREBCRE": "1"},
+	}, bcreCre, creBcre)
+
+	require.NoError(t, p.Poll())
+	require.Equal(t, sdk.MustNewDecFromStr("0.8"), p.tickers["CREBCRE"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("1000000"), p.tickers["CREBCRE"].Volume)
+	require.Equal(t, sdk.MustNewDecFromStr("1.25"), p.tickers["BCRECRE"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("800000"), p.tickers["BCRECRE"].Volume)
+
+	for _, req := range server.Requests() {
+		require.Equal(t, "/crescent/liquidity/v1beta1/pools/1", req.URL.Path)
+	}
+}
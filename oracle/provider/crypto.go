@@ -60,7 +60,7 @@ func NewCryptoProvider(
 		nil,
 		nil,
 	)
-	go startPolling(provider, provider.endpoints.PollInterval, logger)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
 	return provider, nil
 }
 
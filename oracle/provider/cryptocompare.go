@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+)
+
+var (
+	_                             Provider = (*CryptoCompareProvider)(nil)
+	cryptoCompareDefaultEndpoints          = Endpoint{
+		Name:         ProviderCryptoCompare,
+		Urls:         []string{"https://min-api.cryptocompare.com"},
+		PollInterval: 15 * time.Second,
+	}
+)
+
+type (
+	// CryptoCompareProvider defines an oracle provider polling the CCCAGG
+	// index of CryptoCompare, which aggregates the trades of all exchanges
+	// it tracks. An `api_key` is sent as "authorization" header to raise the
+	// rate limits. The index has no volume of its own, so its tickers are
+	// reported with a volume of one and are best used with the
+	// `referenceOnly` role to sanity check the computed prices.
+	//
+	// REF: https://min-api.cryptocompare.com/documentation?key=Price&cat=multipleSymbolsFullPriceEndpoint
+	CryptoCompareProvider struct {
+		provider
+	}
+
+	CryptoCompareResponse struct {
+		Response string                                        `json:"Response"` // ex.: "Error"
+		Message  string                                        `json:"Message"`  // ex.: "fsyms param is empty"
+		Raw      map[string]map[string]CryptoCompareTickerData `json:"RAW"`
+	}
+
+	CryptoCompareTickerData struct {
+		Price      float64 `json:"PRICE"`      // ex.: 9.87
+		LastUpdate int64   `json:"LASTUPDATE"` // ex.: 1700000000
+	}
+)
+
+func NewCryptoCompareProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*CryptoCompareProvider, error) {
+	provider := &CryptoCompareProvider{}
+	if endpoints.ApiKey != "" {
+		provider.httpHeaders = http.Header{
+			"Authorization": {"Apikey " + endpoints.ApiKey},
+		}
+	}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		nil,
+		nil,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
+
+func (p *CryptoCompareProvider) Poll() error {
+	bases := map[string]struct{}{}
+	quotes := map[string]struct{}{}
+	for _, pair := range p.pairs {
+		bases[pair.Base] = struct{}{}
+		quotes[pair.Quote] = struct{}{}
+	}
+	if len(bases) == 0 {
+		return nil
+	}
+
+	query := url.Values{}
+	query.Set("fsyms", joinKeys(bases))
+	query.Set("tsyms", joinKeys(quotes))
+	query.Set("e", "CCCAGG")
+
+	content, err := p.httpGet("/data/pricemultifull?" + query.Encode())
+	if err != nil {
+		return err
+	}
+
+	var response CryptoCompareResponse
+	err = json.Unmarshal(content, &response)
+	if err != nil {
+		return err
+	}
+	if response.Response == "Error" {
+		return fmt.Errorf("cryptocompare error: %s", response.Message)
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	for symbol, pair := range p.pairs {
+		data, ok := response.Raw[pair.Base][pair.Quote]
+		if !ok || data.Price <= 0 {
+			continue
+		}
+		p.tickers[symbol] = types.TickerPrice{
+			Price:  floatToDec(data.Price),
+			Volume: sdk.OneDec(),
+			Time:   time.Unix(data.LastUpdate, 0),
+		}
+	}
+
+	p.logger.Debug().Msg("updated tickers")
+	return nil
+}
@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCryptoCompareProvider_Poll(t *testing.T) {
+	server := newTestServer(func(rw http.ResponseWriter, req *http.Request) {
+		_, _ = rw.Write([]byte(`{"RAW":{
+			"ATOM":{"USD":{"PRICE":9.87,"LASTUPDATE":1700000000},"BTC":{"PRICE":0.0003,"LASTUPDATE":1700000001}},
+			"OSMO":{"USD":{"PRICE":0.55,"LASTUPDATE":1700000002}}
+		}}`))
+	})
+	defer server.Close()
+
+	atomUsd := types.CurrencyPair{Base: "ATOM", Quote: "USD"}
+	atomBtc := types.CurrencyPair{Base: "ATOM", Quote: "BTC"}
+	osmoUsd := types.CurrencyPair{Base: "OSMO", Quote: "USD"}
+
+	p := newTestProvider(t, NewCryptoCompareProvider, server, Endpoint{
+		Name: ProviderCryptoCompare,
+	}, atomUsd, atomBtc, osmoUsd)
+
+	require.NoError(t, p.Poll())
+	require.Equal(t, sdk.MustNewDecFromStr("9.87"), p.tickers["ATOMUSD"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("0.0003"), p.tickers["ATOMBTC"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("0.55"), p.tickers["OSMOUSD"].Price)
+	require.Equal(t, int64(1700000002), p.tickers["OSMOUSD"].Time.Unix())
+
+	requests := server.Requests()
+	require.Len(t, requests, 1)
+	require.Equal(t, "/data/pricemultifull", requests[0].URL.Path)
+	require.Equal(t, "ATOM,OSMO", requests[0].URL.Query().Get("fsyms"))
+	require.Equal(t, "BTC,USD", requests[0].URL.Query().Get("tsyms"))
+	require.Equal(t, "CCCAGG", requests[0].URL.Query().Get("e"))
+}
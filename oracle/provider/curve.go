@@ -79,7 +79,7 @@ func NewCurveProvider(
 		nil,
 		nil,
 	)
-	go startPolling(provider, provider.endpoints.PollInterval, logger)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
 	return provider, nil
 }
 
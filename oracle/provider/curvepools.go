@@ -0,0 +1,200 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	"github.com/rs/zerolog"
+)
+
+const (
+	// curvePoolsMaxCoins defines the maximum amount of coins of a Curve pool.
+	curvePoolsMaxCoins = 8
+)
+
+var (
+	_                          Provider = (*CurvePoolsProvider)(nil)
+	curvePoolsDefaultEndpoints          = Endpoint{
+		Name:         ProviderCurvePools,
+		Urls:         []string{"https://cloudflare-eth.com"},
+		PollInterval: 15 * time.Second,
+	}
+)
+
+type (
+	// CurvePoolsProvider defines an oracle provider quoting Curve pool
+	// contracts directly using an Ethereum JSON-RPC endpoint, as opposed to the
+	// curve.fi API used by the CurveProvider. The price is the amount of quote
+	// tokens received for one base token according to get_dy, which accounts
+	// for the pool fee. The `contracts` of the provider endpoints map the
+	// denoms to their token addresses and the pairs to their pool addresses,
+	// ex.: {"STETH": "0xae7a...", "ETH": "0xeeee...", "STETHETH": "0xdc24..."}.
+	// The base token balance of the pool is reported as volume.
+	//
+	// REF: https://docs.curve.fi/stableswap-exchange/stableswap/pools/plain_pools/#get_dy
+	CurvePoolsProvider struct {
+		provider
+		decimals *evmDecimalsCache
+		// coins caches the coin addresses of the pools
+		coins    map[string][]string
+		coinsMtx sync.Mutex
+	}
+)
+
+func NewCurvePoolsProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*CurvePoolsProvider, error) {
+	provider := &CurvePoolsProvider{
+		decimals: newEvmDecimalsCache(),
+		coins:    map[string][]string{},
+	}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		nil,
+		nil,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
+
+func (p *CurvePoolsProvider) Poll() error {
+	for symbol, pair := range p.pairs {
+		ticker, err := p.getTicker(pair)
+		if err != nil {
+			p.logger.Warn().Err(err).Str("pair", symbol).Msg("failed to get quote")
+			continue
+		}
+
+		p.mtx.Lock()
+		p.tickers[symbol] = ticker
+		p.mtx.Unlock()
+	}
+
+	p.logger.Debug().Msg("updated tickers")
+	return nil
+}
+
+func (p *CurvePoolsProvider) getTicker(pair types.CurrencyPair) (types.TickerPrice, error) {
+	pool, ok := p.endpoints.Contracts[pair.String()]
+	if !ok {
+		return types.TickerPrice{}, fmt.Errorf("no contract configured for %s", pair.String())
+	}
+	base, ok := p.endpoints.Contracts[pair.Base]
+	if !ok {
+		return types.TickerPrice{}, fmt.Errorf("no contract configured for %s", pair.Base)
+	}
+	quote, ok := p.endpoints.Contracts[pair.Quote]
+	if !ok {
+		return types.TickerPrice{}, fmt.Errorf("no contract configured for %s", pair.Quote)
+	}
+	base = strings.ToLower(base)
+	quote = strings.ToLower(quote)
+
+	coins, err := p.getCoins(pool)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+	i, j := int64(-1), int64(-1)
+	for index, coin := range coins {
+		switch coin {
+		case base:
+			i = int64(index)
+		case quote:
+			j = int64(index)
+		}
+	}
+	if i < 0 || j < 0 {
+		return types.TickerPrice{}, fmt.Errorf("pool %s doesn't contain %s", pool, pair.String())
+	}
+
+	baseDecimals, err := p.decimals.get(&p.provider, pair.Base, base)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+	quoteDecimals, err := p.decimals.get(&p.provider, pair.Quote, quote)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	// quote one whole base token
+	dx := new(big.Int).Exp(big.NewInt(10), big.NewInt(baseDecimals), nil)
+	args := [][]byte{
+		evmEncodeInt(big.NewInt(i)),
+		evmEncodeInt(big.NewInt(j)),
+		evmEncodeInt(dx),
+	}
+	// stableswap pools use int128 indices, cryptoswap pools uint256 indices
+	result, err := p.evmCall(pool, evmEncodeCall("get_dy(int128,int128,uint256)", args...))
+	if err != nil {
+		result, err = p.evmCall(pool, evmEncodeCall("get_dy(uint256,uint256,uint256)", args...))
+		if err != nil {
+			return types.TickerPrice{}, err
+		}
+	}
+	dy, err := evmDecodeUint(result, 0)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	result, err = p.evmCall(pool, evmEncodeCall("balances(uint256)", evmEncodeInt(big.NewInt(i))))
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+	balance, err := evmDecodeUint(result, 0)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	return types.TickerPrice{
+		Price:  bigIntToDec(dy, quoteDecimals),
+		Volume: bigIntToDec(balance, baseDecimals),
+		Time:   time.Now(),
+	}, nil
+}
+
+// getCoins returns the coin addresses of the pool, which are queried until
+// the call reverts for an index out of range.
+func (p *CurvePoolsProvider) getCoins(pool string) ([]string, error) {
+	p.coinsMtx.Lock()
+	defer p.coinsMtx.Unlock()
+
+	if coins, ok := p.coins[pool]; ok {
+		return coins, nil
+	}
+
+	coins := []string{}
+	for i := int64(0); i < curvePoolsMaxCoins; i++ {
+		result, err := p.evmCall(pool, evmEncodeCall("coins(uint256)", evmEncodeInt(big.NewInt(i))))
+		var rpcErr *EvmRpcError
+		if errors.As(err, &rpcErr) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		coin, err := evmDecodeAddress(result, 0)
+		if err != nil {
+			return nil, err
+		}
+		coins = append(coins, coin)
+	}
+	if len(coins) < 2 {
+		return nil, fmt.Errorf("failed to get coins of pool %s", pool)
+	}
+
+	p.coins[pool] = coins
+	return coins, nil
+}
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCurvePoolsProvider_Poll(t *testing.T) {
+	const (
+		eth   = "0xeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeee"
+		steth = "0xae7ab96520de3a18e5e111b5eaab095312d7fe84"
+		pool  = "0xdc24316b9ae028f1497c275eb9192a3ea0f67022"
+	)
+
+	coinsSelector := hex.EncodeToString(evmEncodeCall("coins(uint256)"))
+	getDySelector := hex.EncodeToString(evmEncodeCall("get_dy(int128,int128,uint256)"))
+	balancesSelector := hex.EncodeToString(evmEncodeCall("balances(uint256)"))
+
+	server := newEvmTestServer(func(params EvmCallParams) []byte {
+		selector, args, err := decodeEvmTestData(params)
+		if params.To != pool || err != nil {
+			return nil
+		}
+
+		switch selector {
+		case coinsSelector:
+			index := new(big.Int).SetBytes(args).Int64()
+			if index > 1 {
+				return nil
+			}
+			coin, _ := evmEncodeAddress([]string{eth, steth}[index])
+			return coin
+		case getDySelector:
+			dy, _ := new(big.Int).SetString("999500000000000000", 10)
+			return evmEncodeInt(dy)
+		case balancesSelector:
+			balance, _ := new(big.Int).SetString("50000000000000000000000", 10)
+			return evmEncodeInt(balance)
+		}
+		return nil
+	})
+	defer server.Close()
+
+	stethEth := types.CurrencyPair{Base: "STETH", Quote: "ETH"}
+
+	p := newTestProvider(t, NewCurvePoolsProvider, server, Endpoint{
+		Name:      ProviderCurvePools,
+		Contracts: map[string]string{"STETH": steth, "ETH": eth, "STETHETH": pool},
+		Decimals:  map[string]int64{"STETH": 18, "ETH": 18},
+	}, stethEth)
+
+	require.NoError(t, p.Poll())
+	require.Equal(t, sdk.MustNewDecFromStr("0.9995"), p.tickers["STETHETH"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("50000"), p.tickers["STETHETH"].Volume)
+	require.Equal(t, []string{eth, steth}, p.coins[pool])
+
+	// the price is quoted for swapping stETH (1) to ETH (0)
+	for _, call := range evmTestCalls(t, server) {
+		require.Equal(t, pool, call.To)
+		selector, args, err := decodeEvmTestData(call)
+		require.NoError(t, err)
+		if selector == getDySelector {
+			require.Equal(t, int64(1), new(big.Int).SetBytes(args[:32]).Int64())
+			require.Equal(t, int64(0), new(big.Int).SetBytes(args[32:64]).Int64())
+		}
+	}
+}
@@ -0,0 +1,169 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/url"
+	"strings"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+)
+
+var (
+	_                     Provider = (*DemexProvider)(nil)
+	demexDefaultEndpoints          = Endpoint{
+		Name:         ProviderDemex,
+		Urls:         []string{"https://api.carbon.network"},
+		PollInterval: 5 * time.Second,
+	}
+
+	// demexDefaultDepthBand defines the band around the mid price the depth
+	// is summed up in, unless configured as `depth_band`.
+	demexDefaultDepthBand = sdk.MustNewDecFromStr("0.02")
+)
+
+type (
+	// DemexProvider defines an oracle provider implemented by the orderbook
+	// API of Carbon, the chain Demex is built on. The price is the mid price
+	// of the book and the volume is the base liquidity resting within the
+	// depth band around it. The book is denominated in the chain denoms, so
+	// the decimals of the currencies have to be configured unless they're
+	// six. The `contracts` of the provider endpoints map the pairs to their
+	// market ids, ex.: {"SWTHUSDC": "cmkt/117"}, otherwise the lower case
+	// symbols joined by "_" are used, ex.: "swth_usdc".
+	//
+	// REF: https://api-docs.carbon.network
+	DemexProvider struct {
+		provider
+	}
+
+	DemexBookResponse struct {
+		Book DemexBook `json:"book"`
+	}
+
+	DemexBook struct {
+		MarketId string           `json:"market_id"` // ex.: "cmkt/117"
+		Bids     []DemexBookLevel `json:"bids"`
+		Asks     []DemexBookLevel `json:"asks"`
+	}
+
+	DemexBookLevel struct {
+		Price    string `json:"price"`          // ex.: "0.0000358"
+		Quantity string `json:"total_quantity"` // ex.: "1289000000000"
+	}
+)
+
+func NewDemexProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*DemexProvider, error) {
+	provider := &DemexProvider{}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		nil,
+		nil,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
+
+func (p *DemexProvider) Poll() error {
+	for symbol, pair := range p.pairs {
+		ticker, err := p.getTicker(pair)
+		if err != nil {
+			p.logger.Warn().Err(err).Str("pair", symbol).Msg("failed to get book")
+			continue
+		}
+
+		p.mtx.Lock()
+		p.tickers[symbol] = ticker
+		p.mtx.Unlock()
+	}
+
+	p.logger.Debug().Msg("updated tickers")
+	return nil
+}
+
+func (p *DemexProvider) getTicker(pair types.CurrencyPair) (types.TickerPrice, error) {
+	content, err := p.httpGet("/carbon/book/v1/books/" + url.PathEscape(p.CurrencyPairToProviderPair(pair)))
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	var response DemexBookResponse
+	err = json.Unmarshal(content, &response)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	// the prices are denominated in the chain denoms
+	baseDecimals := p.cosmosDecimals(pair.Base)
+	toLevels := func(levels []DemexBookLevel) ([][2]string, error) {
+		converted := make([][2]string, len(levels))
+		for i, level := range levels {
+			price, err := decFromString(level.Price)
+			if err != nil {
+				return nil, err
+			}
+			amount, ok := new(big.Int).SetString(level.Quantity, 10)
+			if !ok {
+				amount = big.NewInt(0)
+			}
+			converted[i] = [2]string{
+				p.cosmosScalePrice(pair, price).String(),
+				bigIntToDec(amount, baseDecimals).String(),
+			}
+		}
+		return converted, nil
+	}
+	bids, err := toLevels(response.Book.Bids)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+	asks, err := toLevels(response.Book.Asks)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	band := p.endpoints.DepthBand
+	if band.IsNil() {
+		band = demexDefaultDepthBand
+	}
+	depth, _, ok := orderbookDepth(bids, asks, band)
+	if !ok {
+		return types.TickerPrice{}, fmt.Errorf("empty book")
+	}
+
+	bid, err := decFromString(bids[0][0])
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+	ask, err := decFromString(asks[0][0])
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+	return types.TickerPrice{
+		Price:  bid.Add(ask).QuoInt64(2),
+		Volume: depth,
+		Time:   time.Now(),
+		Spread: computeSpread(bid, ask),
+	}, nil
+}
+
+func (p *DemexProvider) CurrencyPairToProviderPair(pair types.CurrencyPair) string {
+	if market, ok := p.endpoints.Contracts[pair.String()]; ok {
+		return market
+	}
+	return strings.ToLower(pair.Join("_"))
+}
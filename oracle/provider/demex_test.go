@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDemexProvider_Poll(t *testing.T) {
+	server := newTestServer(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/carbon/book/v1/books/swth_usdt" {
+			_, _ = rw.Write([]byte(`{"book":{"market_id":"swth_usdt","bids":[
+				{"price":"","total_quantity":"100000000000"}
+			],"asks":[
+				{"price":"0.000051","total_quantity":"200000000000"}
+			]}}`))
+			return
+		}
+		_, _ = rw.Write([]byte(`{"book":{"market_id":"cmkt/117","bids":[
+			{"price":"0.000049","total_quantity":"100000000000"},
+			{"price":"0.000040","total_quantity":"900000000000"}
+		],"asks":[
+			{"price":"0.000051","total_quantity":"200000000000"},
+			{"price":"0.000060","total_quantity":"900000000000"}
+		]}}`))
+	})
+	defer server.Close()
+
+	swthUsdc := types.CurrencyPair{Base: "SWTH", Quote: "USDC"}
+	swthUsdt := types.CurrencyPair{Base: "SWTH", Quote: "USDT"}
+
+	p := newTestProvider(t, NewDemexProvider, server, Endpoint{
+		Name:      ProviderDemex,
+		Contracts: map[string]string{"SWTHUSDC": "cmkt/117"},
+		Decimals:  map[string]int64{"SWTH": 8},
+	}, swthUsdc, swthUsdt)
+
+	require.NoError(t, p.Poll())
+	paths := []string{}
+	for _, req := range server.Requests() {
+		paths = append(paths, req.URL.EscapedPath())
+	}
+	require.ElementsMatch(t, []string{
+		"/carbon/book/v1/books/cmkt%2F117",
+		"/carbon/book/v1/books/swth_usdt",
+	}, paths)
+
+	// books with unparsable prices are skipped
+	require.NotContains(t, p.tickers, "SWTHUSDT")
+
+	ticker, ok := p.tickers["SWTHUSDC"]
+	require.True(t, ok)
+	require.Equal(t, sdk.MustNewDecFromStr("0.005"), ticker.Price)
+	// 1000 SWTH of the bids and 2000 SWTH of the asks are within ±2%
+	require.Equal(t, sdk.MustNewDecFromStr("3000"), ticker.Volume)
+}
+
+func TestDemexProvider_CurrencyPairToProviderPair(t *testing.T) {
+	p := &DemexProvider{}
+	require.Equal(t, "swth_usdc", p.CurrencyPairToProviderPair(types.CurrencyPair{Base: "SWTH", Quote: "USDC"}))
+}
@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+)
+
+var (
+	_                       Provider = (*DeribitProvider)(nil)
+	deribitDefaultEndpoints          = Endpoint{
+		Name:         ProviderDeribit,
+		Urls:         []string{"https://www.deribit.com"},
+		PollInterval: 5 * time.Second,
+	}
+)
+
+type (
+	// DeribitProvider defines an oracle provider polling the index prices of
+	// Deribit, ex.: "btc_usd", which are already aggregated over several
+	// exchanges. Indices don't have a volume, so their tickers are reported
+	// with a volume of one and are best used with the `referenceOnly` role.
+	//
+	// REF: https://docs.deribit.com/#public-get_index_price
+	DeribitProvider struct {
+		provider
+	}
+
+	DeribitIndexResponse struct {
+		Result DeribitIndex  `json:"result"`
+		Error  *DeribitError `json:"error"`
+		UsOut  int64         `json:"usOut"` // ex.: 1677666151422640
+	}
+
+	DeribitIndex struct {
+		Price float64 `json:"index_price"` // ex.: 23012.5
+	}
+
+	DeribitError struct {
+		Code    int64  `json:"code"`
+		Message string `json:"message"`
+	}
+)
+
+func NewDeribitProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*DeribitProvider, error) {
+	provider := &DeribitProvider{}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		nil,
+		nil,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
+
+func (p *DeribitProvider) Poll() error {
+	for symbol, pair := range p.pairs {
+		ticker, err := p.getIndexPrice(pair)
+		if err != nil {
+			p.logger.Warn().Err(err).Str("pair", symbol).Msg("failed to get index price")
+			continue
+		}
+
+		p.mtx.Lock()
+		p.tickers[symbol] = ticker
+		p.mtx.Unlock()
+	}
+
+	p.logger.Debug().Msg("updated tickers")
+	return nil
+}
+
+func (p *DeribitProvider) getIndexPrice(pair types.CurrencyPair) (types.TickerPrice, error) {
+	path := "/api/v2/public/get_index_price?index_name=" + p.CurrencyPairToProviderPair(pair)
+	content, err := p.httpGet(path)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	var indexResponse DeribitIndexResponse
+	err = json.Unmarshal(content, &indexResponse)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	if indexResponse.Error != nil {
+		return types.TickerPrice{}, fmt.Errorf(
+			"%d: %s",
+			indexResponse.Error.Code,
+			indexResponse.Error.Message,
+		)
+	}
+
+	return types.TickerPrice{
+		Price:  floatToDec(indexResponse.Result.Price),
+		Volume: sdk.OneDec(),
+		Time:   time.UnixMicro(indexResponse.UsOut),
+	}, nil
+}
+
+// CurrencyPairToProviderPair returns the name of the index, ex.:
+// "BTCUSD" -> "btc_usd".
+func (p *DeribitProvider) CurrencyPairToProviderPair(pair types.CurrencyPair) string {
+	return strings.ToLower(pair.Join("_"))
+}
@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	"github.com/rs/zerolog"
+)
+
+var (
+	_                      Provider = (*DexterProvider)(nil)
+	dexterDefaultEndpoints          = Endpoint{
+		Name:         ProviderDexter,
+		Urls:         []string{"https://rest.cosmos.directory/persistence"},
+		PollInterval: 10 * time.Second,
+	}
+)
+
+type (
+	// DexterProvider defines an oracle provider querying Dexter pool contracts
+	// on Persistence directly using the REST endpoint of a node. The price is
+	// simulated for one base token and includes the spread and fee. The
+	// `contracts` of the provider endpoints map the denoms to their chain
+	// denoms or "cw20:" prefixed token addresses and the pairs to their pool
+	// contracts. The base reserve of the pool is reported as volume.
+	//
+	// REF: https://docs.dexter.zone/smart-contracts/pool
+	DexterProvider struct {
+		provider
+	}
+
+	DexterConfigResponse struct {
+		Assets []CosmwasmAsset `json:"assets"`
+	}
+
+	DexterSwapResponse struct {
+		TradeParams DexterTradeParams `json:"trade_params"`
+		Fee         *CosmwasmAsset    `json:"fee"`
+	}
+
+	DexterTradeParams struct {
+		AmountIn  string `json:"amount_in"`  // ex.: "1000000"
+		AmountOut string `json:"amount_out"` // ex.: "1214235"
+		Spread    string `json:"spread"`     // ex.: "12"
+	}
+)
+
+func NewDexterProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*DexterProvider, error) {
+	provider := &DexterProvider{}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		nil,
+		nil,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
+
+func (p *DexterProvider) Poll() error {
+	for symbol, pair := range p.pairs {
+		ticker, err := p.getTicker(pair)
+		if err != nil {
+			p.logger.Warn().Err(err).Str("pair", symbol).Msg("failed to query pool")
+			continue
+		}
+
+		p.mtx.Lock()
+		p.tickers[symbol] = ticker
+		p.mtx.Unlock()
+	}
+
+	p.logger.Debug().Msg("updated tickers")
+	return nil
+}
+
+func (p *DexterProvider) getTicker(pair types.CurrencyPair) (types.TickerPrice, error) {
+	contract, ok := p.endpoints.Contracts[pair.String()]
+	if !ok {
+		return types.TickerPrice{}, fmt.Errorf("no contract configured for %s", pair.String())
+	}
+	base, ok := p.endpoints.Contracts[pair.Base]
+	if !ok {
+		return types.TickerPrice{}, fmt.Errorf("no denom configured for %s", pair.Base)
+	}
+	quote, ok := p.endpoints.Contracts[pair.Quote]
+	if !ok {
+		return types.TickerPrice{}, fmt.Errorf("no denom configured for %s", pair.Quote)
+	}
+	baseInfo := newCosmwasmAssetInfo(base)
+	quoteInfo := newCosmwasmAssetInfo(quote)
+	baseDecimals := p.cosmosDecimals(pair.Base)
+	quoteDecimals := p.cosmosDecimals(pair.Quote)
+
+	var config DexterConfigResponse
+	err := p.wasmQuery(contract, map[string]interface{}{"config": struct{}{}}, &config)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	var reserve *big.Int
+	for _, asset := range config.Assets {
+		if asset.Info.Equal(baseInfo) {
+			reserve, err = cosmosParseAmount(asset.Amount)
+			if err != nil {
+				return types.TickerPrice{}, err
+			}
+		}
+	}
+	if reserve == nil {
+		return types.TickerPrice{}, fmt.Errorf("pool %s doesn't contain %s", contract, base)
+	}
+
+	// simulate swapping one whole base token
+	offerAmount := new(big.Int).Exp(big.NewInt(10), big.NewInt(baseDecimals), nil)
+	var swap DexterSwapResponse
+	err = p.wasmQuery(contract, map[string]interface{}{
+		"on_swap": map[string]interface{}{
+			"swap_type":   map[string]interface{}{"give_in": struct{}{}},
+			"offer_asset": baseInfo,
+			"ask_asset":   quoteInfo,
+			"amount":      offerAmount.String(),
+		},
+	}, &swap)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	amountOut := big.NewInt(0)
+	for _, value := range []string{swap.TradeParams.AmountOut, swap.TradeParams.Spread} {
+		if value == "" {
+			continue
+		}
+		parsed, err := cosmosParseAmount(value)
+		if err != nil {
+			return types.TickerPrice{}, err
+		}
+		amountOut.Add(amountOut, parsed)
+	}
+
+	// depending on the pool type the fee is charged in the offered or asked
+	// asset, it's excluded from the offer or included in the return
+	// respectively
+	amountIn := offerAmount
+	if swap.Fee != nil {
+		fee, err := cosmosParseAmount(swap.Fee.Amount)
+		if err != nil {
+			return types.TickerPrice{}, err
+		}
+		switch {
+		case swap.Fee.Info.Equal(baseInfo):
+			amountIn = new(big.Int).Sub(offerAmount, fee)
+		case swap.Fee.Info.Equal(quoteInfo):
+			amountOut.Add(amountOut, fee)
+		}
+	}
+	if amountIn.Sign() <= 0 {
+		return types.TickerPrice{}, fmt.Errorf("invalid fee of %s", contract)
+	}
+
+	return types.TickerPrice{
+		Price:  bigIntToDec(amountOut, quoteDecimals).Quo(bigIntToDec(amountIn, baseDecimals)),
+		Volume: bigIntToDec(reserve, baseDecimals),
+		Time:   time.Now(),
+	}, nil
+}
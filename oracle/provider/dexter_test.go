@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDexterProvider_Poll(t *testing.T) {
+	const (
+		pool    = "persistence1pool"
+		stkatom = "stk/uatom"
+		atom    = "ibc/C8A74ABBE2AF892E15680D916A7C22130585CE5704F9B17A10F184A90D53BECA"
+	)
+
+	server := newWasmTestServer(func(contract string, query map[string]json.RawMessage) interface{} {
+		switch {
+		case query["config"] != nil:
+			return DexterConfigResponse{Assets: []CosmwasmAsset{
+				{Info: newCosmwasmAssetInfo(atom), Amount: "1100000000000"},
+				{Info: newCosmwasmAssetInfo(stkatom), Amount: "1000000000000"},
+			}}
+		case query["on_swap"] != nil:
+			return DexterSwapResponse{
+				TradeParams: DexterTradeParams{AmountIn: "1000000", AmountOut: "1096698", Spread: "2"},
+				Fee:         &CosmwasmAsset{Info: newCosmwasmAssetInfo(stkatom), Amount: "3000"},
+			}
+		}
+		return nil
+	})
+	defer server.Close()
+
+	stkatomAtom := types.CurrencyPair{Base: "STKATOM", Quote: "ATOM"}
+
+	p := newTestProvider(t, NewDexterProvider, server, Endpoint{
+		Name:      ProviderDexter,
+		Contracts: map[string]string{"STKATOM": stkatom, "ATOM": atom, "STKATOMATOM": pool},
+	}, stkatomAtom)
+
+	require.NoError(t, p.Poll())
+	require.Equal(t, sdk.MustNewDecFromStr("1.1"), p.tickers["STKATOMATOM"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("1000000"), p.tickers["STKATOMATOM"].Volume)
+
+	for _, query := range wasmTestQueries(t, server) {
+		require.Equal(t, pool, query.contract)
+		if query.query["on_swap"] != nil {
+			require.JSONEq(t, `{
+				"swap_type":{"give_in":{}},
+				"offer_asset":{"native_token":{"denom":"stk/uatom"}},
+				"ask_asset":{"native_token":{"denom":"`+atom+`"}},
+				"amount":"1000000"
+			}`, string(query.query["on_swap"]))
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+)
+
+var (
+	_                   Provider = (*DiaProvider)(nil)
+	diaDefaultEndpoints          = Endpoint{
+		Name:         ProviderDia,
+		Urls:         []string{"https://api.diadata.org"},
+		PollInterval: 30 * time.Second,
+	}
+
+	// diaDefaultAssets maps the denoms to their DIA blockchain and address.
+	diaDefaultAssets = map[string]string{
+		"BTC": "Bitcoin/0x0000000000000000000000000000000000000000",
+		"ETH": "Ethereum/0x0000000000000000000000000000000000000000",
+	}
+)
+
+type (
+	// DiaProvider defines an oracle provider polling the asset quotations of
+	// DIA, which aggregates the trades of CEXes and DEXes. The `contracts` of
+	// the provider endpoints map the denoms to their blockchain and address,
+	// ex.: {"ATOM": "Cosmos/0x0000000000000000000000000000000000000000"}.
+	// Other denoms are requested by their symbol. All prices are quoted in
+	// USD and don't have a volume, so their tickers are reported with a
+	// volume of one and are best used with the `referenceOnly` role.
+	//
+	// REF: https://docs.diadata.org/products/token-price-feeds/api-endpoints
+	DiaProvider struct {
+		provider
+	}
+
+	DiaQuotation struct {
+		Symbol string    `json:"Symbol"` // ex.: "ATOM"
+		Price  float64   `json:"Price"`  // ex.: 9.87
+		Time   time.Time `json:"Time"`   // ex.: "2023-11-14T22:13:20Z"
+	}
+)
+
+func NewDiaProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*DiaProvider, error) {
+	provider := &DiaProvider{}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		nil,
+		nil,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
+
+func (p *DiaProvider) Poll() error {
+	for symbol, pair := range p.pairs {
+		if pair.Quote != "USD" {
+			continue
+		}
+
+		ticker, err := p.getQuotation(pair.Base)
+		if err != nil {
+			p.logger.Warn().Err(err).Str("pair", symbol).Msg("failed to get quotation")
+			continue
+		}
+
+		p.mtx.Lock()
+		p.tickers[symbol] = ticker
+		p.mtx.Unlock()
+	}
+
+	p.logger.Debug().Msg("updated tickers")
+	return nil
+}
+
+func (p *DiaProvider) getQuotation(denom string) (types.TickerPrice, error) {
+	asset, ok := p.endpoints.Contracts[denom]
+	if !ok {
+		asset, ok = diaDefaultAssets[denom]
+	}
+
+	path := "/v1/quotation/" + url.PathEscape(denom)
+	if ok {
+		path = "/v1/assetQuotation/" + asset
+	}
+
+	content, err := p.httpGet(path)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	var quotation DiaQuotation
+	err = json.Unmarshal(content, &quotation)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	if quotation.Price <= 0 {
+		return types.TickerPrice{}, fmt.Errorf("invalid price: %f", quotation.Price)
+	}
+
+	return types.TickerPrice{
+		Price:  floatToDec(quotation.Price),
+		Volume: sdk.OneDec(),
+		Time:   quotation.Time,
+	}, nil
+}
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiaProvider_Poll(t *testing.T) {
+	server := newTestServer(func(rw http.ResponseWriter, req *http.Request) {
+		var response string
+		switch req.URL.Path {
+		case "/v1/assetQuotation/Cosmos/0x0000000000000000000000000000000000000000":
+			response = `{"Symbol":"ATOM","Price":9.87,"Time":"2023-11-14T22:13:20Z"}`
+		case "/v1/assetQuotation/Bitcoin/0x0000000000000000000000000000000000000000":
+			response = `{"Symbol":"BTC","Price":37000.5,"Time":"2023-11-14T22:13:20Z"}`
+		case "/v1/quotation/OSMO":
+			response = `{"Symbol":"OSMO","Price":0.55,"Time":"2023-11-14T22:13:20Z"}`
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = rw.Write([]byte(response))
+	})
+	defer server.Close()
+
+	atomUsd := types.CurrencyPair{Base: "ATOM", Quote: "USD"}
+	btcUsd := types.CurrencyPair{Base: "BTC", Quote: "USD"}
+	osmoUsd := types.CurrencyPair{Base: "OSMO", Quote: "USD"}
+
+	p := newTestProvider(t, NewDiaProvider, server, Endpoint{
+		Name: ProviderDia,
+		Contracts: map[string]string{
+			"ATOM": "Cosmos/0x0000000000000000000000000000000000000000",
+		},
+	}, atomUsd, btcUsd, osmoUsd)
+
+	require.NoError(t, p.Poll())
+	require.Equal(t, sdk.MustNewDecFromStr("9.87"), p.tickers["ATOMUSD"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("37000.5"), p.tickers["BTCUSD"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("0.55"), p.tickers["OSMOUSD"].Price)
+	require.Equal(t, int64(1700000000), p.tickers["OSMOUSD"].Time.Unix())
+}
@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+)
+
+const (
+	// driftPricePrecision defines the decimals of Drift prices.
+	driftPricePrecision = 6
+)
+
+var (
+	_                     Provider = (*DriftProvider)(nil)
+	driftDefaultEndpoints          = Endpoint{
+		Name:         ProviderDrift,
+		Urls:         []string{"https://dlob.drift.trade"},
+		PollInterval: 5 * time.Second,
+	}
+)
+
+type (
+	// DriftProvider defines an oracle provider polling the orderbooks of the
+	// Drift perpetual markets on Solana from the decentralized limit orderbook
+	// (DLOB) server. The price is the mid price of the book, falling back to
+	// the oracle price of the market if one of its sides is empty. Perpetuals
+	// don't report a spot volume, so their tickers are reported with a volume
+	// of one and are best used with the `referenceOnly` role.
+	//
+	// REF: https://drift-labs.github.io/v2-teacher/#orderbook-trades-dlob-server
+	DriftProvider struct {
+		provider
+	}
+
+	DriftL2Response struct {
+		Bids   []DriftLevel `json:"bids"`
+		Asks   []DriftLevel `json:"asks"`
+		Oracle json.Number  `json:"oracle"` // ex.: 147250000
+		Time   int64        `json:"ts"`     // ex.: 1677666151422
+	}
+
+	DriftLevel struct {
+		Price json.Number `json:"price"` // ex.: "147230000"
+		Size  json.Number `json:"size"`  // ex.: "12000000000"
+	}
+)
+
+func NewDriftProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*DriftProvider, error) {
+	provider := &DriftProvider{}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		nil,
+		nil,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
+
+func (p *DriftProvider) Poll() error {
+	for symbol, pair := range p.pairs {
+		// all markets are quoted in USD
+		if pair.Quote != "USD" {
+			continue
+		}
+
+		ticker, err := p.getTicker(pair)
+		if err != nil {
+			p.logger.Warn().Err(err).Str("pair", symbol).Msg("failed to get orderbook")
+			continue
+		}
+
+		p.mtx.Lock()
+		p.tickers[symbol] = ticker
+		p.mtx.Unlock()
+	}
+
+	p.logger.Debug().Msg("updated tickers")
+	return nil
+}
+
+func (p *DriftProvider) getTicker(pair types.CurrencyPair) (types.TickerPrice, error) {
+	path := fmt.Sprintf(
+		"/l2?marketName=%s&depth=1&includeOracle=true",
+		p.CurrencyPairToProviderPair(pair),
+	)
+	content, err := p.httpGet(path)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	var l2 DriftL2Response
+	err = json.Unmarshal(content, &l2)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	ticker := types.TickerPrice{
+		Volume: sdk.OneDec(),
+		Time:   time.UnixMilli(l2.Time),
+	}
+	if len(l2.Bids) > 0 && len(l2.Asks) > 0 {
+		bid, err := driftParsePrice(l2.Bids[0].Price)
+		if err != nil {
+			return types.TickerPrice{}, err
+		}
+		ask, err := driftParsePrice(l2.Asks[0].Price)
+		if err != nil {
+			return types.TickerPrice{}, err
+		}
+		ticker.Price = bid.Add(ask).QuoInt64(2)
+		ticker.Spread = computeSpread(bid, ask)
+		return ticker, nil
+	}
+
+	if l2.Oracle == "" {
+		return types.TickerPrice{}, fmt.Errorf("no price available")
+	}
+	ticker.Price, err = driftParsePrice(l2.Oracle)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+	return ticker, nil
+}
+
+// driftParsePrice returns the price with the precision of Drift prices, ex.:
+// "147230000" -> 147.23.
+func driftParsePrice(price json.Number) (sdk.Dec, error) {
+	value, ok := new(big.Int).SetString(price.String(), 10)
+	if !ok {
+		return sdk.Dec{}, fmt.Errorf("invalid price: %s", price)
+	}
+	return bigIntToDec(value, driftPricePrecision), nil
+}
+
+// CurrencyPairToProviderPair returns the name of the perpetual market, ex.:
+// "SOLUSD" -> "SOL-PERP".
+func (p *DriftProvider) CurrencyPairToProviderPair(pair types.CurrencyPair) string {
+	return pair.Base + "-PERP"
+}
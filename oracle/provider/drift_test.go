@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDriftProvider_Poll(t *testing.T) {
+	server := newTestServer(func(rw http.ResponseWriter, req *http.Request) {
+		var response string
+		switch req.URL.Query().Get("marketName") {
+		case "SOL-PERP":
+			response = `{"bids":[{"price":"147230000","size":"12000000000"}],"asks":[{"price":"147250000","size":"3000000000"}],"oracle":147245000,"ts":1677666151422}`
+		case "JTO-PERP":
+			response = `{"bids":[],"asks":[{"price":"2510000","size":"1000000000"}],"oracle":2500000,"ts":1677666151422}`
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = rw.Write([]byte(response))
+	})
+	defer server.Close()
+
+	solUsd := types.CurrencyPair{Base: "SOL", Quote: "USD"}
+	jtoUsd := types.CurrencyPair{Base: "JTO", Quote: "USD"}
+
+	p := newTestProvider(t, NewDriftProvider, server, Endpoint{
+		Name: ProviderDrift,
+	}, solUsd, jtoUsd)
+
+	require.NoError(t, p.Poll())
+	require.Equal(t, sdk.MustNewDecFromStr("147.24"), p.tickers["SOLUSD"].Price)
+	require.False(t, p.tickers["SOLUSD"].Spread.IsNil())
+	require.Equal(t, sdk.MustNewDecFromStr("2.5"), p.tickers["JTOUSD"].Price)
+
+	for _, req := range server.Requests() {
+		require.Equal(t, "/l2", req.URL.Path)
+	}
+}
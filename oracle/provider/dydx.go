@@ -0,0 +1,205 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+)
+
+var (
+	_                    Provider = (*DydxProvider)(nil)
+	dydxDefaultEndpoints          = Endpoint{
+		Name:          ProviderDydx,
+		Urls:          []string{"https://indexer.dydx.trade"},
+		Websocket:     "indexer.dydx.trade",
+		WebsocketPath: "/v4/ws",
+	}
+)
+
+type (
+	// DydxProvider defines an oracle provider implemented by the dYdX v4
+	// indexer websocket API. It reports the oracle prices of the perpetual
+	// markets, which are settled in USD, ex.: "BTCUSD". The 24h volumes of
+	// the markets are quoted in USD and converted to the base currency.
+	//
+	// REF: https://docs.dydx.exchange/api_integration-indexer/indexer_websocket#markets
+	DydxProvider struct {
+		provider
+		// volumes stores the 24h USD volumes of the markets
+		volumes map[string]sdk.Dec
+	}
+
+	DydxSubscriptionMsg struct {
+		Type    string `json:"type"`    // ex.: "subscribe"
+		Channel string `json:"channel"` // ex.: "v4_markets"
+		Batched bool   `json:"batched"`
+	}
+
+	// DydxMarketsMsg defines the initial snapshot or an update of the markets,
+	// the contents of batched updates are a list of updates.
+	DydxMarketsMsg struct {
+		Type     string          `json:"type"` // ex.: "subscribed", "channel_data", "channel_batch_data"
+		Channel  string          `json:"channel"`
+		Message  string          `json:"message"`
+		Contents json.RawMessage `json:"contents"`
+	}
+
+	DydxMarketsContents struct {
+		Markets      map[string]DydxMarket      `json:"markets"`
+		Trading      map[string]DydxMarket      `json:"trading"`
+		OraclePrices map[string]DydxOraclePrice `json:"oraclePrices"`
+	}
+
+	DydxMarket struct {
+		OraclePrice string `json:"oraclePrice"` // ex.: "61346.52"
+		Volume      string `json:"volume24H"`   // ex.: "493819328.12"
+	}
+
+	DydxOraclePrice struct {
+		OraclePrice string `json:"oraclePrice"` // ex.: "61346.52"
+	}
+)
+
+func NewDydxProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*DydxProvider, error) {
+	provider := &DydxProvider{
+		volumes: map[string]sdk.Dec{},
+	}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		provider.messageReceived,
+		provider.getSubscriptionMsgs,
+	)
+	return provider, nil
+}
+
+// getSubscriptionMsgs subscribes to all markets, since the channel doesn't
+// support subscribing to single markets.
+func (p *DydxProvider) getSubscriptionMsgs(...types.CurrencyPair) []interface{} {
+	return []interface{}{
+		DydxSubscriptionMsg{
+			Type:    "subscribe",
+			Channel: "v4_markets",
+			Batched: true,
+		},
+	}
+}
+
+func (p *DydxProvider) messageReceived(messageType int, bz []byte) {
+	var marketsMsg DydxMarketsMsg
+	err := json.Unmarshal(bz, &marketsMsg)
+	if err != nil {
+		p.logger.Error().Err(err).Msg("failed to unmarshal message")
+		return
+	}
+
+	var contents []DydxMarketsContents
+	switch marketsMsg.Type {
+	case "subscribed", "channel_data":
+		var content DydxMarketsContents
+		err = json.Unmarshal(marketsMsg.Contents, &content)
+		contents = append(contents, content)
+	case "channel_batch_data":
+		err = json.Unmarshal(marketsMsg.Contents, &contents)
+	case "error":
+		p.logger.Error().Str("msg", marketsMsg.Message).Msg("received error message")
+		return
+	default:
+		// connected messages don't contain any data
+		return
+	}
+	if err != nil {
+		p.logger.Error().Err(err).Msg("failed to unmarshal contents")
+		return
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	for _, content := range contents {
+		for _, markets := range []map[string]DydxMarket{content.Markets, content.Trading} {
+			for market, update := range markets {
+				p.updateTicker(market, update.OraclePrice, update.Volume)
+			}
+		}
+		for market, update := range content.OraclePrices {
+			p.updateTicker(market, update.OraclePrice, "")
+		}
+	}
+}
+
+// updateTicker updates the price and volume of the market's ticker, either of
+// which may be omitted by an update. Updates with unparsable numbers are
+// dropped.
+func (p *DydxProvider) updateTicker(market, price, volume string) {
+	symbol := p.ProviderPairToCurrencyPair(market).String()
+	if _, ok := p.pairs[symbol]; !ok {
+		return
+	}
+
+	var (
+		usdVolume, parsedPrice sdk.Dec
+		err                    error
+	)
+	if volume != "" {
+		usdVolume, err = decFromString(volume)
+		if err != nil {
+			p.logger.Error().Err(err).Str("market", market).Msg("failed to parse volume")
+			return
+		}
+	}
+	if price != "" {
+		parsedPrice, err = decFromString(price)
+		if err != nil {
+			p.logger.Error().Err(err).Str("market", market).Msg("failed to parse price")
+			return
+		}
+	}
+
+	if !usdVolume.IsNil() {
+		p.volumes[symbol] = usdVolume
+	}
+
+	ticker, ok := p.tickers[symbol]
+	if !parsedPrice.IsNil() {
+		ticker.Price = parsedPrice
+		ticker.Time = time.Now()
+	} else if !ok {
+		// the volume can't be converted without a price
+		return
+	}
+
+	ticker.Volume = sdk.ZeroDec()
+	if usdVolume, ok := p.volumes[symbol]; ok && ticker.Price.IsPositive() {
+		ticker.Volume = usdVolume.Quo(ticker.Price)
+	}
+	p.tickers[symbol] = ticker
+}
+
+func (p *DydxProvider) CurrencyPairToProviderPair(pair types.CurrencyPair) string {
+	return pair.Join("-")
+}
+
+func (p *DydxProvider) ProviderPairToCurrencyPair(market string) types.CurrencyPair {
+	tokens := strings.Split(market, "-")
+	if len(tokens) != 2 {
+		return types.CurrencyPair{}
+	}
+	return types.CurrencyPair{
+		Base:  tokens[0],
+		Quote: tokens[1],
+	}
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"testing"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDydxProvider_MessageReceived(t *testing.T) {
+	btcUsd := types.CurrencyPair{Base: "BTC", Quote: "USD"}
+
+	p := &DydxProvider{
+		volumes: map[string]sdk.Dec{},
+	}
+	p.logger = zerolog.Nop()
+	p.pairs = map[string]types.CurrencyPair{btcUsd.String(): btcUsd}
+	p.tickers = map[string]types.TickerPrice{}
+
+	// ignores connected messages
+	p.messageReceived(1, []byte(`{"type":"connected","connection_id":"1","message_id":0}`))
+	require.Empty(t, p.tickers)
+
+	p.messageReceived(1, []byte(`{"type":"subscribed","channel":"v4_markets","contents":{"markets":{
+		"BTC-USD":{"ticker":"BTC-USD","oraclePrice":"60000","volume24H":"600000000"},
+		"ETH-USD":{"ticker":"ETH-USD","oraclePrice":"3000","volume24H":"300000000"}
+	}}}`))
+	require.Len(t, p.tickers, 1)
+	require.Equal(t, sdk.MustNewDecFromStr("60000"), p.tickers["BTCUSD"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("10000"), p.tickers["BTCUSD"].Volume)
+
+	p.messageReceived(1, []byte(`{"type":"channel_batch_data","channel":"v4_markets","contents":[
+		{"oraclePrices":{"BTC-USD":{"oraclePrice":"50000","effectiveAt":"2024-05-01T00:00:00.000Z"}}},
+		{"trading":{"BTC-USD":{"volume24H":"1000000000"}}}
+	]}`))
+	require.Equal(t, sdk.MustNewDecFromStr("50000"), p.tickers["BTCUSD"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("20000"), p.tickers["BTCUSD"].Volume)
+
+	// drops updates with unparsable numbers
+	p.messageReceived(1, []byte(`{"type":"channel_data","channel":"v4_markets","contents":{
+		"trading":{"BTC-USD":{"oraclePrice":"NaN","volume24H":"2000000000"}}
+	}}`))
+	require.Equal(t, sdk.MustNewDecFromStr("50000"), p.tickers["BTCUSD"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("20000"), p.tickers["BTCUSD"].Volume)
+}
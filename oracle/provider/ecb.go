@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"encoding/xml"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+)
+
+var (
+	_                   Provider = (*EcbProvider)(nil)
+	ecbDefaultEndpoints          = Endpoint{
+		Name:         ProviderEcb,
+		Urls:         []string{"https://www.ecb.europa.eu"},
+		PollInterval: 30 * time.Second,
+	}
+)
+
+type (
+	// EcbProvider defines an oracle provider reporting the euro foreign
+	// exchange reference rates of the European Central Bank, ex.: "EURUSD" or
+	// "JPYUSD". The rates are published once every working day around 16:00
+	// CET and are all quoted in EUR, so other pairs are crossed via EUR.
+	// Like the FxProvider, it allows tickers quoted in fiat currencies to be
+	// converted to USD.
+	//
+	// REF: https://www.ecb.europa.eu/stats/policy_and_exchange_rates/euro_reference_exchange_rates/html/index.en.html
+	EcbProvider struct {
+		provider
+	}
+
+	// EcbEnvelope defines the daily reference rates, ex.:
+	// <Cube><Cube time="2023-11-14"><Cube currency="USD" rate="1.0723"/></Cube></Cube>
+	EcbEnvelope struct {
+		Cube struct {
+			Cube []struct {
+				Time  string    `xml:"time,attr"`
+				Rates []EcbRate `xml:"Cube"`
+			} `xml:"Cube"`
+		} `xml:"Cube"`
+	}
+
+	EcbRate struct {
+		Currency string `xml:"currency,attr"` // ex.: "USD"
+		Rate     string `xml:"rate,attr"`     // ex.: "1.0723"
+	}
+)
+
+func NewEcbProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*EcbProvider, error) {
+	provider := &EcbProvider{}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		nil,
+		nil,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
+
+func (p *EcbProvider) Poll() error {
+	content, err := p.httpGet("/stats/eurofxref/eurofxref-daily.xml")
+	if err != nil {
+		return err
+	}
+
+	var envelope EcbEnvelope
+	err = xml.Unmarshal(content, &envelope)
+	if err != nil {
+		return err
+	}
+
+	// the rates define the amount of the currency per EUR
+	rates := map[string]sdk.Dec{
+		"EUR": sdk.OneDec(),
+	}
+	for _, day := range envelope.Cube.Cube {
+		for _, rate := range day.Rates {
+			value, err := sdk.NewDecFromStr(rate.Rate)
+			if err != nil || !value.IsPositive() {
+				continue
+			}
+			rates[rate.Currency] = value
+		}
+	}
+
+	timestamp := time.Now()
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	for symbol, pair := range p.pairs {
+		base, ok := rates[pair.Base]
+		if !ok {
+			continue
+		}
+		quote, ok := rates[pair.Quote]
+		if !ok {
+			continue
+		}
+		p.tickers[symbol] = types.TickerPrice{
+			Price:  quote.Quo(base),
+			Volume: sdk.OneDec(),
+			Time:   timestamp,
+		}
+	}
+
+	p.logger.Debug().Msg("updated rates")
+	return nil
+}
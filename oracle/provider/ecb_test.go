@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEcbProvider_Poll(t *testing.T) {
+	server := newTestServer(func(rw http.ResponseWriter, req *http.Request) {
+		_, _ = rw.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<gesmes:Envelope xmlns:gesmes="http://www.gesmes.org/xml/2002-08-01" xmlns="http://www.ecb.int/vocabulary/2002-08-01/eurofxref">
+	<gesmes:subject>Reference rates</gesmes:subject>
+	<Cube>
+		<Cube time="2023-11-14">
+			<Cube currency="USD" rate="1.25"/>
+			<Cube currency="JPY" rate="160.00"/>
+		</Cube>
+	</Cube>
+</gesmes:Envelope>`))
+	})
+	defer server.Close()
+
+	eurUsd := types.CurrencyPair{Base: "EUR", Quote: "USD"}
+	jpyUsd := types.CurrencyPair{Base: "JPY", Quote: "USD"}
+	krwUsd := types.CurrencyPair{Base: "KRW", Quote: "USD"}
+
+	p := newTestProvider(t, NewEcbProvider, server, Endpoint{
+		Name: ProviderEcb,
+	}, eurUsd, jpyUsd, krwUsd)
+
+	require.NoError(t, p.Poll())
+	require.Equal(t, sdk.MustNewDecFromStr("1.25"), p.tickers["EURUSD"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("0.0078125"), p.tickers["JPYUSD"].Price)
+	require.NotContains(t, p.tickers, "KRWUSD")
+
+	requests := server.Requests()
+	require.Len(t, requests, 1)
+	require.Equal(t, "/stats/eurofxref/eurofxref-daily.xml", requests[0].URL.Path)
+}
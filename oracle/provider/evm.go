@@ -0,0 +1,273 @@
+package provider
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"golang.org/x/crypto/sha3"
+)
+
+const (
+	// evmWordSize defines the size of the words of the EVM ABI encoding.
+	evmWordSize = 32
+)
+
+type (
+	EvmRpcRequest struct {
+		JsonRpc string        `json:"jsonrpc"` // ex.: "2.0"
+		Id      int64         `json:"id"`
+		Method  string        `json:"method"` // ex.: "eth_call"
+		Params  []interface{} `json:"params"`
+	}
+
+	EvmCallParams struct {
+		To   string `json:"to"`   // ex.: "0x88e6a0c2ddd26feeb64f039a2c41296fcb3f5640"
+		Data string `json:"data"` // ex.: "0x3850c7bd"
+	}
+
+	EvmRpcResponse struct {
+		Result string       `json:"result"` // ex.: "0x000000000000000000000000000000000000000000000000000000000000000c"
+		Error  *EvmRpcError `json:"error"`
+	}
+
+	EvmRpcError struct {
+		Code    int64  `json:"code"`
+		Message string `json:"message"`
+	}
+
+	// EvmCall defines a contract call of the EvmCallProvider, which is
+	// executed using the JSON-RPC endpoint of its chain.
+	EvmCall struct {
+		Base     string // ex.: "WSTETH"
+		Quote    string // ex.: "STETH"
+		Url      string // ex.: "https://ethereum-rpc.publicnode.com"
+		Contract string // ex.: "0x7f39c581f595b53c5cb19bd0b3f8da6c935e2ca0"
+		Method   string // ex.: "stEthPerToken()"
+		Output   int    // index of the returned word
+		Decimals int64  // decimals the returned value is scaled by
+	}
+
+	// evmDecimalsCache caches the decimals of ERC20 tokens by their endpoint
+	// and address.
+	evmDecimalsCache struct {
+		mtx      sync.Mutex
+		decimals map[string]int64
+	}
+)
+
+func (e *EvmRpcError) Error() string {
+	return fmt.Sprintf("eth_call failed: %d: %s", e.Code, e.Message)
+}
+
+// evmCall executes a read only call of a contract using the eth_call method of
+// the JSON-RPC endpoint and returns the ABI encoded result.
+func (p *provider) evmCall(to string, data []byte) ([]byte, error) {
+	return p.evmCallUrl(p.httpBase, to, data)
+}
+
+// evmCallUrl executes a read only call of a contract like evmCall using the
+// JSON-RPC endpoint of the url.
+func (p *provider) evmCallUrl(url string, to string, data []byte) ([]byte, error) {
+	body, err := json.Marshal(EvmRpcRequest{
+		JsonRpc: "2.0",
+		Id:      1,
+		Method:  "eth_call",
+		Params: []interface{}{
+			EvmCallParams{
+				To:   to,
+				Data: "0x" + hex.EncodeToString(data),
+			},
+			"latest",
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := p.makeHttpPost(url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	var response EvmRpcResponse
+	err = json.Unmarshal(content, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.Error != nil {
+		return nil, response.Error
+	}
+
+	return hex.DecodeString(strings.TrimPrefix(response.Result, "0x"))
+}
+
+func newEvmDecimalsCache() *evmDecimalsCache {
+	return &evmDecimalsCache{
+		decimals: map[string]int64{},
+	}
+}
+
+// get returns the decimals configured for the denom, or queries and caches
+// the decimals of the token otherwise.
+func (c *evmDecimalsCache) get(p *provider, denom, token string) (int64, error) {
+	return c.getUrl(p, p.httpBase, denom, token)
+}
+
+// getUrl returns the decimals like get, querying the token using the
+// JSON-RPC endpoint of the url.
+func (c *evmDecimalsCache) getUrl(p *provider, url, denom, token string) (int64, error) {
+	if decimals, ok := p.endpoints.Decimals[denom]; ok {
+		return decimals, nil
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	key := url + "/" + token
+	if decimals, ok := c.decimals[key]; ok {
+		return decimals, nil
+	}
+
+	result, err := p.evmCallUrl(url, token, evmEncodeCall("decimals()"))
+	if err != nil {
+		return 0, err
+	}
+	decimals, err := evmDecodeUint(result, 0)
+	if err != nil {
+		return 0, err
+	}
+
+	c.decimals[key] = decimals.Int64()
+	return decimals.Int64(), nil
+}
+
+// evmBalanceOf returns the ERC20 token balance of the account.
+func (p *provider) evmBalanceOf(token, account string) (*big.Int, error) {
+	accountWord, err := evmEncodeAddress(account)
+	if err != nil {
+		return nil, err
+	}
+	result, err := p.evmCall(token, evmEncodeCall("balanceOf(address)", accountWord))
+	if err != nil {
+		return nil, err
+	}
+	return evmDecodeUint(result, 0)
+}
+
+// evmEncodeCall returns the call data of a function, ex.:
+// "balanceOf(address)", with already encoded arguments.
+func evmEncodeCall(signature string, args ...[]byte) []byte {
+	hash := sha3.NewLegacyKeccak256()
+	hash.Write([]byte(signature))
+	data := hash.Sum(nil)[:4]
+	for _, arg := range args {
+		data = append(data, arg...)
+	}
+	return data
+}
+
+// evmEncodeAddress returns the address as ABI encoded word.
+func evmEncodeAddress(address string) ([]byte, error) {
+	bz, err := hex.DecodeString(strings.TrimPrefix(address, "0x"))
+	if err != nil {
+		return nil, err
+	}
+	if len(bz) != 20 {
+		return nil, fmt.Errorf("invalid address: %s", address)
+	}
+	return append(make([]byte, evmWordSize-len(bz)), bz...), nil
+}
+
+// evmEncodeInt returns the signed integer as ABI encoded word.
+func evmEncodeInt(value *big.Int) []byte {
+	word := make([]byte, evmWordSize)
+	if value.Sign() < 0 {
+		// two's complement
+		value = new(big.Int).Add(value, new(big.Int).Lsh(big.NewInt(1), evmWordSize*8))
+	}
+	return value.FillBytes(word)
+}
+
+// evmEncodeBool returns the boolean as ABI encoded word.
+func evmEncodeBool(value bool) []byte {
+	if value {
+		return evmEncodeInt(big.NewInt(1))
+	}
+	return evmEncodeInt(big.NewInt(0))
+}
+
+// evmWord returns the word at the index of the ABI encoded result.
+func evmWord(result []byte, index int) ([]byte, error) {
+	start := index * evmWordSize
+	if len(result) < start+evmWordSize {
+		return nil, fmt.Errorf("result too short: %d bytes", len(result))
+	}
+	return result[start : start+evmWordSize], nil
+}
+
+// evmDecodeArray returns the index of the first element and the length of the
+// dynamic array referenced at the index of the ABI encoded result.
+func evmDecodeArray(result []byte, index int) (int, int, error) {
+	offset, err := evmDecodeUint(result, index)
+	if err != nil {
+		return 0, 0, err
+	}
+	start := int(offset.Int64() / evmWordSize)
+	length, err := evmDecodeUint(result, start)
+	if err != nil {
+		return 0, 0, err
+	}
+	if len(result) < (start+1+int(length.Int64()))*evmWordSize {
+		return 0, 0, fmt.Errorf("result too short: %d bytes", len(result))
+	}
+	return start + 1, int(length.Int64()), nil
+}
+
+// evmDecodeUint returns the unsigned integer at the index of the ABI encoded
+// result.
+func evmDecodeUint(result []byte, index int) (*big.Int, error) {
+	word, err := evmWord(result, index)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(word), nil
+}
+
+// evmDecodeInt returns the signed integer at the index of the ABI encoded
+// result.
+func evmDecodeInt(result []byte, index int) (*big.Int, error) {
+	value, err := evmDecodeUint(result, index)
+	if err != nil {
+		return nil, err
+	}
+	if value.Bit(evmWordSize*8-1) == 1 {
+		value.Sub(value, new(big.Int).Lsh(big.NewInt(1), evmWordSize*8))
+	}
+	return value, nil
+}
+
+// evmDecodeAddress returns the address at the index of the ABI encoded
+// result.
+func evmDecodeAddress(result []byte, index int) (string, error) {
+	word, err := evmWord(result, index)
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(word[evmWordSize-20:]), nil
+}
+
+// bigIntToDec returns the integer scaled down by the decimals, ex.:
+// 1500000 with 6 decimals -> 1.5. Precision beyond 18 decimals is truncated.
+func bigIntToDec(value *big.Int, decimals int64) sdk.Dec {
+	if decimals <= sdk.Precision {
+		return sdk.NewDecFromBigIntWithPrec(value, decimals)
+	}
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(decimals-sdk.Precision), nil)
+	return sdk.NewDecFromBigIntWithPrec(new(big.Int).Quo(value, scale), sdk.Precision)
+}
@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvmEncodeCall(t *testing.T) {
+	require.Equal(t, "313ce567", hex.EncodeToString(evmEncodeCall("decimals()")))
+
+	address, err := evmEncodeAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48")
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		"70a08231000000000000000000000000a0b86991c6218b36c1d19d4a2e9eb0ce3606eb48",
+		hex.EncodeToString(evmEncodeCall("balanceOf(address)", address)),
+	)
+
+	_, err = evmEncodeAddress("0x1234")
+	require.Error(t, err)
+}
+
+func TestEvmDecodeInt(t *testing.T) {
+	result := append(evmEncodeInt(big.NewInt(-600)), evmEncodeInt(big.NewInt(42))...)
+
+	value, err := evmDecodeInt(result, 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(-600), value.Int64())
+
+	value, err = evmDecodeUint(result, 1)
+	require.NoError(t, err)
+	require.Equal(t, int64(42), value.Int64())
+
+	_, err = evmDecodeUint(result, 2)
+	require.Error(t, err)
+}
+
+func TestBigIntToDec(t *testing.T) {
+	require.Equal(t, sdk.MustNewDecFromStr("1.5"), bigIntToDec(big.NewInt(1500000), 6))
+
+	value, ok := new(big.Int).SetString("1500000000000000000000", 10)
+	require.True(t, ok)
+	require.Equal(t, sdk.MustNewDecFromStr("0.15"), bigIntToDec(value, 22))
+}
+
+// newEvmTestServer returns a JSON-RPC server answering eth_call requests with
+// the result of the handler, or reverting if the result is nil. Malformed
+// requests are answered with a bad request.
+func newEvmTestServer(handler func(params EvmCallParams) []byte) *testServer {
+	return newTestServer(func(rw http.ResponseWriter, req *http.Request) {
+		params, err := decodeEvmTestCall(req.Body)
+		if err != nil {
+			rw.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		response := `{"jsonrpc":"2.0","id":1,"error":{"code":3,"message":"execution reverted"}}`
+		if result := handler(params); result != nil {
+			response = `{"jsonrpc":"2.0","id":1,"result":"0x` + hex.EncodeToString(result) + `"}`
+		}
+		_, _ = rw.Write([]byte(response))
+	})
+}
+
+// evmTestCalls returns the eth_call parameters received by the evm test server.
+func evmTestCalls(t *testing.T, server *testServer) []EvmCallParams {
+	calls := []EvmCallParams{}
+	for _, req := range server.Requests() {
+		params, err := decodeEvmTestCall(bytes.NewReader(req.body))
+		require.NoError(t, err)
+		calls = append(calls, params)
+	}
+	return calls
+}
+
+// decodeEvmTestData returns the hex encoded function selector and the
+// arguments of the call.
+func decodeEvmTestData(params EvmCallParams) (string, []byte, error) {
+	data, err := hex.DecodeString(strings.TrimPrefix(params.Data, "0x"))
+	if err != nil {
+		return "", nil, err
+	}
+	if len(data) < 4 {
+		return "", nil, fmt.Errorf("invalid call data: %s", params.Data)
+	}
+	return hex.EncodeToString(data[:4]), data[4:], nil
+}
+
+func decodeEvmTestCall(body io.Reader) (EvmCallParams, error) {
+	var request EvmRpcRequest
+	if err := json.NewDecoder(body).Decode(&request); err != nil {
+		return EvmCallParams{}, err
+	}
+	if len(request.Params) == 0 {
+		return EvmCallParams{}, fmt.Errorf("missing params")
+	}
+
+	bz, err := json.Marshal(request.Params[0])
+	if err != nil {
+		return EvmCallParams{}, err
+	}
+	var params EvmCallParams
+	err = json.Unmarshal(bz, &params)
+	return params, err
+}
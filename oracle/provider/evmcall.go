@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+)
+
+var (
+	_                       Provider = (*EvmCallProvider)(nil)
+	evmCallDefaultEndpoints          = Endpoint{
+		Name:         ProviderEvmCall,
+		PollInterval: 30 * time.Second,
+	}
+)
+
+type (
+	// EvmCallProvider defines an oracle provider reading prices from
+	// arbitrary contracts, ex.: custom rate or oracle contracts, without a
+	// provider of their own. Every pair is listed in the `calls` of the
+	// provider endpoints with the JSON-RPC endpoint of its chain, its
+	// contract and a method without arguments, ex.: "latestAnswer()". The
+	// returned word at the index of `output` is decoded as signed integer and
+	// scaled by its `decimals`. Calls without an url use the `urls` of the
+	// provider endpoints. The prices don't have a volume, so their tickers
+	// are reported with a volume of one.
+	EvmCallProvider struct {
+		provider
+	}
+)
+
+func NewEvmCallProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*EvmCallProvider, error) {
+	provider := &EvmCallProvider{}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		nil,
+		nil,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
+
+func (p *EvmCallProvider) Poll() error {
+	for _, call := range p.endpoints.Calls {
+		symbol := call.Base + call.Quote
+		if _, ok := p.pairs[symbol]; !ok {
+			continue
+		}
+
+		price, err := p.getPrice(call)
+		if err != nil {
+			p.logger.Warn().Err(err).Str("pair", symbol).Msg("failed to call contract")
+			continue
+		}
+
+		p.mtx.Lock()
+		p.tickers[symbol] = types.TickerPrice{
+			Price:  price,
+			Volume: sdk.OneDec(),
+			Time:   time.Now(),
+		}
+		p.mtx.Unlock()
+	}
+
+	p.logger.Debug().Msg("updated tickers")
+	return nil
+}
+
+func (p *EvmCallProvider) getPrice(call EvmCall) (sdk.Dec, error) {
+	url := call.Url
+	if url == "" {
+		url = p.httpBase
+	}
+
+	result, err := p.evmCallUrl(url, call.Contract, evmEncodeCall(call.Method))
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	value, err := evmDecodeInt(result, call.Output)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	if value.Sign() <= 0 {
+		return sdk.Dec{}, fmt.Errorf("invalid value returned by %s: %s", call.Contract, value)
+	}
+
+	return bigIntToDec(value, call.Decimals), nil
+}
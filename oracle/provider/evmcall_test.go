@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvmCallProvider_Poll(t *testing.T) {
+	const (
+		wsteth     = "0x7f39c581f595b53c5cb19bd0b3f8da6c935e2ca0"
+		aggregator = "0x5f4ec3df9cbd43714fe2740f5e3616155c5b8419"
+	)
+
+	server := newEvmTestServer(func(params EvmCallParams) []byte {
+		switch {
+		case params.To == wsteth && params.Data == "0x"+hex.EncodeToString(evmEncodeCall("stEthPerToken()")):
+			value, _ := new(big.Int).SetString("1180000000000000000", 10)
+			return evmEncodeInt(value)
+		case params.To == aggregator && params.Data == "0x"+hex.EncodeToString(evmEncodeCall("latestRoundData()")):
+			return append(append(evmEncodeInt(big.NewInt(1)), evmEncodeInt(big.NewInt(300012345678))...), evmEncodeInt(big.NewInt(1700000000))...)
+		case params.To == aggregator:
+			// negative answers are rejected
+			return evmEncodeInt(big.NewInt(-1))
+		}
+		return nil
+	})
+	defer server.Close()
+
+	p := newTestProvider(t, NewEvmCallProvider, server, Endpoint{
+		Name: ProviderEvmCall,
+		Calls: []EvmCall{
+			{Base: "WSTETH", Quote: "STETH", Url: server.URL, Contract: wsteth, Method: "stEthPerToken()", Decimals: 18},
+			{Base: "ETH", Quote: "USD", Contract: aggregator, Method: "latestRoundData()", Output: 1, Decimals: 8},
+			{Base: "ETH", Quote: "USDC", Contract: aggregator, Method: "latestAnswer()", Decimals: 8},
+		},
+	},
+		types.CurrencyPair{Base: "WSTETH", Quote: "STETH"},
+		types.CurrencyPair{Base: "ETH", Quote: "USD"},
+		types.CurrencyPair{Base: "ETH", Quote: "USDC"},
+	)
+
+	require.NoError(t, p.Poll())
+	require.Len(t, p.tickers, 2)
+	require.Equal(t, sdk.MustNewDecFromStr("1.18"), p.tickers["WSTETHSTETH"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("3000.12345678"), p.tickers["ETHUSD"].Price)
+}
@@ -3,11 +3,13 @@ package provider
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"strings"
 	"time"
 
 	"price-feeder/oracle/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/rs/zerolog"
 )
 
@@ -34,12 +36,18 @@ type (
 	}
 
 	FinTicker struct {
+		TickerId    string `json:"ticker_id"`       // ex.: "LUNA_axlUSDC"
 		Price       string `json:"last_price"`      // ex.: "2.0690000418"
 		BaseVolume  string `json:"base_volume"`     // ex.: "4875.4890980000"
 		QuoteVolume string `json:"target_volume"`   // ex.: "4875.4890980000"
 		Base        string `json:"base_currency"`   // ex.: "LUNA"
 		Quote       string `json:"target_currency"` // ex.: "axlUSDC"
 	}
+
+	FinOrderbookResponse struct {
+		Bids [][2]string `json:"bids"` // ex.: [["2.0690000000","120.500000"]]
+		Asks [][2]string `json:"asks"` // ex.: [["2.0710000000","80.250000"]]
+	}
 )
 
 func NewFinProvider(
@@ -57,7 +65,7 @@ func NewFinProvider(
 		nil,
 		nil,
 	)
-	go startPolling(provider, provider.endpoints.PollInterval, logger)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
 	return provider, nil
 }
 
@@ -73,10 +81,8 @@ func (p *FinProvider) Poll() error {
 		return err
 	}
 
-	p.mtx.Lock()
-	defer p.mtx.Unlock()
-
 	timestamp := time.Now()
+	tickers := make(map[string]types.TickerPrice, len(p.pairs))
 
 	for _, ticker := range tickersResponse.Tickers {
 		base := finTranslateProviderSymbol(ticker.Base)
@@ -102,16 +108,59 @@ func (p *FinProvider) Poll() error {
 			price = strToDec("1").Quo(price)
 		}
 
-		p.tickers[symbol] = types.TickerPrice{
+		volumeDec := strToDec(volume)
+		if !p.endpoints.DepthBand.IsNil() {
+			baseDepth, quoteDepth, err := p.getDepth(ticker.TickerId)
+			if err != nil {
+				p.logger.Warn().
+					Err(err).
+					Str("ticker", ticker.TickerId).
+					Msg("failed to get orderbook depth, using 24h volume")
+			} else if reciprocal {
+				volumeDec = quoteDepth
+			} else {
+				volumeDec = baseDepth
+			}
+		}
+
+		tickers[symbol] = types.TickerPrice{
 			Price:  price,
-			Volume: strToDec(volume),
+			Volume: volumeDec,
 			Time:   timestamp,
 		}
 	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	for symbol, ticker := range tickers {
+		p.tickers[symbol] = ticker
+	}
 	p.logger.Debug().Msg("updated tickers")
 	return nil
 }
 
+// getDepth returns the base and quote liquidity within the configured depth
+// band of the ticker's live orderbook.
+func (p *FinProvider) getDepth(tickerId string) (sdk.Dec, sdk.Dec, error) {
+	content, err := p.httpGet("/api/coingecko/orderbook?depth=0&ticker_id=" + tickerId)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+
+	var orderbook FinOrderbookResponse
+	err = json.Unmarshal(content, &orderbook)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+
+	baseDepth, quoteDepth, ok := orderbookDepth(orderbook.Bids, orderbook.Asks, p.endpoints.DepthBand)
+	if !ok {
+		return sdk.Dec{}, sdk.Dec{}, fmt.Errorf("empty orderbook")
+	}
+
+	return baseDepth, quoteDepth, nil
+}
+
 func finTranslateProviderSymbol(symbol string) string {
 	return strings.ToUpper(strings.Replace(symbol, "axl", "", 1))
 }
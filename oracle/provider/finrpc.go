@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+)
+
+const (
+	// finRPCBookLimit defines the amount of price levels queried per side of
+	// the book.
+	finRPCBookLimit = 100
+)
+
+var (
+	_                      Provider = (*FinRPCProvider)(nil)
+	finRPCDefaultEndpoints          = Endpoint{
+		Name:         ProviderFinRPC,
+		Urls:         []string{"https://rest.cosmos.directory/kujira"},
+		PollInterval: 3 * time.Second,
+	}
+
+	// finRPCDefaultDepthBand defines the band around the mid price the depth
+	// is summed up in, unless configured as `depth_band`.
+	finRPCDefaultDepthBand = sdk.MustNewDecFromStr("0.02")
+)
+
+type (
+	// FinRPCProvider defines an oracle provider querying the books of FIN
+	// orderbook contracts directly using the REST endpoint of a Kujira node,
+	// as opposed to the FinProvider, which uses the FIN API. The price is the
+	// mid price of the book and the volume is the base liquidity resting
+	// within the depth band around it. The `contracts` of the provider
+	// endpoints map the pairs to their FIN contracts, ex.:
+	// {"KUJIUSDC": "kujira14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9sl4e867"}.
+	//
+	// REF: https://docs.kujira.app/developers/smart-contracts/fin
+	FinRPCProvider struct {
+		provider
+	}
+
+	FinBookResponse struct {
+		Base  []FinPoolResponse `json:"base"`  // asks, offering the base denom
+		Quote []FinPoolResponse `json:"quote"` // bids, offering the quote denom
+	}
+
+	FinPoolResponse struct {
+		QuotePrice       string `json:"quote_price"`        // ex.: "0.6015"
+		TotalOfferAmount string `json:"total_offer_amount"` // ex.: "120500000"
+	}
+)
+
+func NewFinRPCProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*FinRPCProvider, error) {
+	provider := &FinRPCProvider{}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		nil,
+		nil,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
+
+func (p *FinRPCProvider) Poll() error {
+	for symbol, pair := range p.pairs {
+		ticker, err := p.getTicker(pair)
+		if err != nil {
+			p.logger.Warn().Err(err).Str("pair", symbol).Msg("failed to query book")
+			continue
+		}
+
+		p.mtx.Lock()
+		p.tickers[symbol] = ticker
+		p.mtx.Unlock()
+	}
+
+	p.logger.Debug().Msg("updated tickers")
+	return nil
+}
+
+func (p *FinRPCProvider) getTicker(pair types.CurrencyPair) (types.TickerPrice, error) {
+	contract, ok := p.endpoints.Contracts[pair.String()]
+	if !ok {
+		return types.TickerPrice{}, fmt.Errorf("no contract configured for %s", pair.String())
+	}
+
+	var book FinBookResponse
+	err := p.wasmQuery(contract, map[string]interface{}{
+		"book": map[string]interface{}{"limit": finRPCBookLimit},
+	}, &book)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	baseDecimals := p.cosmosDecimals(pair.Base)
+	quoteDecimals := p.cosmosDecimals(pair.Quote)
+
+	asks := make([][2]string, 0, len(book.Base))
+	for _, level := range book.Base {
+		amount, err := cosmosParseAmount(level.TotalOfferAmount)
+		if err != nil {
+			return types.TickerPrice{}, err
+		}
+		price := p.cosmosScalePrice(pair, strToDec(level.QuotePrice))
+		asks = append(asks, [2]string{price.String(), bigIntToDec(amount, baseDecimals).String()})
+	}
+
+	// bids offer quote tokens, which are converted to base tokens
+	bids := make([][2]string, 0, len(book.Quote))
+	for _, level := range book.Quote {
+		amount, err := cosmosParseAmount(level.TotalOfferAmount)
+		if err != nil {
+			return types.TickerPrice{}, err
+		}
+		price := p.cosmosScalePrice(pair, strToDec(level.QuotePrice))
+		if !price.IsPositive() {
+			continue
+		}
+		bids = append(bids, [2]string{price.String(), bigIntToDec(amount, quoteDecimals).Quo(price).String()})
+	}
+
+	band := p.endpoints.DepthBand
+	if band.IsNil() {
+		band = finRPCDefaultDepthBand
+	}
+	depth, _, ok := orderbookDepth(bids, asks, band)
+	if !ok {
+		return types.TickerPrice{}, fmt.Errorf("empty book")
+	}
+
+	bid := strToDec(bids[0][0])
+	ask := strToDec(asks[0][0])
+	return types.TickerPrice{
+		Price:  bid.Add(ask).QuoInt64(2),
+		Volume: depth,
+		Time:   time.Now(),
+		Spread: computeSpread(bid, ask),
+	}, nil
+}
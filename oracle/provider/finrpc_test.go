@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFinRPCProvider_Poll(t *testing.T) {
+	server := newWasmTestServer(func(contract string, query map[string]json.RawMessage) interface{} {
+		return FinBookResponse{
+			// asks offering ukuji
+			Base: []FinPoolResponse{
+				{QuotePrice: "0.61", TotalOfferAmount: "100000000"},
+				{QuotePrice: "0.70", TotalOfferAmount: "500000000"},
+			},
+			// bids offering uusdc
+			Quote: []FinPoolResponse{
+				{QuotePrice: "0.59", TotalOfferAmount: "59000000"},
+				{QuotePrice: "0.50", TotalOfferAmount: "500000000"},
+			},
+		}
+	})
+	defer server.Close()
+
+	p := newTestProvider(t, NewFinRPCProvider, server, Endpoint{
+		Name:      ProviderFinRPC,
+		Contracts: map[string]string{"KUJIUSDC": "kujira1fin"},
+	}, types.CurrencyPair{Base: "KUJI", Quote: "USDC"})
+
+	require.NoError(t, p.Poll())
+	for _, query := range wasmTestQueries(t, server) {
+		require.Equal(t, "kujira1fin", query.contract)
+		require.Contains(t, query.query, "book")
+	}
+
+	ticker, ok := p.tickers["KUJIUSDC"]
+	require.True(t, ok)
+	require.Equal(t, sdk.MustNewDecFromStr("0.6"), ticker.Price)
+	// 100 KUJI of the bids and asks are within ±2% of the mid price
+	require.Equal(t, sdk.MustNewDecFromStr("200"), ticker.Volume)
+}
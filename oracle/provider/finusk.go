@@ -62,7 +62,7 @@ func NewFinUskProvider(
 		nil,
 	)
 
-	go startPolling(provider, provider.endpoints.PollInterval, logger)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
 	return provider, nil
 }
 
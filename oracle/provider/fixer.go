@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	"github.com/rs/zerolog"
+)
+
+var (
+	_                     Provider = (*FixerProvider)(nil)
+	fixerDefaultEndpoints          = Endpoint{
+		Name:         ProviderFixer,
+		Urls:         []string{"https://data.fixer.io"},
+		PollInterval: 30 * time.Second,
+	}
+)
+
+type (
+	// FixerProvider defines an oracle provider reporting the exchange rates
+	// of fiat currencies from Fixer, which serves the same response as Open
+	// Exchange Rates. The rates of the free plan are quoted in EUR.
+	//
+	// REF: https://fixer.io/documentation
+	FixerProvider struct {
+		OpenExchangeRatesProvider
+	}
+
+	FixerError struct {
+		Code int64  `json:"code"` // ex.: 101
+		Type string `json:"type"` // ex.: "invalid_access_key"
+	}
+)
+
+func NewFixerProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*FixerProvider, error) {
+	if endpoints.ApiKey == "" {
+		return nil, fmt.Errorf("%s requires an api key", endpoints.Name)
+	}
+
+	provider := &FixerProvider{}
+	provider.path = "/api/latest"
+	provider.keyParam = "access_key"
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		nil,
+		nil,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
+
+func (e *FixerError) Error() string {
+	return fmt.Sprintf("fixer error %d: %s", e.Code, e.Type)
+}
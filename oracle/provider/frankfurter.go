@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	"github.com/rs/zerolog"
+)
+
+var (
+	_                           Provider = (*FrankfurterProvider)(nil)
+	frankfurterDefaultEndpoints          = Endpoint{
+		Name:         ProviderFrankfurter,
+		Urls:         []string{"https://api.frankfurter.dev/v1"},
+		PollInterval: 30 * time.Second,
+	}
+)
+
+type (
+	// FrankfurterProvider defines an oracle provider reporting the exchange
+	// rates of the public Frankfurter API, which doesn't require an api key.
+	// It's the only free fx provider with a default url and serves as a
+	// fallback for the fiat conversion when the other fx providers fail, ex.:
+	// when the quota of their api key is exhausted. The FxProvider works the
+	// same, but has to be pointed to a self hosted or paid frankfurter
+	// compatible API.
+	//
+	// REF: https://frankfurter.dev
+	FrankfurterProvider struct {
+		FxProvider
+	}
+)
+
+func NewFrankfurterProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*FrankfurterProvider, error) {
+	provider := &FrankfurterProvider{}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		nil,
+		nil,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+)
+
+var (
+	_                  Provider = (*FxProvider)(nil)
+	fxDefaultEndpoints          = Endpoint{
+		Name:         ProviderFx,
+		PollInterval: 30 * time.Second,
+	}
+)
+
+type (
+	// FxProvider defines an oracle provider reporting the exchange rates of
+	// fiat currencies, ex.: "KRWUSD". It doesn't provide any prices by itself,
+	// but allows tickers quoted in fiat currencies other than USD to be
+	// converted to USD. It has no default url and requires the `urls` of a
+	// self hosted or paid frankfurter compatible API, while the public API is
+	// covered by the FrankfurterProvider.
+	//
+	// REF: https://frankfurter.dev
+	FxProvider struct {
+		provider
+	}
+
+	// FxRatesResponse defines the rates of the base currency, ex.:
+	// {"amount":1.0,"base":"USD","date":"2023-03-01","rates":{"KRW":1317.88}}
+	FxRatesResponse struct {
+		Base  string             `json:"base"`
+		Rates map[string]float64 `json:"rates"`
+	}
+)
+
+func NewFxProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*FxProvider, error) {
+	if len(endpoints.Urls) == 0 {
+		return nil, fmt.Errorf("%s requires the urls of a frankfurter compatible api", ProviderFx)
+	}
+
+	provider := &FxProvider{}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		nil,
+		nil,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
+
+func (p *FxProvider) Poll() error {
+	// the rates are requested with the quote as the base currency
+	currencies := map[string][]string{}
+	for _, pair := range p.pairs {
+		currencies[pair.Quote] = append(currencies[pair.Quote], pair.Base)
+	}
+
+	timestamp := time.Now()
+
+	for quote, bases := range currencies {
+		path := fmt.Sprintf("/latest?from=%s&to=%s", quote, strings.Join(bases, ","))
+		content, err := p.httpGet(path)
+		if err != nil {
+			return err
+		}
+
+		var ratesResponse FxRatesResponse
+		err = json.Unmarshal(content, &ratesResponse)
+		if err != nil {
+			return err
+		}
+
+		p.mtx.Lock()
+		for base, rate := range ratesResponse.Rates {
+			if rate <= 0 {
+				continue
+			}
+			symbol := base + quote
+			if _, ok := p.pairs[symbol]; !ok {
+				continue
+			}
+			p.tickers[symbol] = types.TickerPrice{
+				Price:  sdk.OneDec().Quo(floatToDec(rate)),
+				Volume: sdk.OneDec(),
+				Time:   timestamp,
+			}
+		}
+		p.mtx.Unlock()
+	}
+
+	p.logger.Debug().Msg("updated rates")
+	return nil
+}
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFxProvider(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// only the public frankfurter api is used by default
+	_, err := NewFxProvider(ctx, zerolog.Nop(), Endpoint{Name: ProviderFx})
+	require.Error(t, err)
+
+	p, err := NewFrankfurterProvider(ctx, zerolog.Nop(), Endpoint{Name: ProviderFrankfurter})
+	require.NoError(t, err)
+	require.Equal(t, []string{"https://api.frankfurter.dev/v1"}, p.endpoints.Urls)
+}
+
+func TestFxProvider_Poll(t *testing.T) {
+	server := newTestServer(func(rw http.ResponseWriter, req *http.Request) {
+		_, _ = rw.Write([]byte(`{"amount":1.0,"base":"USD","date":"2023-03-01","rates":{"KRW":1250}}`))
+	})
+	defer server.Close()
+
+	krwUsd := types.CurrencyPair{Base: "KRW", Quote: "USD"}
+
+	p := newTestProvider(t, NewFxProvider, server, Endpoint{
+		Name: ProviderFx,
+	}, krwUsd)
+
+	require.NoError(t, p.Poll())
+	require.Equal(t, sdk.MustNewDecFromStr("0.0008"), p.tickers["KRWUSD"].Price)
+
+	requests := server.Requests()
+	require.Len(t, requests, 1)
+	require.Equal(t, "/latest", requests[0].URL.Path)
+	require.Equal(t, "USD", requests[0].URL.Query().Get("from"))
+}
+
+func TestFrankfurterProvider_Poll(t *testing.T) {
+	server := newTestServer(func(rw http.ResponseWriter, req *http.Request) {
+		_, _ = rw.Write([]byte(`{"amount":1.0,"base":"USD","date":"2024-03-01","rates":{"JPY":160,"KRW":1250}}`))
+	})
+	defer server.Close()
+
+	p := newTestProvider(t, NewFrankfurterProvider, server, Endpoint{
+		Name: ProviderFrankfurter,
+		Urls: []string{server.URL + "/v1"},
+	},
+		types.CurrencyPair{Base: "JPY", Quote: "USD"},
+		types.CurrencyPair{Base: "KRW", Quote: "USD"},
+	)
+
+	require.NoError(t, p.Poll())
+	require.Equal(t, sdk.MustNewDecFromStr("0.00625"), p.tickers["JPYUSD"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("0.0008"), p.tickers["KRWUSD"].Price)
+
+	requests := server.Requests()
+	require.Len(t, requests, 1)
+	require.Equal(t, "/v1/latest", requests[0].URL.Path)
+	require.Equal(t, "USD", requests[0].URL.Query().Get("from"))
+	require.ElementsMatch(t, []string{"JPY", "KRW"}, strings.Split(requests[0].URL.Query().Get("to"), ","))
+}
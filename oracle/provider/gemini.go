@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+)
+
+var (
+	_                      Provider = (*GeminiProvider)(nil)
+	geminiDefaultEndpoints          = Endpoint{
+		Name:          ProviderGemini,
+		Urls:          []string{"https://api.gemini.com"},
+		Websocket:     "api.gemini.com",
+		WebsocketPath: "/v2/marketdata",
+	}
+)
+
+type (
+	// GeminiProvider defines an oracle provider implemented by the Gemini v2
+	// market data websocket API. The hourly candles are streamed for every
+	// pair, the price is the close of the latest candle and the volume is
+	// summed up over the candles of the last 24h.
+	//
+	// REF: https://docs.gemini.com/websocket-api/#market-data-version-2
+	GeminiProvider struct {
+		provider
+		// candles holds the hourly candles of every symbol by their start time
+		candles map[string]map[int64]GeminiCandle
+	}
+
+	GeminiSubscriptionMsg struct {
+		Type          string               `json:"type"` // ex.: "subscribe"
+		Subscriptions []GeminiSubscription `json:"subscriptions"`
+	}
+
+	GeminiSubscription struct {
+		Name    string   `json:"name"`    // ex.: "candles_1h"
+		Symbols []string `json:"symbols"` // ex.: ["BTCUSD"]
+	}
+
+	// GeminiCandlesMsg defines an update of the candles, the first update
+	// after subscribing contains the recent history, ex.:
+	// {"type":"candles_1h_updates","symbol":"BTCUSD","changes":[[1561054500000,9350.18,9358.35,9350.18,9355.51,2.07]]}
+	GeminiCandlesMsg struct {
+		Type    string         `json:"type"`
+		Symbol  string         `json:"symbol"`
+		Changes []GeminiCandle `json:"changes"`
+	}
+
+	// GeminiCandle defines a candle of the websocket API, ex.:
+	// [1561054500000, 9350.18, 9358.35, 9350.18, 9355.51, 2.07]
+	// [time, open, high, low, close, volume]
+	GeminiCandle [6]float64
+)
+
+func NewGeminiProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*GeminiProvider, error) {
+	provider := &GeminiProvider{
+		candles: make(map[string]map[int64]GeminiCandle, len(pairs)),
+	}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		provider.messageReceived,
+		provider.getSubscriptionMsgs,
+	)
+	return provider, nil
+}
+
+func (p *GeminiProvider) getSubscriptionMsgs(pairs ...types.CurrencyPair) []interface{} {
+	symbols := make([]string, len(pairs))
+	for i, pair := range pairs {
+		symbols[i] = pair.String()
+	}
+	return []interface{}{
+		GeminiSubscriptionMsg{
+			Type: "subscribe",
+			Subscriptions: []GeminiSubscription{{
+				Name:    "candles_1h",
+				Symbols: symbols,
+			}},
+		},
+	}
+}
+
+func (p *GeminiProvider) messageReceived(messageType int, bz []byte) {
+	var candlesMsg GeminiCandlesMsg
+	err := json.Unmarshal(bz, &candlesMsg)
+	if err != nil {
+		p.logger.Error().Err(err).Msg("failed to unmarshal message")
+		return
+	}
+
+	if candlesMsg.Type != "candles_1h_updates" {
+		if strings.Contains(candlesMsg.Type, "error") {
+			p.logger.Error().Str("msg", string(bz)).Msg("received error message")
+		}
+		return
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	if _, ok := p.pairs[candlesMsg.Symbol]; !ok {
+		return
+	}
+
+	candles, ok := p.candles[candlesMsg.Symbol]
+	if !ok {
+		candles = map[int64]GeminiCandle{}
+		p.candles[candlesMsg.Symbol] = candles
+	}
+
+	for _, candle := range candlesMsg.Changes {
+		candles[int64(candle[0])] = candle
+	}
+
+	cutoff := time.Now().Add(-24 * time.Hour).UnixMilli()
+	var latest GeminiCandle
+	volume := sdk.ZeroDec()
+	for start, candle := range candles {
+		if start < cutoff {
+			delete(candles, start)
+			continue
+		}
+		if candle[0] > latest[0] {
+			latest = candle
+		}
+		volume = volume.Add(floatToDec(candle[5]))
+	}
+
+	if latest[0] == 0 {
+		return
+	}
+
+	p.tickers[candlesMsg.Symbol] = types.TickerPrice{
+		Price:  floatToDec(latest[4]),
+		Volume: volume,
+		Time:   time.Now(),
+	}
+}
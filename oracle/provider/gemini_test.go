@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeminiProvider_MessageReceived(t *testing.T) {
+	atomUsd := types.CurrencyPair{Base: "ATOM", Quote: "USD"}
+
+	p := &GeminiProvider{
+		candles: map[string]map[int64]GeminiCandle{},
+	}
+	p.logger = zerolog.Nop()
+	p.pairs = map[string]types.CurrencyPair{atomUsd.String(): atomUsd}
+	p.tickers = map[string]types.TickerPrice{}
+
+	hour := time.Now().Truncate(time.Hour).UnixMilli()
+	old := time.Now().Add(-25 * time.Hour).UnixMilli()
+
+	// the initial update contains the history, older candles are ignored
+	p.messageReceived(1, []byte(fmt.Sprintf(
+		`{"type":"candles_1h_updates","symbol":"ATOMUSD","changes":[[%d,13.5,13.7,13.4,13.6,100],[%d,13.4,13.6,13.3,13.5,50],[%d,10,10,10,10,1000]]}`,
+		hour, hour-3600000, old,
+	)))
+	require.Equal(t, sdk.MustNewDecFromStr("13.6"), p.tickers["ATOMUSD"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("150"), p.tickers["ATOMUSD"].Volume)
+
+	// updates of the latest candle replace it
+	p.messageReceived(1, []byte(fmt.Sprintf(
+		`{"type":"candles_1h_updates","symbol":"ATOMUSD","changes":[[%d,13.5,13.8,13.4,13.8,120]]}`,
+		hour,
+	)))
+	require.Equal(t, sdk.MustNewDecFromStr("13.8"), p.tickers["ATOMUSD"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("170"), p.tickers["ATOMUSD"].Volume)
+}
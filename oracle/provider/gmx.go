@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+)
+
+const (
+	// gmxPricePrecision defines the precision of GMX prices per unit of a
+	// token, which is 30 minus the decimals of the token.
+	gmxPricePrecision = 30
+)
+
+var (
+	_                   Provider = (*GmxProvider)(nil)
+	gmxDefaultEndpoints          = Endpoint{
+		Name:         ProviderGmx,
+		Urls:         []string{"https://arbitrum-api.gmxinfra.io", "https://arbitrum-api.gmxinfra2.io"},
+		PollInterval: 5 * time.Second,
+	}
+)
+
+type (
+	// GmxProvider defines an oracle provider polling the signed prices of the
+	// GMX V2 oracle keepers, which are the values GMX settles its markets with
+	// on-chain. The spread between the min and max price is reported as the
+	// spread. GMX prices don't have a volume, so their tickers are reported with
+	// a volume of one and are best used with the `referenceOnly` role.
+	//
+	// REF: https://docs.gmx.io/docs/api/rest-v2#prices-and-tokens
+	GmxProvider struct {
+		provider
+	}
+
+	GmxTokensResponse struct {
+		Tokens []GmxToken `json:"tokens"`
+	}
+
+	GmxToken struct {
+		Symbol   string `json:"symbol"`   // ex.: "ETH"
+		Address  string `json:"address"`  // ex.: "0x82aF49447D8a07e3bd95BD0d56f35241523fBab1"
+		Decimals int64  `json:"decimals"` // ex.: 18
+	}
+
+	GmxTicker struct {
+		Address   string `json:"tokenAddress"` // ex.: "0x82aF49447D8a07e3bd95BD0d56f35241523fBab1"
+		Symbol    string `json:"tokenSymbol"`  // ex.: "ETH"
+		MinPrice  string `json:"minPrice"`     // ex.: "2345670000000000"
+		MaxPrice  string `json:"maxPrice"`     // ex.: "2345690000000000"
+		Timestamp int64  `json:"timestamp"`    // ex.: 1677666151
+	}
+)
+
+func NewGmxProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*GmxProvider, error) {
+	provider := &GmxProvider{}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		nil,
+		nil,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
+
+func (p *GmxProvider) Poll() error {
+	content, err := p.httpGet("/tokens")
+	if err != nil {
+		return err
+	}
+
+	var tokensResponse GmxTokensResponse
+	err = json.Unmarshal(content, &tokensResponse)
+	if err != nil {
+		return err
+	}
+
+	decimals := map[string]int64{}
+	for _, token := range tokensResponse.Tokens {
+		decimals[strings.ToLower(token.Address)] = token.Decimals
+	}
+
+	content, err = p.httpGet("/prices/tickers")
+	if err != nil {
+		return err
+	}
+
+	var tickers []GmxTicker
+	err = json.Unmarshal(content, &tickers)
+	if err != nil {
+		return err
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	for _, ticker := range tickers {
+		symbol := ticker.Symbol + "USD"
+		if _, ok := p.pairs[symbol]; !ok {
+			continue
+		}
+
+		tokenDecimals, ok := decimals[strings.ToLower(ticker.Address)]
+		if !ok {
+			p.logger.Warn().Str("token", ticker.Address).Msg("unknown token decimals")
+			continue
+		}
+
+		minPrice, err := gmxParsePrice(ticker.MinPrice, tokenDecimals)
+		if err != nil {
+			p.logger.Error().Err(err).Str("pair", symbol).Msg("failed to parse price")
+			continue
+		}
+		maxPrice, err := gmxParsePrice(ticker.MaxPrice, tokenDecimals)
+		if err != nil {
+			p.logger.Error().Err(err).Str("pair", symbol).Msg("failed to parse price")
+			continue
+		}
+
+		p.tickers[symbol] = types.TickerPrice{
+			Price:  minPrice.Add(maxPrice).QuoInt64(2),
+			Volume: sdk.OneDec(),
+			Time:   time.Unix(ticker.Timestamp, 0),
+			Spread: computeSpread(minPrice, maxPrice),
+		}
+	}
+
+	p.logger.Debug().Msg("updated tickers")
+	return nil
+}
+
+// gmxParsePrice returns the USD price of a whole token, ex.:
+// "2345670000000000" with 18 decimals -> 2345.67.
+func gmxParsePrice(price string, decimals int64) (sdk.Dec, error) {
+	value, ok := new(big.Int).SetString(price, 10)
+	if !ok {
+		return sdk.Dec{}, fmt.Errorf("invalid price: %s", price)
+	}
+	return bigIntToDec(value, gmxPricePrecision-decimals), nil
+}
@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGmxProvider_Poll(t *testing.T) {
+	server := newTestServer(func(rw http.ResponseWriter, req *http.Request) {
+		var response string
+		switch req.URL.Path {
+		case "/tokens":
+			response = `{"tokens":[
+				{"symbol":"ETH","address":"0x82aF49447D8a07e3bd95BD0d56f35241523fBab1","decimals":18},
+				{"symbol":"BTC","address":"0x47904963fc8b2340414262125aF798B9655E58Cd","decimals":8}
+			]}`
+		case "/prices/tickers":
+			response = `[
+				{"tokenAddress":"0x82aF49447D8a07e3bd95BD0d56f35241523fBab1","tokenSymbol":"ETH","minPrice":"2345670000000000","maxPrice":"2345690000000000","timestamp":1677666151},
+				{"tokenAddress":"0x47904963fc8b2340414262125aF798B9655E58Cd","tokenSymbol":"BTC","minPrice":"230125000000000000000000000","maxPrice":"230125000000000000000000000","timestamp":1677666151}
+			]`
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = rw.Write([]byte(response))
+	})
+	defer server.Close()
+
+	ethUsd := types.CurrencyPair{Base: "ETH", Quote: "USD"}
+	btcUsd := types.CurrencyPair{Base: "BTC", Quote: "USD"}
+
+	p := newTestProvider(t, NewGmxProvider, server, Endpoint{
+		Name: ProviderGmx,
+	}, ethUsd, btcUsd)
+
+	require.NoError(t, p.Poll())
+	require.Equal(t, sdk.MustNewDecFromStr("2345.68"), p.tickers["ETHUSD"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("23012.5"), p.tickers["BTCUSD"].Price)
+	require.Equal(t, sdk.OneDec(), p.tickers["BTCUSD"].Volume)
+}
@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	"github.com/rs/zerolog"
+)
+
+var (
+	_                       Provider = (*GraphQLProvider)(nil)
+	graphQLDefaultEndpoints          = Endpoint{
+		Name:         ProviderGraphQL,
+		PollInterval: 15 * time.Second,
+	}
+)
+
+type (
+	// GraphQLProvider defines an oracle provider querying arbitrary GraphQL
+	// endpoints, so DEXes backed by an indexer can be added through the
+	// config. Every pair is listed in the `queries` of the provider endpoints
+	// with a query, in which "{base}" and "{quote}" are replaced by the
+	// currencies of the pair, and the JSONPath expressions of the price and
+	// the optional volume and time in the response. Queries without an url
+	// are sent to the `urls` of the provider endpoints.
+	GraphQLProvider struct {
+		provider
+	}
+
+	// GraphQLQuery defines a query of the GraphQLProvider, ex.:
+	// {"query": "{ pair(id: \"{base}-{quote}\") { price } }", "price": "$.data.pair.price"}.
+	GraphQLQuery struct {
+		Base   string // ex.: "ATOM"
+		Quote  string // ex.: "USDC"
+		Url    string // ex.: "https://indexer.example.com/graphql"
+		Query  string // ex.: "{ pair(id: \"{base}-{quote}\") { price volume24h } }"
+		Price  string // ex.: "$.data.pair.price"
+		Volume string // ex.: "$.data.pair.volume24h"
+		Time   string // ex.: "$.data.pair.updatedAt"
+	}
+
+	GraphQLRequest struct {
+		Query string `json:"query"`
+	}
+
+	GraphQLResponse struct {
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+)
+
+func NewGraphQLProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*GraphQLProvider, error) {
+	provider := &GraphQLProvider{}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		nil,
+		nil,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
+
+func (p *GraphQLProvider) Poll() error {
+	for _, query := range p.endpoints.Queries {
+		symbol := query.Base + query.Quote
+		if _, ok := p.pairs[symbol]; !ok {
+			continue
+		}
+
+		ticker, err := p.getTicker(query)
+		if err != nil {
+			p.logger.Warn().Err(err).Str("pair", symbol).Msg("failed to get ticker")
+			continue
+		}
+
+		p.mtx.Lock()
+		p.tickers[symbol] = ticker
+		p.mtx.Unlock()
+	}
+
+	p.logger.Debug().Msg("updated tickers")
+	return nil
+}
+
+func (p *GraphQLProvider) getTicker(query GraphQLQuery) (types.TickerPrice, error) {
+	request, err := json.Marshal(GraphQLRequest{
+		Query: strings.NewReplacer(
+			"{base}", query.Base,
+			"{quote}", query.Quote,
+		).Replace(query.Query),
+	})
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	var content []byte
+	if query.Url == "" || strings.HasPrefix(query.Url, "/") {
+		content, err = p.httpPost(query.Url, request)
+	} else {
+		content, err = p.makeHttpPost(query.Url, request)
+	}
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	var response GraphQLResponse
+	err = json.Unmarshal(content, &response)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+	if len(response.Errors) > 0 {
+		return types.TickerPrice{}, fmt.Errorf(
+			"graphql query failed: %s", response.Errors[0].Message,
+		)
+	}
+
+	return jsonPathTickerPrice(
+		content,
+		query.Price,
+		query.Volume,
+		query.Time,
+	)
+}
@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGraphQLProvider_Poll(t *testing.T) {
+	server := newTestServer(func(rw http.ResponseWriter, req *http.Request) {
+		var request GraphQLRequest
+		if err := json.NewDecoder(req.Body).Decode(&request); err != nil {
+			rw.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		var response string
+		switch request.Query {
+		case `{ pair(id: "ATOM-USDC") { price volume24h } }`:
+			response = `{"data":{"pair":{"price":"9.87","volume24h":"150000.5"}}}`
+		default:
+			response = `{"data":null,"errors":[{"message":"pair not found"}]}`
+		}
+		_, _ = rw.Write([]byte(response))
+	})
+	defer server.Close()
+
+	atomUsdc := types.CurrencyPair{Base: "ATOM", Quote: "USDC"}
+	osmoUsdc := types.CurrencyPair{Base: "OSMO", Quote: "USDC"}
+
+	p := newTestProvider(t, NewGraphQLProvider, server, Endpoint{
+		Name: ProviderGraphQL,
+		Queries: []GraphQLQuery{
+			{
+				Base:   "ATOM",
+				Quote:  "USDC",
+				Query:  `{ pair(id: "{base}-{quote}") { price volume24h } }`,
+				Price:  "$.data.pair.price",
+				Volume: "$.data.pair.volume24h",
+			},
+			{
+				Base:  "OSMO",
+				Quote: "USDC",
+				Url:   server.URL,
+				Query: `{ pair(id: "{base}-{quote}") { price } }`,
+				Price: "$.data.pair.price",
+			},
+		},
+	}, atomUsdc, osmoUsdc)
+
+	require.NoError(t, p.Poll())
+	require.Equal(t, sdk.MustNewDecFromStr("9.87"), p.tickers["ATOMUSDC"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("150000.5"), p.tickers["ATOMUSDC"].Volume)
+	require.NotContains(t, p.tickers, "OSMOUSDC")
+
+	requests := server.Requests()
+	require.Len(t, requests, 2)
+	for _, req := range requests {
+		var request GraphQLRequest
+		require.NoError(t, json.Unmarshal(req.body, &request))
+		require.Contains(t, []string{
+			`{ pair(id: "ATOM-USDC") { price volume24h } }`,
+			`{ pair(id: "OSMO-USDC") { price } }`,
+		}, request.Query)
+	}
+}
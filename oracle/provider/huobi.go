@@ -1,8 +1,12 @@
 package provider
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
 	"encoding/json"
+	"fmt"
+	"io/ioutil"
 	"strings"
 	"time"
 
@@ -11,32 +15,72 @@ import (
 	"github.com/rs/zerolog"
 )
 
+const (
+	// huobiFallbackCutoff defines the age of the websocket tickers after which
+	// they're polled from the REST API instead.
+	huobiFallbackCutoff = 30 * time.Second
+)
+
 var (
 	_                     Provider = (*HuobiProvider)(nil)
 	huobiDefaultEndpoints          = Endpoint{
-		Name:         ProviderHuobi,
-		Urls:         []string{"https://api.huobi.pro", "https://api-aws.huobi.pro"},
-		PollInterval: 2 * time.Second,
+		Name:          ProviderHuobi,
+		Urls:          []string{"https://api.huobi.pro", "https://api-aws.huobi.pro"},
+		PollInterval:  10 * time.Second,
+		Websocket:     "api.huobi.pro",
+		WebsocketPath: "/ws",
 	}
 )
 
 type (
-	// HuobiProvider defines an oracle provider implemented by the crypto.com
-	// public API.
+	// HuobiProvider defines an oracle provider implemented by the HTX (formerly
+	// Huobi) public websocket API. All websocket messages are gzip compressed,
+	// and the server pings are answered in-band. Tickers which aren't updated
+	// via the websocket are polled from the REST API as a fallback.
 	//
-	// REF: https://huobiapi.github.io/docs/spot/v1/en
+	// REF: https://huobiapi.github.io/docs/spot/v1/en/#websocket-market-data
 	HuobiProvider struct {
 		provider
 	}
 
-	HuobiTickersResponse struct {
-		Data []HuobiTicker `json:"data"`
+	HuobiSubscriptionMsg struct {
+		Sub string `json:"sub"` // ex.: "market.btcusdt.ticker"
+		Id  string `json:"id"`  // ex.: "btcusdt"
+	}
+
+	HuobiPongMsg struct {
+		Pong int64 `json:"pong"` // ex.: 1492420473027
+	}
+
+	// HuobiTickerMsg defines a ticker update or subscription ack, ex.:
+	// {"id":"btcusdt","status":"ok","subbed":"market.btcusdt.ticker","ts":1489474081631}
+	HuobiTickerMsg struct {
+		Ping   int64       `json:"ping"` // ex.: 1492420473027
+		Status string      `json:"status"`
+		Error  string      `json:"err-msg"`
+		Ch     string      `json:"ch"` // ex.: "market.btcusdt.ticker"
+		Time   int64       `json:"ts"` // ex.: 1630982370526
+		Tick   HuobiTicker `json:"tick"`
 	}
 
 	HuobiTicker struct {
-		Symbol string  `json:"symbol"` // Symbol ex.: btcusdt
 		Price  float64 `json:"close"`  // Last price ex.: 0.0025
 		Volume float64 `json:"amount"` // Total traded base asset volume ex.: 1000
+		Bid    float64 `json:"bid"`    // Best bid price ex.: 0.0024
+		Ask    float64 `json:"ask"`    // Best ask price ex.: 0.0026
+	}
+
+	HuobiMergedResponse struct {
+		Status string      `json:"status"` // ex.: "ok"
+		Time   int64       `json:"ts"`     // ex.: 1630982370526
+		Tick   HuobiMerged `json:"tick"`
+	}
+
+	HuobiMerged struct {
+		Price  float64    `json:"close"`  // Last price ex.: 0.0025
+		Volume float64    `json:"amount"` // Total traded base asset volume ex.: 1000
+		Bid    [2]float64 `json:"bid"`    // Best bid price and size ex.: [0.0024, 100]
+		Ask    [2]float64 `json:"ask"`    // Best ask price and size ex.: [0.0026, 100]
 	}
 )
 
@@ -52,45 +96,141 @@ func NewHuobiProvider(
 		endpoints,
 		logger,
 		pairs,
-		nil,
-		nil,
+		provider.messageReceived,
+		provider.getSubscriptionMsgs,
 	)
-	go startPolling(provider, provider.endpoints.PollInterval, logger)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
 	return provider, nil
 }
 
-func (p *HuobiProvider) Poll() error {
-	symbols := make(map[string]string, len(p.pairs))
-	for _, pair := range p.pairs {
-		symbols[strings.ToLower(pair.String())] = pair.String()
+func (p *HuobiProvider) getSubscriptionMsgs(pairs ...types.CurrencyPair) []interface{} {
+	msgs := make([]interface{}, len(pairs))
+	for i, pair := range pairs {
+		symbol := p.CurrencyPairToProviderPair(pair)
+		msgs[i] = HuobiSubscriptionMsg{
+			Sub: "market." + symbol + ".ticker",
+			Id:  symbol,
+		}
 	}
+	return msgs
+}
 
-	content, err := p.httpGet("/market/tickers")
+func (p *HuobiProvider) messageReceived(messageType int, bz []byte) {
+	content, err := decompressGzip(bz)
 	if err != nil {
-		return err
+		p.logger.Error().Err(err).Msg("failed to decompress message")
+		return
 	}
 
-	var tickers HuobiTickersResponse
-	err = json.Unmarshal(content, &tickers)
+	var tickerMsg HuobiTickerMsg
+	err = json.Unmarshal(content, &tickerMsg)
 	if err != nil {
-		return err
+		p.logger.Error().Err(err).Msg("failed to unmarshal message")
+		return
 	}
 
+	if tickerMsg.Ping != 0 {
+		err = p.websocket.SendJSON(HuobiPongMsg{Pong: tickerMsg.Ping})
+		if err != nil {
+			p.logger.Error().Err(err).Msg("failed to send pong")
+		}
+		return
+	}
+
+	if tickerMsg.Status == "error" {
+		p.logger.Error().Str("msg", tickerMsg.Error).Msg("received error message")
+		return
+	}
+
+	// subscription acks don't have a channel
+	tokens := strings.Split(tickerMsg.Ch, ".")
+	if len(tokens) != 3 {
+		return
+	}
+	symbol := strings.ToUpper(tokens[1])
+
 	p.mtx.Lock()
 	defer p.mtx.Unlock()
-	now := time.Now()
-	for _, ticker := range tickers.Data {
-		symbol, ok := symbols[ticker.Symbol]
-		if !ok {
-			continue
+
+	if _, ok := p.pairs[symbol]; !ok {
+		return
+	}
+
+	p.tickers[symbol] = types.TickerPrice{
+		Price:  floatToDec(tickerMsg.Tick.Price),
+		Volume: floatToDec(tickerMsg.Tick.Volume),
+		Time:   time.UnixMilli(tickerMsg.Time),
+		Spread: computeSpread(floatToDec(tickerMsg.Tick.Bid), floatToDec(tickerMsg.Tick.Ask)),
+	}
+}
+
+// Poll updates the tickers which weren't updated via the websocket recently
+// using the REST API.
+func (p *HuobiProvider) Poll() error {
+	p.mtx.RLock()
+	stale := []types.CurrencyPair{}
+	for symbol, pair := range p.pairs {
+		ticker, ok := p.tickers[symbol]
+		if !ok || time.Since(ticker.Time) > huobiFallbackCutoff {
+			stale = append(stale, pair)
 		}
+	}
+	p.mtx.RUnlock()
 
-		p.tickers[symbol] = types.TickerPrice{
-			Price:  floatToDec(ticker.Price),
-			Volume: floatToDec(ticker.Volume),
-			Time:   now,
+	for _, pair := range stale {
+		ticker, err := p.getMergedTicker(pair)
+		if err != nil {
+			p.logger.Warn().Err(err).Str("pair", pair.String()).Msg("failed to get ticker")
+			continue
 		}
+
+		p.mtx.Lock()
+		p.tickers[pair.String()] = ticker
+		p.mtx.Unlock()
+	}
+
+	if len(stale) > 0 {
+		p.logger.Debug().Int("tickers", len(stale)).Msg("updated stale tickers")
 	}
-	p.logger.Debug().Msg("updated tickers")
 	return nil
 }
+
+func (p *HuobiProvider) getMergedTicker(pair types.CurrencyPair) (types.TickerPrice, error) {
+	path := "/market/detail/merged?symbol=" + p.CurrencyPairToProviderPair(pair)
+	content, err := p.httpGet(path)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	var merged HuobiMergedResponse
+	err = json.Unmarshal(content, &merged)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	if merged.Status != "ok" {
+		return types.TickerPrice{}, fmt.Errorf("invalid status: %s", merged.Status)
+	}
+
+	return types.TickerPrice{
+		Price:  floatToDec(merged.Tick.Price),
+		Volume: floatToDec(merged.Tick.Volume),
+		Time:   time.UnixMilli(merged.Time),
+		Spread: computeSpread(floatToDec(merged.Tick.Bid[0]), floatToDec(merged.Tick.Ask[0])),
+	}, nil
+}
+
+func (p *HuobiProvider) CurrencyPairToProviderPair(pair types.CurrencyPair) string {
+	return strings.ToLower(pair.String())
+}
+
+// decompressGzip returns the decompressed content of a gzip compressed
+// message.
+func decompressGzip(bz []byte) ([]byte, error) {
+	reader, err := gzip.NewReader(bytes.NewReader(bz))
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	return ioutil.ReadAll(reader)
+}
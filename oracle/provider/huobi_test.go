@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"bytes"
+	"compress/gzip"
+	"testing"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHuobiProvider_MessageReceived(t *testing.T) {
+	atomUsdt := types.CurrencyPair{Base: "ATOM", Quote: "USDT"}
+
+	p := &HuobiProvider{}
+	p.logger = zerolog.Nop()
+	p.pairs = map[string]types.CurrencyPair{atomUsdt.String(): atomUsdt}
+	p.tickers = map[string]types.TickerPrice{}
+
+	compress := func(msg string) []byte {
+		var buf bytes.Buffer
+		writer := gzip.NewWriter(&buf)
+		_, err := writer.Write([]byte(msg))
+		require.NoError(t, err)
+		require.NoError(t, writer.Close())
+		return buf.Bytes()
+	}
+
+	// ignores subscription acks
+	p.messageReceived(2, compress(`{"id":"atomusdt","status":"ok","subbed":"market.atomusdt.ticker","ts":1630982370526}`))
+	require.Empty(t, p.tickers)
+
+	p.messageReceived(2, compress(`{"ch":"market.atomusdt.ticker","ts":1630982370526,"tick":{"close":13.61,"amount":433812.95,"bid":13.6,"ask":13.62}}`))
+	require.Equal(t, sdk.MustNewDecFromStr("13.61"), p.tickers["ATOMUSDT"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("433812.95"), p.tickers["ATOMUSDT"].Volume)
+	require.Equal(t, int64(1630982370526), p.tickers["ATOMUSDT"].Time.UnixMilli())
+}
@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	"github.com/rs/zerolog"
+)
+
+var (
+	_                           Provider = (*HyperliquidProvider)(nil)
+	hyperliquidDefaultEndpoints          = Endpoint{
+		Name:         ProviderHyperliquid,
+		Urls:         []string{"https://api.hyperliquid.xyz"},
+		PollInterval: 3 * time.Second,
+	}
+)
+
+type (
+	// HyperliquidProvider defines an oracle provider implemented by the
+	// Hyperliquid info API. It reports the mid prices and 24h volumes of the
+	// perpetuals, which are settled in USDC, ex.: "BTCUSDC".
+	//
+	// REF: https://hyperliquid.gitbook.io/hyperliquid-docs/for-developers/api/info-endpoint/perpetuals
+	HyperliquidProvider struct {
+		provider
+	}
+
+	HyperliquidInfoRequest struct {
+		Type string `json:"type"` // ex.: "metaAndAssetCtxs"
+	}
+
+	HyperliquidMeta struct {
+		Universe []HyperliquidAsset `json:"universe"`
+	}
+
+	HyperliquidAsset struct {
+		Name string `json:"name"` // ex.: "BTC"
+	}
+
+	HyperliquidAssetCtx struct {
+		MidPrice string `json:"midPx"`      // ex.: "23012.5"
+		Volume   string `json:"dayBaseVlm"` // ex.: "1621.20941"
+	}
+)
+
+func NewHyperliquidProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*HyperliquidProvider, error) {
+	provider := &HyperliquidProvider{}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		nil,
+		nil,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
+
+func (p *HyperliquidProvider) Poll() error {
+	body, err := json.Marshal(HyperliquidInfoRequest{Type: "metaAndAssetCtxs"})
+	if err != nil {
+		return err
+	}
+
+	content, err := p.httpPost("/info", body)
+	if err != nil {
+		return err
+	}
+
+	// the response is a tuple of the metadata and the contexts of the assets
+	var response []json.RawMessage
+	err = json.Unmarshal(content, &response)
+	if err != nil {
+		return err
+	}
+
+	if len(response) != 2 {
+		return fmt.Errorf("invalid response length: %d", len(response))
+	}
+
+	var meta HyperliquidMeta
+	err = json.Unmarshal(response[0], &meta)
+	if err != nil {
+		return err
+	}
+
+	var assetCtxs []HyperliquidAssetCtx
+	err = json.Unmarshal(response[1], &assetCtxs)
+	if err != nil {
+		return err
+	}
+
+	if len(meta.Universe) != len(assetCtxs) {
+		return fmt.Errorf("mismatching assets and contexts")
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	timestamp := time.Now()
+
+	for i, asset := range meta.Universe {
+		symbol := asset.Name + "USDC"
+		if _, ok := p.pairs[symbol]; !ok {
+			continue
+		}
+
+		// assets without a book don't have a mid price
+		assetCtx := assetCtxs[i]
+		if assetCtx.MidPrice == "" {
+			continue
+		}
+
+		p.tickers[symbol] = types.TickerPrice{
+			Price:  strToDec(assetCtx.MidPrice),
+			Volume: strToDec(assetCtx.Volume),
+			Time:   timestamp,
+		}
+	}
+
+	p.logger.Debug().Msg("updated tickers")
+	return nil
+}
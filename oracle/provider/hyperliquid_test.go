@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHyperliquidProvider_Poll(t *testing.T) {
+	server := newTestServer(func(rw http.ResponseWriter, req *http.Request) {
+		_, _ = rw.Write([]byte(`[{"universe":[{"name":"BTC"},{"name":"ATOM"},{"name":"DELISTED"}]},[{"midPx":"23012.5","dayBaseVlm":"1621.2"},{"midPx":"13.61","dayBaseVlm":"433812.95"},{"midPx":null,"dayBaseVlm":"0"}]]`))
+	})
+	defer server.Close()
+
+	atomUsdc := types.CurrencyPair{Base: "ATOM", Quote: "USDC"}
+
+	p := newTestProvider(t, NewHyperliquidProvider, server, Endpoint{
+		Name: ProviderHyperliquid,
+	}, atomUsdc)
+
+	require.NoError(t, p.Poll())
+	require.Len(t, p.tickers, 1)
+	require.Equal(t, sdk.MustNewDecFromStr("13.61"), p.tickers["ATOMUSDC"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("433812.95"), p.tickers["ATOMUSDC"].Volume)
+
+	requests := server.Requests()
+	require.Len(t, requests, 1)
+	require.Equal(t, "/info", requests[0].URL.Path)
+}
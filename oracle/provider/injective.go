@@ -0,0 +1,171 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+)
+
+const (
+	// injectiveBookLimit defines the amount of price levels queried per side
+	// of the book.
+	injectiveBookLimit = 100
+)
+
+var (
+	_                         Provider = (*InjectiveProvider)(nil)
+	injectiveDefaultEndpoints          = Endpoint{
+		Name:         ProviderInjective,
+		Urls:         []string{"https://sentry.lcd.injective.network", "https://rest.cosmos.directory/injective"},
+		PollInterval: 5 * time.Second,
+	}
+
+	// injectiveDefaultDepthBand defines the band around the mid price the
+	// depth is summed up in, unless configured as `depth_band`.
+	injectiveDefaultDepthBand = sdk.MustNewDecFromStr("0.02")
+)
+
+type (
+	// InjectiveProvider defines an oracle provider querying the spot
+	// orderbooks of the exchange module of Injective using the REST gateway
+	// of a node, which serves the same queries as its gRPC endpoint. The
+	// price is the mid price of the book and the volume is the base liquidity
+	// resting within the depth band around it. The `contracts` of the
+	// provider endpoints map the pairs to their market ids, ex.:
+	// {"INJUSDT": "0xa508cb32923323679f29a032c70342c147c17d0145625922b0ef22e955c844c0"}.
+	// The book is denominated in the chain denoms, so the decimals of the
+	// currencies have to be configured unless they're six, ex.: {"INJ": 18}.
+	//
+	// REF: https://api.injective.exchange/#chain-exchange-for-spot-spotorderbook
+	InjectiveProvider struct {
+		provider
+	}
+
+	InjectiveOrderbookResponse struct {
+		Bids []InjectivePriceLevel `json:"buys_price_level"`
+		Asks []InjectivePriceLevel `json:"sells_price_level"`
+	}
+
+	InjectivePriceLevel struct {
+		Price    string `json:"p"` // ex.: "0.000000000024214"
+		Quantity string `json:"q"` // ex.: "1250000000000000000"
+	}
+)
+
+func NewInjectiveProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*InjectiveProvider, error) {
+	provider := &InjectiveProvider{}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		nil,
+		nil,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
+
+func (p *InjectiveProvider) Poll() error {
+	for symbol, pair := range p.pairs {
+		ticker, err := p.getTicker(pair)
+		if err != nil {
+			p.logger.Warn().Err(err).Str("pair", symbol).Msg("failed to get orderbook")
+			continue
+		}
+
+		p.mtx.Lock()
+		p.tickers[symbol] = ticker
+		p.mtx.Unlock()
+	}
+
+	p.logger.Debug().Msg("updated tickers")
+	return nil
+}
+
+func (p *InjectiveProvider) getTicker(pair types.CurrencyPair) (types.TickerPrice, error) {
+	market, ok := p.endpoints.Contracts[pair.String()]
+	if !ok {
+		return types.TickerPrice{}, fmt.Errorf("no market configured for %s", pair.String())
+	}
+
+	content, err := p.httpGet(fmt.Sprintf(
+		"/injective/exchange/v1beta1/spot/orderbook/%s?limit=%d",
+		market,
+		injectiveBookLimit,
+	))
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	var orderbook InjectiveOrderbookResponse
+	err = json.Unmarshal(content, &orderbook)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	// the prices and quantities are denominated in the chain denoms
+	quantityScale := sdk.NewDecFromBigInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(p.cosmosDecimals(pair.Base)), nil))
+	toLevels := func(levels []InjectivePriceLevel) ([][2]string, error) {
+		converted := make([][2]string, len(levels))
+		for i, level := range levels {
+			price, err := decFromString(level.Price)
+			if err != nil {
+				return nil, err
+			}
+			quantity, err := decFromString(level.Quantity)
+			if err != nil {
+				return nil, err
+			}
+			converted[i] = [2]string{
+				p.cosmosScalePrice(pair, price).String(),
+				quantity.Quo(quantityScale).String(),
+			}
+		}
+		return converted, nil
+	}
+	bids, err := toLevels(orderbook.Bids)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+	asks, err := toLevels(orderbook.Asks)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	band := p.endpoints.DepthBand
+	if band.IsNil() {
+		band = injectiveDefaultDepthBand
+	}
+	depth, _, ok := orderbookDepth(bids, asks, band)
+	if !ok {
+		return types.TickerPrice{}, fmt.Errorf("empty orderbook")
+	}
+
+	bid, err := decFromString(bids[0][0])
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+	ask, err := decFromString(asks[0][0])
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+	return types.TickerPrice{
+		Price:  bid.Add(ask).QuoInt64(2),
+		Volume: depth,
+		Time:   time.Now(),
+		Spread: computeSpread(bid, ask),
+	}, nil
+}
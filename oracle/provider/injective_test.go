@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInjectiveProvider_Poll(t *testing.T) {
+	const (
+		market        = "0xa508cb32923323679f29a032c70342c147c17d0145625922b0ef22e955c844c0"
+		invalidMarket = "0x0611780ba69656949525013d947713300f56c37b6175e02f26bffa495c3208fe"
+	)
+
+	server := newTestServer(func(rw http.ResponseWriter, req *http.Request) {
+		if strings.HasSuffix(req.URL.Path, invalidMarket) {
+			_, _ = rw.Write([]byte(`{
+				"buys_price_level":[{"p":"0.000000000019950","q":""}],
+				"sells_price_level":[{"p":"0.000000000020050","q":"20000000000000000000.000000000000000000"}]
+			}`))
+			return
+		}
+		_, _ = rw.Write([]byte(`{
+			"buys_price_level":[
+				{"p":"0.000000000019950","q":"10000000000000000000.000000000000000000"},
+				{"p":"0.000000000015000","q":"90000000000000000000.000000000000000000"}
+			],
+			"sells_price_level":[
+				{"p":"0.000000000020050","q":"20000000000000000000.000000000000000000"},
+				{"p":"0.000000000025000","q":"90000000000000000000.000000000000000000"}
+			]
+		}`))
+	})
+	defer server.Close()
+
+	injUsdt := types.CurrencyPair{Base: "INJ", Quote: "USDT"}
+	injUsdc := types.CurrencyPair{Base: "INJ", Quote: "USDC"}
+
+	p := newTestProvider(t, NewInjectiveProvider, server, Endpoint{
+		Name:      ProviderInjective,
+		Contracts: map[string]string{"INJUSDT": market, "INJUSDC": invalidMarket},
+		Decimals:  map[string]int64{"INJ": 18},
+	}, injUsdt, injUsdc)
+
+	require.NoError(t, p.Poll())
+	paths := []string{}
+	for _, req := range server.Requests() {
+		paths = append(paths, req.URL.Path)
+	}
+	require.ElementsMatch(t, []string{
+		"/injective/exchange/v1beta1/spot/orderbook/" + market,
+		"/injective/exchange/v1beta1/spot/orderbook/" + invalidMarket,
+	}, paths)
+
+	// orderbooks with unparsable quantities are skipped
+	require.NotContains(t, p.tickers, "INJUSDC")
+
+	ticker, ok := p.tickers["INJUSDT"]
+	require.True(t, ok)
+	require.Equal(t, sdk.MustNewDecFromStr("20"), ticker.Price)
+	// 10 INJ of the bids and 20 INJ of the asks are within ±2%
+	require.Equal(t, sdk.MustNewDecFromStr("30"), ticker.Volume)
+}
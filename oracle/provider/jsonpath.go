@@ -0,0 +1,167 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// jsonPathLookup returns the value of the JSONPath expression in the decoded
+// JSON document. Only the child operators are supported, ex.:
+// "$.data[0].last" or "$['BTC-USD'].price".
+func jsonPathLookup(document interface{}, path string) (interface{}, error) {
+	if !strings.HasPrefix(path, "$") {
+		return nil, fmt.Errorf("invalid json path: %s", path)
+	}
+
+	value := document
+	rest := path[1:]
+	for rest != "" {
+		var key string
+		index := -1
+		switch {
+		case rest[0] == '.':
+			end := strings.IndexAny(rest[1:], ".[")
+			if end < 0 {
+				end = len(rest) - 1
+			}
+			key, rest = rest[1:end+1], rest[end+1:]
+		case strings.HasPrefix(rest, "['"):
+			end := strings.Index(rest, "']")
+			if end < 0 {
+				return nil, fmt.Errorf("invalid json path: %s", path)
+			}
+			key, rest = rest[2:end], rest[end+2:]
+		case rest[0] == '[':
+			end := strings.Index(rest, "]")
+			if end < 0 {
+				return nil, fmt.Errorf("invalid json path: %s", path)
+			}
+			i, err := strconv.Atoi(rest[1:end])
+			if err != nil || i < 0 {
+				return nil, fmt.Errorf("invalid json path: %s", path)
+			}
+			index, rest = i, rest[end+1:]
+		default:
+			return nil, fmt.Errorf("invalid json path: %s", path)
+		}
+
+		if index >= 0 {
+			array, ok := value.([]interface{})
+			if !ok || index >= len(array) {
+				return nil, fmt.Errorf("no value at %s", path)
+			}
+			value = array[index]
+			continue
+		}
+
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("no value at %s", path)
+		}
+		value, ok = object[key]
+		if !ok {
+			return nil, fmt.Errorf("no value at %s", path)
+		}
+	}
+	return value, nil
+}
+
+// jsonValueToDec converts a JSON number or numeric string to a decimal,
+// including numbers in scientific notation, truncated to sdk.Precision.
+func jsonValueToDec(value interface{}) (sdk.Dec, error) {
+	switch v := value.(type) {
+	case json.Number:
+		return decFromString(v.String())
+	case string:
+		return decFromString(v)
+	case float64:
+		return decFromFloat(v)
+	default:
+		return sdk.Dec{}, fmt.Errorf("invalid number: %v", value)
+	}
+}
+
+// jsonValueToTime converts a unix timestamp in seconds or milliseconds, or an
+// RFC 3339 string to a time.
+func jsonValueToTime(value interface{}) (time.Time, error) {
+	if str, ok := value.(string); ok {
+		if timestamp, err := time.Parse(time.RFC3339, str); err == nil {
+			return timestamp, nil
+		}
+	}
+
+	dec, err := jsonValueToDec(value)
+	if err != nil {
+		return time.Time{}, err
+	}
+	// timestamps after 2286 in seconds are assumed to be milliseconds
+	if dec.GT(sdk.NewDec(1e10)) {
+		return time.UnixMilli(dec.TruncateInt64()), nil
+	}
+	return time.Unix(dec.TruncateInt64(), 0), nil
+}
+
+// jsonPathTickerPrice decodes the JSON response and returns the ticker at the
+// JSONPath expressions of the price and the optional volume and time. Tickers
+// without a volume are reported with a volume of one.
+func jsonPathTickerPrice(
+	content []byte,
+	pricePath string,
+	volumePath string,
+	timePath string,
+) (types.TickerPrice, error) {
+	var document interface{}
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.UseNumber()
+	err := decoder.Decode(&document)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	value, err := jsonPathLookup(document, pricePath)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+	price, err := jsonValueToDec(value)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	volume := sdk.OneDec()
+	if volumePath != "" {
+		value, err = jsonPathLookup(document, volumePath)
+		if err != nil {
+			return types.TickerPrice{}, err
+		}
+		volume, err = jsonValueToDec(value)
+		if err != nil {
+			return types.TickerPrice{}, err
+		}
+	}
+
+	timestamp := time.Now()
+	if timePath != "" {
+		value, err = jsonPathLookup(document, timePath)
+		if err != nil {
+			return types.TickerPrice{}, err
+		}
+		timestamp, err = jsonValueToTime(value)
+		if err != nil {
+			return types.TickerPrice{}, err
+		}
+	}
+
+	return types.TickerPrice{
+		Price:  price,
+		Volume: volume,
+		Time:   timestamp,
+	}, nil
+}
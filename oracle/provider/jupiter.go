@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+)
+
+const (
+	// jupiterMaxIds defines the amount of mints Jupiter accepts per request.
+	jupiterMaxIds = 50
+)
+
+var (
+	_                       Provider = (*JupiterProvider)(nil)
+	jupiterDefaultEndpoints          = Endpoint{
+		Name:         ProviderJupiter,
+		Urls:         []string{"https://lite-api.jup.ag"},
+		PollInterval: 10 * time.Second,
+	}
+)
+
+type (
+	// JupiterProvider defines an oracle provider polling the Jupiter price
+	// API, which derives the USD prices of Solana tokens from the routes of
+	// its swap aggregator across all Solana DEXes. The `contracts` of the
+	// provider endpoints map the denoms to their mint addresses. An `api_key`
+	// is sent as "x-api-key" header for the paid api.jup.ag endpoints. The
+	// prices don't have a volume, so their tickers are reported with a volume
+	// of one.
+	//
+	// REF: https://dev.jup.ag/docs/price-api/v3
+	JupiterProvider struct {
+		provider
+	}
+
+	// JupiterPriceResponse maps the mints to their prices, ex.:
+	// {"So11111111111111111111111111111111111111112":{"usdPrice":147.47,"blockId":348004023,"decimals":9}}
+	JupiterPriceResponse map[string]JupiterPrice
+
+	JupiterPrice struct {
+		Price   float64 `json:"usdPrice"` // ex.: 147.47
+		BlockId int64   `json:"blockId"`  // ex.: 348004023
+	}
+)
+
+func NewJupiterProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*JupiterProvider, error) {
+	provider := &JupiterProvider{}
+	if endpoints.ApiKey != "" {
+		provider.httpHeaders = http.Header{
+			"X-Api-Key": {endpoints.ApiKey},
+		}
+	}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		nil,
+		nil,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
+
+func (p *JupiterProvider) Poll() error {
+	// all prices are quoted in USD
+	symbols := map[string]string{}
+	for symbol, pair := range p.pairs {
+		if pair.Quote != "USD" {
+			continue
+		}
+		mint, ok := p.endpoints.Contracts[pair.Base]
+		if !ok {
+			p.logger.Warn().Str("pair", symbol).Msg("no contract configured")
+			continue
+		}
+		symbols[mint] = symbol
+	}
+
+	mints := make([]string, 0, len(symbols))
+	for mint := range symbols {
+		mints = append(mints, mint)
+	}
+
+	for i := 0; i < len(mints); i += jupiterMaxIds {
+		end := i + jupiterMaxIds
+		if end > len(mints) {
+			end = len(mints)
+		}
+
+		content, err := p.httpGet("/price/v3?ids=" + strings.Join(mints[i:end], ","))
+		if err != nil {
+			return err
+		}
+
+		var prices JupiterPriceResponse
+		err = json.Unmarshal(content, &prices)
+		if err != nil {
+			return err
+		}
+
+		timestamp := time.Now()
+
+		p.mtx.Lock()
+		for mint, price := range prices {
+			symbol, ok := symbols[mint]
+			if !ok || price.Price <= 0 {
+				continue
+			}
+			dec, err := decFromFloat(price.Price)
+			if err != nil {
+				p.logger.Warn().Err(err).Str("pair", symbol).Msg("failed to parse price")
+				continue
+			}
+			p.tickers[symbol] = types.TickerPrice{
+				Price:  dec,
+				Volume: sdk.OneDec(),
+				Time:   timestamp,
+			}
+		}
+		p.mtx.Unlock()
+	}
+
+	p.logger.Debug().Msg("updated tickers")
+	return nil
+}
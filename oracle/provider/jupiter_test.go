@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJupiterProvider_Poll(t *testing.T) {
+	const (
+		sol  = "So11111111111111111111111111111111111111112"
+		bonk = "DezXAZ8z7PnrnRJjz3wXBoRgixCa6xjnB7YaB1pPB263"
+	)
+
+	server := newTestServer(func(rw http.ResponseWriter, req *http.Request) {
+		_, _ = rw.Write([]byte(`{
+			"` + sol + `":{"usdPrice":147.47,"blockId":348004023,"decimals":9,"priceChange24h":1.29},
+			"` + bonk + `":{"usdPrice":0.000021887343419117866,"blockId":348004023,"decimals":5}
+		}`))
+	})
+	defer server.Close()
+
+	p := newTestProvider(t, NewJupiterProvider, server, Endpoint{
+		Name:      ProviderJupiter,
+		Contracts: map[string]string{"SOL": sol, "BONK": bonk},
+	},
+		types.CurrencyPair{Base: "SOL", Quote: "USD"},
+		types.CurrencyPair{Base: "BONK", Quote: "USD"},
+	)
+
+	require.NoError(t, p.Poll())
+	requests := server.Requests()
+	require.Len(t, requests, 1)
+	require.Equal(t, "/price/v3", requests[0].URL.Path)
+	require.ElementsMatch(t, []string{sol, bonk}, strings.Split(requests[0].URL.Query().Get("ids"), ","))
+	require.Equal(t, sdk.MustNewDecFromStr("147.47"), p.tickers["SOLUSD"].Price)
+	// prices with more than 18 decimals are truncated
+	require.Equal(t, sdk.MustNewDecFromStr("0.000021887343419117"), p.tickers["BONKUSD"].Price)
+}
@@ -0,0 +1,180 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+)
+
+var (
+	_                     Provider = (*KaikoProvider)(nil)
+	kaikoDefaultEndpoints          = Endpoint{
+		Name:         ProviderKaiko,
+		Urls:         []string{"https://us.market-api.kaiko.io"},
+		PollInterval: 30 * time.Second,
+	}
+)
+
+type (
+	// KaikoProvider defines an oracle provider polling the aggregated VWAP
+	// of Kaiko, which requires the `api_key` of the provider endpoints. The
+	// price is the latest one minute VWAP of the pair across all exchanges
+	// and the volume is summed up from the hourly VWAPs of the last day.
+	// Pairs without a direct market are priced using the Kaiko cross rate
+	// instead, which has no volume, so those tickers are reported with a
+	// volume of one.
+	//
+	// REF: https://docs.kaiko.com/rest-api/data-feeds/aggregates/direct-exchange-rate
+	KaikoProvider struct {
+		provider
+	}
+
+	KaikoAggregatesResponse struct {
+		Result  string           `json:"result"`  // ex.: "success"
+		Message string           `json:"message"` // ex.: "Invalid instrument"
+		Data    []KaikoAggregate `json:"data"`
+	}
+
+	KaikoAggregate struct {
+		Timestamp int64   `json:"timestamp"` // ex.: 1700000000000
+		Price     *string `json:"price"`     // ex.: "9.87"
+		Volume    *string `json:"volume"`    // ex.: "1234.5"
+	}
+)
+
+func NewKaikoProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*KaikoProvider, error) {
+	if endpoints.ApiKey == "" {
+		return nil, fmt.Errorf("%s requires an api key", ProviderKaiko)
+	}
+
+	provider := &KaikoProvider{}
+	provider.httpHeaders = http.Header{
+		"X-Api-Key": {endpoints.ApiKey},
+	}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		nil,
+		nil,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
+
+func (p *KaikoProvider) Poll() error {
+	for symbol, pair := range p.pairs {
+		ticker, err := p.getTicker(pair)
+		if err != nil {
+			p.logger.Warn().Err(err).Str("pair", symbol).Msg("failed to get ticker")
+			continue
+		}
+
+		p.mtx.Lock()
+		p.tickers[symbol] = ticker
+		p.mtx.Unlock()
+	}
+
+	p.logger.Debug().Msg("updated tickers")
+	return nil
+}
+
+func (p *KaikoProvider) getTicker(pair types.CurrencyPair) (types.TickerPrice, error) {
+	instrument := strings.ToLower(pair.Base) + "/" + strings.ToLower(pair.Quote)
+
+	prices, err := p.getAggregates("spot_direct_exchange_rate", instrument, "1m", 10)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+	price, timestamp, ok := latestKaikoPrice(prices)
+	if !ok {
+		// fall back to the cross rate for pairs without a direct market
+		prices, err = p.getAggregates("spot_exchange_rate", instrument, "1m", 10)
+		if err != nil {
+			return types.TickerPrice{}, err
+		}
+		price, timestamp, ok = latestKaikoPrice(prices)
+		if !ok {
+			return types.TickerPrice{}, fmt.Errorf("no price found")
+		}
+		return types.TickerPrice{
+			Price:  price,
+			Volume: sdk.OneDec(),
+			Time:   timestamp,
+		}, nil
+	}
+
+	volumes, err := p.getAggregates("spot_direct_exchange_rate", instrument, "1h", 24)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+	volume := sdk.ZeroDec()
+	for _, aggregate := range volumes {
+		if aggregate.Volume == nil {
+			continue
+		}
+		volume = volume.Add(strToDec(*aggregate.Volume))
+	}
+
+	return types.TickerPrice{
+		Price:  price,
+		Volume: volume,
+		Time:   timestamp,
+	}, nil
+}
+
+func (p *KaikoProvider) getAggregates(
+	endpoint string,
+	instrument string,
+	interval string,
+	pageSize int,
+) ([]KaikoAggregate, error) {
+	path := fmt.Sprintf(
+		"/v2/data/trades.v1/%s/%s?interval=%s&page_size=%d&sort=desc",
+		endpoint, instrument, interval, pageSize,
+	)
+	content, err := p.httpGet(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var response KaikoAggregatesResponse
+	err = json.Unmarshal(content, &response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Result != "success" {
+		return nil, fmt.Errorf("kaiko error: %s", response.Message)
+	}
+	return response.Data, nil
+}
+
+// latestKaikoPrice returns the latest price of the aggregates sorted in
+// descending order, intervals without trades don't have a price.
+func latestKaikoPrice(aggregates []KaikoAggregate) (sdk.Dec, time.Time, bool) {
+	for _, aggregate := range aggregates {
+		if aggregate.Price == nil {
+			continue
+		}
+		price := strToDec(*aggregate.Price)
+		if !price.IsPositive() {
+			continue
+		}
+		return price, time.UnixMilli(aggregate.Timestamp), true
+	}
+	return sdk.Dec{}, time.Time{}, false
+}
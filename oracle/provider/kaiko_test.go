@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKaikoProvider_Poll(t *testing.T) {
+	server := newTestServer(func(rw http.ResponseWriter, req *http.Request) {
+		var response string
+		switch req.URL.Path + "?" + req.URL.Query().Get("interval") {
+		case "/v2/data/trades.v1/spot_direct_exchange_rate/atom/usd?1m":
+			response = `{"result":"success","data":[
+				{"timestamp":1700000060000,"price":null,"volume":null},
+				{"timestamp":1700000000000,"price":"9.87","volume":"12.5"}
+			]}`
+		case "/v2/data/trades.v1/spot_direct_exchange_rate/atom/usd?1h":
+			response = `{"result":"success","data":[
+				{"timestamp":1699999200000,"price":"9.86","volume":"1000.5"},
+				{"timestamp":1699995600000,"price":"9.85","volume":"2000"},
+				{"timestamp":1699992000000,"price":null,"volume":null}
+			]}`
+		case "/v2/data/trades.v1/spot_direct_exchange_rate/statom/usd?1m":
+			response = `{"result":"success","data":[]}`
+		case "/v2/data/trades.v1/spot_exchange_rate/statom/usd?1m":
+			response = `{"result":"success","data":[{"timestamp":1700000000000,"price":"11.2"}]}`
+		default:
+			response = `{"result":"error","message":"Invalid instrument"}`
+		}
+		_, _ = rw.Write([]byte(response))
+	})
+	defer server.Close()
+
+	atomUsd := types.CurrencyPair{Base: "ATOM", Quote: "USD"}
+	statomUsd := types.CurrencyPair{Base: "STATOM", Quote: "USD"}
+	osmoUsd := types.CurrencyPair{Base: "OSMO", Quote: "USD"}
+
+	p := newTestProvider(t, NewKaikoProvider, server, Endpoint{
+		Name:   ProviderKaiko,
+		ApiKey: "key",
+	}, atomUsd, statomUsd, osmoUsd)
+
+	require.NoError(t, p.Poll())
+	for _, req := range server.Requests() {
+		require.Equal(t, "key", req.Header.Get("X-Api-Key"))
+	}
+	require.Equal(t, sdk.MustNewDecFromStr("9.87"), p.tickers["ATOMUSD"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("3000.5"), p.tickers["ATOMUSD"].Volume)
+	require.Equal(t, int64(1700000000000), p.tickers["ATOMUSD"].Time.UnixMilli())
+	require.Equal(t, sdk.MustNewDecFromStr("11.2"), p.tickers["STATOMUSD"].Price)
+	require.Equal(t, sdk.OneDec(), p.tickers["STATOMUSD"].Volume)
+	require.NotContains(t, p.tickers, "OSMOUSD")
+}
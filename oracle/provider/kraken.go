@@ -8,6 +8,7 @@ import (
 
 	"price-feeder/oracle/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/rs/zerolog"
 )
 
@@ -27,7 +28,7 @@ type (
 	// REF: https://docs.kraken.com/rest
 	KrakenProvider struct {
 		provider
-		symbols map[string]string
+		symbols *symbolCache
 	}
 
 	KrakenTickerResponse struct {
@@ -37,6 +38,8 @@ type (
 	KrakenTicker struct {
 		Price  [2]string `json:"c"` // ex.: ["0.52900","94.23583387"]
 		Volume [2]string `json:"v"` // ex.: ["6512.53593495","9341.68221855"]
+		Ask    [3]string `json:"a"` // ex.: ["0.52950","1184","1184.000"]
+		Bid    [3]string `json:"b"` // ex.: ["0.52850","2000","2000.000"]
 	}
 
 	KrakenPairsResponse struct {
@@ -64,49 +67,30 @@ func NewKrakenProvider(
 		nil,
 	)
 
-	content, err := provider.httpGet("/0/public/AssetPairs")
-	if err != nil {
-		return nil, err
-	}
-
-	var krakenPairs KrakenPairsResponse
-	err = json.Unmarshal(content, &krakenPairs)
+	symbols, err := newSymbolCache(
+		provider.logger,
+		provider.endpoints.SymbolsTTL,
+		provider.getSymbols,
+	)
 	if err != nil {
 		return nil, err
 	}
+	provider.symbols = symbols
 
-	provider.symbols = map[string]string{}
-	for symbol, pair := range krakenPairs.Result {
-		values := strings.Split(pair.WsName, "/")
-		base := values[0]
-		quote := values[1]
-		switch quote {
-		case "XBT":
-			quote = "BTC"
-		case "ZUSD":
-			quote = "USD"
-		}
-
-		switch base {
-		case "XBT":
-			base = "BTC"
-		case "LUNA":
-			base = "LUNC"
-		case "LUNA2":
-			base = "LUNA"
-		}
-
-		provider.symbols[base+quote] = symbol
-	}
-
-	go startPolling(provider, provider.endpoints.PollInterval, logger)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
 	return provider, nil
 }
 
 func (p *KrakenProvider) Poll() error {
+	krakenSymbols := p.symbols.Symbols()
 	symbols := make(map[string]string, len(p.pairs))
 	for _, pair := range p.pairs {
-		krakenSymbol := p.symbols[pair.String()]
+		krakenSymbol, ok := krakenSymbols[pair.String()]
+		if !ok {
+			p.logger.Warn().Str("pair", pair.String()).Msg("symbol not found")
+			p.symbols.Invalidate()
+			continue
+		}
 		symbols[krakenSymbol] = pair.String()
 	}
 
@@ -132,12 +116,57 @@ func (p *KrakenProvider) Poll() error {
 			continue
 		}
 
+		spread := sdk.Dec{}
+		if ticker.Bid[0] != "" && ticker.Ask[0] != "" {
+			spread = computeSpread(strToDec(ticker.Bid[0]), strToDec(ticker.Ask[0]))
+		}
+
 		p.tickers[symbol] = types.TickerPrice{
 			Price:  strToDec(ticker.Price[0]),
 			Volume: strToDec(ticker.Volume[1]),
 			Time:   timestamp,
+			Spread: spread,
 		}
 	}
 	p.logger.Debug().Msg("updated tickers")
 	return nil
 }
+
+// getSymbols returns the Kraken symbols by their pair, ex.: {"BTCUSD": "XXBTZUSD"}.
+func (p *KrakenProvider) getSymbols() (map[string]string, error) {
+	content, err := p.httpGet("/0/public/AssetPairs")
+	if err != nil {
+		return nil, err
+	}
+
+	var krakenPairs KrakenPairsResponse
+	err = json.Unmarshal(content, &krakenPairs)
+	if err != nil {
+		return nil, err
+	}
+
+	symbols := map[string]string{}
+	for symbol, pair := range krakenPairs.Result {
+		values := strings.Split(pair.WsName, "/")
+		base := values[0]
+		quote := values[1]
+		switch quote {
+		case "XBT":
+			quote = "BTC"
+		case "ZUSD":
+			quote = "USD"
+		}
+
+		switch base {
+		case "XBT":
+			base = "BTC"
+		case "LUNA":
+			base = "LUNC"
+		case "LUNA2":
+			base = "LUNA"
+		}
+
+		symbols[base+quote] = symbol
+	}
+	return symbols, nil
+}
@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+)
+
+var (
+	_                             Provider = (*KrakenFuturesProvider)(nil)
+	krakenFuturesDefaultEndpoints          = Endpoint{
+		Name:         ProviderKrakenFutures,
+		Urls:         []string{"https://futures.kraken.com"},
+		PollInterval: 5 * time.Second,
+	}
+)
+
+type (
+	// KrakenFuturesProvider defines an oracle provider polling the CF
+	// Benchmarks real time indices published by Kraken Futures, ex.:
+	// "in_xbtusd". The daily reference rates ("rr_xbtusd") are only published
+	// once a day and would be considered stale, so the real time indices they
+	// are based on are used instead. Indices don't have a volume, so their
+	// tickers are reported with a volume of one and are best used with the
+	// `referenceOnly` role.
+	//
+	// REF: https://docs.futures.kraken.com/#http-api-trading-v3-api-market-data-get-tickers
+	KrakenFuturesProvider struct {
+		provider
+	}
+
+	KrakenFuturesTickersResponse struct {
+		Result  string                `json:"result"` // ex.: "success"
+		Error   string                `json:"error"`
+		Tickers []KrakenFuturesTicker `json:"tickers"`
+	}
+
+	KrakenFuturesTicker struct {
+		Symbol string  `json:"symbol"`   // ex.: "in_xbtusd"
+		Price  float64 `json:"last"`     // ex.: 23012.5
+		Time   string  `json:"lastTime"` // ex.: "2023-03-01T10:15:32.000Z"
+	}
+)
+
+func NewKrakenFuturesProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*KrakenFuturesProvider, error) {
+	provider := &KrakenFuturesProvider{}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		nil,
+		nil,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
+
+func (p *KrakenFuturesProvider) Poll() error {
+	symbols := make(map[string]string, len(p.pairs))
+	for _, pair := range p.pairs {
+		symbols[p.CurrencyPairToProviderPair(pair)] = pair.String()
+	}
+
+	content, err := p.httpGet("/derivatives/api/v3/tickers")
+	if err != nil {
+		return err
+	}
+
+	var tickers KrakenFuturesTickersResponse
+	err = json.Unmarshal(content, &tickers)
+	if err != nil {
+		return err
+	}
+
+	if tickers.Result != "success" {
+		return fmt.Errorf("invalid result: %s: %s", tickers.Result, tickers.Error)
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	for _, ticker := range tickers.Tickers {
+		symbol, ok := symbols[ticker.Symbol]
+		if !ok {
+			continue
+		}
+
+		timestamp, err := time.Parse(time.RFC3339, ticker.Time)
+		if err != nil {
+			p.logger.Error().Err(err).Msg("failed parsing timestamp")
+			continue
+		}
+
+		p.tickers[symbol] = types.TickerPrice{
+			Price:  floatToDec(ticker.Price),
+			Volume: sdk.OneDec(),
+			Time:   timestamp,
+		}
+	}
+
+	p.logger.Debug().Msg("updated tickers")
+	return nil
+}
+
+// CurrencyPairToProviderPair returns the symbol of the real time index, ex.:
+// "BTCUSD" -> "in_xbtusd".
+func (p *KrakenFuturesProvider) CurrencyPairToProviderPair(pair types.CurrencyPair) string {
+	base := pair.Base
+	if base == "BTC" {
+		base = "XBT"
+	}
+	return "in_" + strings.ToLower(base+pair.Quote)
+}
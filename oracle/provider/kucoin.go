@@ -3,29 +3,83 @@ package provider
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
 	"time"
 
 	"price-feeder/oracle/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gorilla/websocket"
 	"github.com/rs/zerolog"
 )
 
+const (
+	// kucoinMaxSubscriptionSymbols defines the amount of symbols per topic
+	// Kucoin accepts.
+	kucoinMaxSubscriptionSymbols = 100
+)
+
 var (
 	_                      Provider = (*KucoinProvider)(nil)
 	kucoinDefaultEndpoints          = Endpoint{
 		Name:         ProviderKucoin,
 		Urls:         []string{"https://api.kucoin.com"},
-		PollInterval: 2 * time.Second,
+		PollInterval: time.Minute,
+		// the websocket url is resolved using a token on every connect
+		Websocket:    "ws-api-spot.kucoin.com",
+		PingDuration: 18 * time.Second,
+		PingType:     websocket.TextMessage,
+		PingMessage:  `{"type":"ping"}`,
 	}
 )
 
 type (
 	// KucoinProvider defines an oracle provider implemented by the Kucoin
-	// public API.
+	// public websocket API. Connecting requires a token, which is requested
+	// from the REST API on every (re)connect. The 24h volumes are polled from
+	// the REST API since they're not part of the ticker.
 	//
-	// REF: https://docs.kucoin.com/?lang=en_US
+	// REF: https://docs.kucoin.com/#websocket-feed
 	KucoinProvider struct {
 		provider
+		volumes map[string]sdk.Dec
+	}
+
+	KucoinTokenResponse struct {
+		Code string          `json:"code"` // ex.: "200000"
+		Data KucoinTokenData `json:"data"`
+	}
+
+	KucoinTokenData struct {
+		Token           string                 `json:"token"`
+		InstanceServers []KucoinInstanceServer `json:"instanceServers"`
+	}
+
+	KucoinInstanceServer struct {
+		Endpoint string `json:"endpoint"` // ex.: "wss://ws-api-spot.kucoin.com/"
+	}
+
+	KucoinSubscriptionMsg struct {
+		Id             int64  `json:"id"`
+		Type           string `json:"type"`  // ex.: "subscribe"
+		Topic          string `json:"topic"` // ex.: "/market/ticker:BTC-USDT,ETH-USDT"
+		PrivateChannel bool   `json:"privateChannel"`
+		Response       bool   `json:"response"`
+	}
+
+	KucoinTickerMsg struct {
+		Type  string           `json:"type"`  // ex.: "message"
+		Topic string           `json:"topic"` // ex.: "/market/ticker:BTC-USDT"
+		Data  KucoinTickerData `json:"data"`
+	}
+
+	KucoinTickerData struct {
+		Price string `json:"price"`   // ex.: "0.08"
+		Bid   string `json:"bestBid"` // ex.: "0.0799"
+		Ask   string `json:"bestAsk"` // ex.: "0.0801"
+		Time  int64  `json:"time"`    // ex.: 1545904567062
 	}
 
 	KucoinTickersResponse struct {
@@ -50,19 +104,122 @@ func NewKucoinProvider(
 	endpoints Endpoint,
 	pairs ...types.CurrencyPair,
 ) (*KucoinProvider, error) {
-	provider := &KucoinProvider{}
+	provider := &KucoinProvider{
+		volumes: make(map[string]sdk.Dec, len(pairs)),
+	}
+	provider.websocketURLHandler = provider.getWebsocketURL
 	provider.Init(
 		ctx,
 		endpoints,
 		logger,
 		pairs,
-		nil,
-		nil,
+		provider.messageReceived,
+		provider.getSubscriptionMsgs,
 	)
-	go startPolling(provider, provider.endpoints.PollInterval, logger)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
 	return provider, nil
 }
 
+// getWebsocketURL requests a new token for the public channels and returns
+// the websocket url including it.
+func (p *KucoinProvider) getWebsocketURL() (url.URL, error) {
+	content, err := p.httpPost("/api/v1/bullet-public", nil)
+	if err != nil {
+		return url.URL{}, err
+	}
+
+	var tokenResponse KucoinTokenResponse
+	err = json.Unmarshal(content, &tokenResponse)
+	if err != nil {
+		return url.URL{}, err
+	}
+
+	if tokenResponse.Data.Token == "" || len(tokenResponse.Data.InstanceServers) == 0 {
+		return url.URL{}, fmt.Errorf("no websocket token received")
+	}
+
+	websocketURL, err := url.Parse(tokenResponse.Data.InstanceServers[0].Endpoint)
+	if err != nil {
+		return url.URL{}, err
+	}
+
+	query := websocketURL.Query()
+	query.Set("token", tokenResponse.Data.Token)
+	query.Set("connectId", fmt.Sprint(time.Now().UnixNano()))
+	websocketURL.RawQuery = query.Encode()
+
+	return *websocketURL, nil
+}
+
+func (p *KucoinProvider) getSubscriptionMsgs(pairs ...types.CurrencyPair) []interface{} {
+	msgs := []interface{}{}
+	for i := 0; i < len(pairs); i += kucoinMaxSubscriptionSymbols {
+		end := i + kucoinMaxSubscriptionSymbols
+		if end > len(pairs) {
+			end = len(pairs)
+		}
+		symbols := []string{}
+		for _, pair := range pairs[i:end] {
+			symbols = append(symbols, p.CurrencyPairToProviderPair(pair))
+		}
+		msgs = append(msgs, KucoinSubscriptionMsg{
+			Id:       time.Now().UnixNano(),
+			Type:     "subscribe",
+			Topic:    "/market/ticker:" + strings.Join(symbols, ","),
+			Response: true,
+		})
+	}
+	return msgs
+}
+
+func (p *KucoinProvider) messageReceived(messageType int, bz []byte) {
+	var tickerMsg KucoinTickerMsg
+	err := json.Unmarshal(bz, &tickerMsg)
+	if err != nil {
+		p.logger.Error().Err(err).Msg("failed to unmarshal message")
+		return
+	}
+
+	// welcome, ack and pong messages don't contain any data
+	if tickerMsg.Type != "message" {
+		if tickerMsg.Type == "error" {
+			p.logger.Error().Str("msg", string(bz)).Msg("received error message")
+		}
+		return
+	}
+
+	tokens := strings.Split(tickerMsg.Topic, ":")
+	if len(tokens) != 2 {
+		return
+	}
+	symbol := p.ProviderPairToCurrencyPair(tokens[1]).String()
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	if _, ok := p.pairs[symbol]; !ok {
+		return
+	}
+
+	volume, ok := p.volumes[symbol]
+	if !ok {
+		volume = sdk.ZeroDec()
+	}
+
+	spread := sdk.Dec{}
+	if tickerMsg.Data.Bid != "" && tickerMsg.Data.Ask != "" {
+		spread = computeSpread(strToDec(tickerMsg.Data.Bid), strToDec(tickerMsg.Data.Ask))
+	}
+
+	p.tickers[symbol] = types.TickerPrice{
+		Price:  strToDec(tickerMsg.Data.Price),
+		Volume: volume,
+		Time:   time.UnixMilli(tickerMsg.Data.Time),
+		Spread: spread,
+	}
+}
+
+// Poll updates the 24h volumes of the tickers.
 func (p *KucoinProvider) Poll() error {
 	symbols := make(map[string]string, len(p.pairs))
 	for _, pair := range p.pairs {
@@ -82,18 +239,29 @@ func (p *KucoinProvider) Poll() error {
 
 	p.mtx.Lock()
 	defer p.mtx.Unlock()
-	now := time.Now()
 	for _, ticker := range tickers.Data.Ticker {
 		symbol, ok := symbols[ticker.Symbol]
 		if !ok {
 			continue
 		}
-		p.tickers[symbol] = types.TickerPrice{
-			Price:  strToDec(ticker.Price),
-			Volume: strToDec(ticker.Volume),
-			Time:   now,
-		}
+		p.volumes[symbol] = strToDec(ticker.Volume)
 	}
-	p.logger.Debug().Msg("updated tickers")
+	p.logger.Debug().Msg("updated volumes")
 	return nil
 }
+
+func (p *KucoinProvider) CurrencyPairToProviderPair(pair types.CurrencyPair) string {
+	return pair.Join("-")
+}
+
+func (p *KucoinProvider) ProviderPairToCurrencyPair(pair string) types.CurrencyPair {
+	tokens := strings.Split(pair, "-")
+	if len(tokens) != 2 {
+		p.logger.Warn().Str("pair", pair).Msg("failed to convert to currency pair")
+		return types.CurrencyPair{}
+	}
+	return types.CurrencyPair{
+		Base:  tokens[0],
+		Quote: tokens[1],
+	}
+}
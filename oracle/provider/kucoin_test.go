@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestKucoinProvider_GetWebsocketURL(t *testing.T) {
+	var tokens atomic.Int64
+	server := newTestServer(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/api/v1/bullet-public" {
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+		// the instance server is the test server itself, so that the
+		// websocket of the provider fails to connect
+		_, _ = rw.Write([]byte(fmt.Sprintf(`{
+			"code": "200000",
+			"data": {
+				"token": "token%d",
+				"instanceServers": [{"endpoint": "ws://%s/", "pingInterval": 18000}]
+			}
+		}`, tokens.Add(1), req.Host)))
+	})
+	defer server.Close()
+
+	p := newTestProvider(t, NewKucoinProvider, server, Endpoint{Name: ProviderKucoin})
+
+	// every connect requests a new token
+	seen := map[string]bool{}
+	for i := 0; i < 2; i++ {
+		websocketURL, err := p.getWebsocketURL()
+		require.NoError(t, err)
+		require.Equal(t, server.Listener.Addr().String(), websocketURL.Host)
+		token := websocketURL.Query().Get("token")
+		require.NotEmpty(t, token)
+		require.False(t, seen[token])
+		seen[token] = true
+		require.NotEmpty(t, websocketURL.Query().Get("connectId"))
+	}
+
+	for _, req := range server.Requests() {
+		if req.URL.Path == "/api/v1/bullet-public" {
+			require.Equal(t, http.MethodPost, req.Method)
+		}
+	}
+}
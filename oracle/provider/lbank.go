@@ -14,29 +14,43 @@ import (
 var (
 	_                     Provider = (*LbankProvider)(nil)
 	lbankDefaultEndpoints          = Endpoint{
-		Name:         ProviderLbank,
-		Urls:         []string{"https://api.lbkex.com", "https://api.lbank.info", "https://www.lbkex.net"},
-		PollInterval: 3 * time.Second,
+		Name:          ProviderLbank,
+		Urls:          []string{"https://api.lbkex.com", "https://api.lbank.info", "https://www.lbkex.net"},
+		Websocket:     "www.lbkex.net",
+		WebsocketPath: "/ws/V2/",
 	}
 )
 
 type (
 	// LbankProvider defines an oracle provider implemented by the LBank
-	// public API.
+	// public websocket API. The server pings are answered in-band.
 	//
-	// REF: https://www.lbank.com/en-US/docs/index.html
+	// REF: https://www.lbank.com/en-US/docs/index.html#websocket-api
 	LbankProvider struct {
 		provider
 	}
 
-	LbankTickersResponse struct {
-		Data []LbankTicker `json:"data"`
+	LbankSubscriptionMsg struct {
+		Action    string `json:"action"`    // ex.: "subscribe"
+		Subscribe string `json:"subscribe"` // ex.: "tick"
+		Pair      string `json:"pair"`      // ex.: "btc_usdt"
 	}
 
-	LbankTicker struct {
-		Symbol string          `json:"symbol"`    // ex.: "btc_usdt"
-		Time   int64           `json:"timestamp"` // ex.: 1675842153413
-		Ticker LbankTickerData `json:"ticker"`
+	LbankPongMsg struct {
+		Action string `json:"action"` // ex.: "pong"
+		Pong   string `json:"pong"`   // ex.: "0ca8f854-7ba7-4341-9d86-d3327e52804e"
+	}
+
+	// LbankTickerMsg defines a ticker update, ping or error, ex.:
+	// {"action":"ping","ping":"0ca8f854-7ba7-4341-9d86-d3327e52804e"}
+	LbankTickerMsg struct {
+		Action  string          `json:"action"`
+		Ping    string          `json:"ping"`
+		Status  string          `json:"status"`
+		Message string          `json:"message"`
+		Type    string          `json:"type"` // ex.: "tick"
+		Pair    string          `json:"pair"` // ex.: "btc_usdt"
+		Tick    LbankTickerData `json:"tick"`
 	}
 
 	LbankTickerData struct {
@@ -57,45 +71,81 @@ func NewLbankProvider(
 		endpoints,
 		logger,
 		pairs,
-		nil,
-		nil,
+		provider.messageReceived,
+		provider.getSubscriptionMsgs,
 	)
-	go startPolling(provider, provider.endpoints.PollInterval, logger)
 	return provider, nil
 }
 
-func (p *LbankProvider) Poll() error {
-	symbols := make(map[string]string, len(p.pairs))
-	for _, pair := range p.pairs {
-		symbols[strings.ToLower(pair.Join("_"))] = pair.String()
+func (p *LbankProvider) getSubscriptionMsgs(pairs ...types.CurrencyPair) []interface{} {
+	msgs := make([]interface{}, len(pairs))
+	for i, pair := range pairs {
+		msgs[i] = LbankSubscriptionMsg{
+			Action:    "subscribe",
+			Subscribe: "tick",
+			Pair:      p.CurrencyPairToProviderPair(pair),
+		}
 	}
+	return msgs
+}
 
-	content, err := p.httpGet("/v2/ticker.do?symbol=all")
+func (p *LbankProvider) messageReceived(messageType int, bz []byte) {
+	var tickerMsg LbankTickerMsg
+	err := json.Unmarshal(bz, &tickerMsg)
 	if err != nil {
-		return err
+		p.logger.Error().Err(err).Msg("failed to unmarshal message")
+		return
 	}
 
-	var tickers LbankTickersResponse
-	err = json.Unmarshal(content, &tickers)
-	if err != nil {
-		return err
+	if tickerMsg.Action == "ping" {
+		err = p.websocket.SendJSON(LbankPongMsg{
+			Action: "pong",
+			Pong:   tickerMsg.Ping,
+		})
+		if err != nil {
+			p.logger.Error().Err(err).Msg("failed to send pong")
+		}
+		return
+	}
+
+	if tickerMsg.Status == "error" {
+		p.logger.Error().Str("msg", tickerMsg.Message).Msg("received error message")
+		return
+	}
+
+	if tickerMsg.Type != "tick" {
+		return
 	}
 
+	symbol := p.ProviderPairToCurrencyPair(tickerMsg.Pair).String()
+
 	p.mtx.Lock()
 	defer p.mtx.Unlock()
 
-	for _, ticker := range tickers.Data {
-		symbol, ok := symbols[ticker.Symbol]
-		if !ok {
-			continue
-		}
+	if _, ok := p.pairs[symbol]; !ok {
+		return
+	}
 
-		p.tickers[symbol] = types.TickerPrice{
-			Price:  floatToDec(ticker.Ticker.Price),
-			Volume: floatToDec(ticker.Ticker.Volume),
-			Time:   time.UnixMilli(ticker.Time),
-		}
+	// the timestamps of the updates are in the local time of the exchange
+	p.tickers[symbol] = types.TickerPrice{
+		Price:  floatToDec(tickerMsg.Tick.Price),
+		Volume: floatToDec(tickerMsg.Tick.Volume),
+		Time:   time.Now(),
+	}
+}
+
+func (p *LbankProvider) CurrencyPairToProviderPair(pair types.CurrencyPair) string {
+	return strings.ToLower(pair.Join("_"))
+}
+
+func (p *LbankProvider) ProviderPairToCurrencyPair(pair string) types.CurrencyPair {
+	tokens := strings.Split(strings.ToUpper(pair), "_")
+	if len(tokens) != 2 {
+		p.logger.Warn().Str("pair", pair).Msg("failed to convert to currency pair")
+		return types.CurrencyPair{}
+	}
+	return types.CurrencyPair{
+		Base:  tokens[0],
+		Quote: tokens[1],
 	}
-	p.logger.Debug().Msg("updated tickers")
-	return nil
 }
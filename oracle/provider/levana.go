@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+)
+
+var (
+	_                      Provider = (*LevanaProvider)(nil)
+	levanaDefaultEndpoints          = Endpoint{
+		Name:         ProviderLevana,
+		Urls:         []string{"https://rest.cosmos.directory/osmosis"},
+		PollInterval: 10 * time.Second,
+	}
+)
+
+type (
+	// LevanaProvider defines an oracle provider querying the spot prices of
+	// Levana perps market contracts directly using the REST endpoint of a
+	// node. Levana is deployed on multiple chains (Osmosis, Sei, Injective),
+	// the chain is selected by the `urls` and the `contracts` of the provider
+	// endpoints, which map the pairs to their market contracts. Pairs quoted
+	// in USD use the USD price of the market, all others the price of the
+	// base in the quote asset. The prices don't have a volume, so their
+	// tickers are reported with a volume of one.
+	//
+	// REF: https://docs.levana.finance/contracts/market#spot_price
+	LevanaProvider struct {
+		provider
+	}
+
+	LevanaSpotPriceResponse struct {
+		PriceBase string `json:"price_base"` // ex.: "11.2481"
+		PriceUsd  string `json:"price_usd"`  // ex.: "11.2481"
+		Timestamp string `json:"timestamp"`  // ex.: "1700000000000000000"
+	}
+)
+
+func NewLevanaProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*LevanaProvider, error) {
+	provider := &LevanaProvider{}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		nil,
+		nil,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
+
+func (p *LevanaProvider) Poll() error {
+	for symbol, pair := range p.pairs {
+		ticker, err := p.getTicker(pair)
+		if err != nil {
+			p.logger.Warn().Err(err).Str("pair", symbol).Msg("failed to query market")
+			continue
+		}
+
+		p.mtx.Lock()
+		p.tickers[symbol] = ticker
+		p.mtx.Unlock()
+	}
+
+	p.logger.Debug().Msg("updated tickers")
+	return nil
+}
+
+func (p *LevanaProvider) getTicker(pair types.CurrencyPair) (types.TickerPrice, error) {
+	contract, ok := p.endpoints.Contracts[pair.String()]
+	if !ok {
+		return types.TickerPrice{}, fmt.Errorf("no contract configured for %s", pair.String())
+	}
+
+	var spotPrice LevanaSpotPriceResponse
+	err := p.wasmQuery(contract, map[string]interface{}{"spot_price": struct{}{}}, &spotPrice)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	price := spotPrice.PriceBase
+	if pair.Quote == "USD" {
+		price = spotPrice.PriceUsd
+	}
+	if price == "" {
+		return types.TickerPrice{}, fmt.Errorf("no price returned by %s", contract)
+	}
+
+	// the timestamps are nanoseconds
+	timestamp := time.Now()
+	if nanos, err := strconv.ParseInt(spotPrice.Timestamp, 10, 64); err == nil {
+		timestamp = time.Unix(0, nanos)
+	}
+
+	return types.TickerPrice{
+		Price:  strToDec(price),
+		Volume: sdk.OneDec(),
+		Time:   timestamp,
+	}, nil
+}
@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLevanaProvider_Poll(t *testing.T) {
+	const market = "osmo1hd7r733w49wrqnxx3daz4gy7kvdhgwsjwn28wj7msjfk4tde89aqjqhu8x"
+
+	server := newWasmTestServer(func(contract string, query map[string]json.RawMessage) interface{} {
+		return LevanaSpotPriceResponse{
+			PriceBase: "0.98",
+			PriceUsd:  "9.8",
+			Timestamp: "1700000000000000000",
+		}
+	})
+	defer server.Close()
+
+	atomUsd := types.CurrencyPair{Base: "ATOM", Quote: "USD"}
+	atomOsmo := types.CurrencyPair{Base: "ATOM", Quote: "OSMO"}
+
+	p := newTestProvider(t, NewLevanaProvider, server, Endpoint{
+		Name:      ProviderLevana,
+		Contracts: map[string]string{"ATOMUSD": market, "ATOMOSMO": market},
+	}, atomUsd, atomOsmo)
+
+	require.NoError(t, p.Poll())
+	for _, query := range wasmTestQueries(t, server) {
+		require.Equal(t, market, query.contract)
+		require.Contains(t, query.query, "spot_price")
+	}
+	require.Equal(t, sdk.MustNewDecFromStr("9.8"), p.tickers["ATOMUSD"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("0.98"), p.tickers["ATOMOSMO"].Price)
+	require.Equal(t, int64(1700000000), p.tickers["ATOMUSD"].Time.Unix())
+}
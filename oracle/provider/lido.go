@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+)
+
+const (
+	// lidoWstEth defines the address of the wstETH token on Ethereum.
+	lidoWstEth = "0x7f39c581f595b53c5cb19bd0b3f8da6c935e2ca0"
+)
+
+var (
+	_                    Provider = (*LidoProvider)(nil)
+	lidoDefaultEndpoints          = Endpoint{
+		Name:         ProviderLido,
+		Urls:         []string{"https://cloudflare-eth.com"},
+		PollInterval: 30 * time.Second,
+	}
+)
+
+type (
+	// LidoProvider defines an oracle provider reading the amount of stETH
+	// per wstETH from the wstETH contract, which is reported as the price of
+	// WSTETH quoted in STETH or in ETH, since stETH is redeemable 1:1 for
+	// ETH. Like the redemption rates of the StrideProvider, the USD
+	// conversion multiplies it with the aggregated price of the quote, so
+	// wstETH doesn't depend on thin spot pools. Bridged wstETH contracts are
+	// set as "WSTETH" in the `contracts` of the provider endpoints.
+	//
+	// REF: https://docs.lido.fi/contracts/wsteth
+	LidoProvider struct {
+		provider
+	}
+)
+
+func NewLidoProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*LidoProvider, error) {
+	provider := &LidoProvider{}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		nil,
+		nil,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
+
+func (p *LidoProvider) Poll() error {
+	contract := lidoWstEth
+	if address, ok := p.endpoints.Contracts["WSTETH"]; ok {
+		contract = strings.ToLower(address)
+	}
+
+	result, err := p.evmCall(contract, evmEncodeCall("stEthPerToken()"))
+	if err != nil {
+		return err
+	}
+	value, err := evmDecodeUint(result, 0)
+	if err != nil {
+		return err
+	}
+
+	rate := bigIntToDec(value, 18)
+	if !isRedemptionRateValid(rate) {
+		return fmt.Errorf("stETH per wstETH out of bounds: %s", rate)
+	}
+
+	timestamp := time.Now()
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	for symbol, pair := range p.pairs {
+		if pair.Base != "WSTETH" || (pair.Quote != "STETH" && pair.Quote != "ETH") {
+			continue
+		}
+		p.tickers[symbol] = types.TickerPrice{
+			Price:  rate,
+			Volume: sdk.OneDec(),
+			Time:   timestamp,
+		}
+	}
+
+	p.logger.Debug().Msg("updated tickers")
+	return nil
+}
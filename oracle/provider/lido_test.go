@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLidoProvider_Poll(t *testing.T) {
+	server := newEvmTestServer(func(params EvmCallParams) []byte {
+		value, _ := new(big.Int).SetString("1180000000000000000", 10)
+		return evmEncodeInt(value)
+	})
+	defer server.Close()
+
+	p := newTestProvider(t, NewLidoProvider, server, Endpoint{Name: ProviderLido},
+		types.CurrencyPair{Base: "WSTETH", Quote: "ETH"},
+		types.CurrencyPair{Base: "WSTETH", Quote: "STETH"},
+		types.CurrencyPair{Base: "STETH", Quote: "ETH"},
+	)
+
+	require.NoError(t, p.Poll())
+	for _, call := range evmTestCalls(t, server) {
+		require.Equal(t, lidoWstEth, call.To)
+		require.Equal(t, "0x"+hex.EncodeToString(evmEncodeCall("stEthPerToken()")), call.Data)
+	}
+	require.Len(t, p.tickers, 2)
+	require.Equal(t, sdk.MustNewDecFromStr("1.18"), p.tickers["WSTETHETH"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("1.18"), p.tickers["WSTETHSTETH"].Price)
+}
@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+)
+
+var (
+	_                        Provider = (*MilkywayProvider)(nil)
+	milkywayDefaultEndpoints          = Endpoint{
+		Name:         ProviderMilkyway,
+		Urls:         []string{"https://rest.cosmos.directory/osmosis"},
+		PollInterval: 30 * time.Second,
+	}
+
+	// milkywayDefaultContracts maps the liquid staking tokens to the
+	// addresses of their staking contracts on Osmosis.
+	milkywayDefaultContracts = map[string]string{
+		"MILKTIA": "osmo1f5vfcph2dvfeqcqkhetwv75fda69z7e5c2dldm3kvgj23crkv6wqcn47a0",
+	}
+)
+
+type (
+	// MilkywayProvider defines an oracle provider using the state of the
+	// MilkyWay liquid staking contracts on Osmosis. The amount of staked
+	// native tokens per liquid staking token is reported as the price of the
+	// liquid staking token quoted in its underlying, ex.: MILKTIA/TIA, which
+	// the USD conversion multiplies with the aggregated price of the
+	// underlying. The `contracts` of the provider endpoints map other liquid
+	// staking tokens to their staking contracts.
+	//
+	// REF: https://github.com/milkyway-labs/milkyway-contracts
+	MilkywayProvider struct {
+		provider
+	}
+
+	MilkywayStateResponse struct {
+		TotalNativeToken      string `json:"total_native_token"`       // ex.: "29451773651497"
+		TotalLiquidStakeToken string `json:"total_liquid_stake_token"` // ex.: "28201938912304"
+		IsPaused              bool   `json:"is_paused"`
+	}
+)
+
+func NewMilkywayProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*MilkywayProvider, error) {
+	provider := &MilkywayProvider{}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		nil,
+		nil,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
+
+func (p *MilkywayProvider) Poll() error {
+	for symbol, pair := range p.pairs {
+		ticker, err := p.getTicker(pair)
+		if err != nil {
+			p.logger.Warn().Err(err).Str("pair", symbol).Msg("failed to get redemption rate")
+			continue
+		}
+
+		p.mtx.Lock()
+		p.tickers[symbol] = ticker
+		p.mtx.Unlock()
+	}
+
+	p.logger.Debug().Msg("updated tickers")
+	return nil
+}
+
+func (p *MilkywayProvider) getTicker(pair types.CurrencyPair) (types.TickerPrice, error) {
+	if pair.Base != "MILK"+pair.Quote {
+		return types.TickerPrice{}, fmt.Errorf("%s is not quoted in its underlying", pair.Base)
+	}
+
+	contract, ok := p.endpoints.Contracts[pair.Base]
+	if !ok {
+		contract, ok = milkywayDefaultContracts[pair.Base]
+	}
+	if !ok {
+		return types.TickerPrice{}, fmt.Errorf("no contract configured for %s", pair.Base)
+	}
+
+	var state MilkywayStateResponse
+	err := p.wasmQuery(contract, map[string]interface{}{"state": struct{}{}}, &state)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	if state.IsPaused {
+		return types.TickerPrice{}, fmt.Errorf("staking contract is paused")
+	}
+
+	native := strToDec(state.TotalNativeToken)
+	liquid := strToDec(state.TotalLiquidStakeToken)
+	if !liquid.IsPositive() {
+		return types.TickerPrice{}, fmt.Errorf("no liquid staking tokens issued")
+	}
+
+	rate := native.Quo(liquid)
+	if !isRedemptionRateValid(rate) {
+		return types.TickerPrice{}, fmt.Errorf("redemption rate out of bounds: %s", rate)
+	}
+
+	return types.TickerPrice{
+		Price:  rate,
+		Volume: sdk.OneDec(),
+		Time:   time.Now(),
+	}, nil
+}
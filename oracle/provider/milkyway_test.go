@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMilkywayProvider_Poll(t *testing.T) {
+	const milkosmo = "osmo1milkosmo"
+
+	server := newWasmTestServer(func(contract string, query map[string]json.RawMessage) interface{} {
+		switch contract {
+		case milkywayDefaultContracts["MILKTIA"]:
+			return MilkywayStateResponse{TotalNativeToken: "1020000", TotalLiquidStakeToken: "1000000"}
+		case milkosmo:
+			return MilkywayStateResponse{TotalNativeToken: "1000000", TotalLiquidStakeToken: "1000000", IsPaused: true}
+		}
+		return nil
+	})
+	defer server.Close()
+
+	milktia := types.CurrencyPair{Base: "MILKTIA", Quote: "TIA"}
+	milkosmoOsmo := types.CurrencyPair{Base: "MILKOSMO", Quote: "OSMO"}
+	milktiaUsd := types.CurrencyPair{Base: "MILKTIA", Quote: "USD"}
+
+	p := newTestProvider(t, NewMilkywayProvider, server, Endpoint{
+		Name:      ProviderMilkyway,
+		Contracts: map[string]string{"MILKOSMO": milkosmo},
+	}, milktia, milkosmoOsmo, milktiaUsd)
+
+	require.NoError(t, p.Poll())
+	for _, query := range wasmTestQueries(t, server) {
+		require.Contains(t, query.query, "state")
+	}
+	require.Len(t, p.tickers, 1)
+	require.Equal(t, sdk.MustNewDecFromStr("1.02"), p.tickers["MILKTIATIA"].Price)
+}
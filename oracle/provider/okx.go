@@ -4,41 +4,70 @@ import (
 	"context"
 	"encoding/json"
 	"strconv"
+	"strings"
 	"time"
 
 	"price-feeder/oracle/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gorilla/websocket"
 	"github.com/rs/zerolog"
 )
 
 var (
 	_                   Provider = (*OkxProvider)(nil)
 	okxDefaultEndpoints          = Endpoint{
-		Name:         ProviderOkx,
-		Urls:         []string{"https://www.okx.com", "https://aws.okx.com"},
-		PollInterval: 2 * time.Second,
+		Name:          ProviderOkx,
+		Urls:          []string{"https://www.okx.com", "https://aws.okx.com"},
+		Websocket:     "ws.okx.com:8443",
+		WebsocketPath: "/ws/v5/public",
+		// OKX closes connections without any messages for 30 seconds
+		PingDuration: 25 * time.Second,
+		PingType:     websocket.TextMessage,
+		PingMessage:  "ping",
 	}
 )
 
 type (
-	// OkxProvider defines an oracle provider implemented by the OKX
-	// public API.
+	// OkxProvider defines an oracle provider implemented by the OKX v5
+	// public websocket API.
 	//
-	// REF: https://www.okx.com/docs-v5/en
+	// REF: https://www.okx.com/docs-v5/en/#websocket-api-public-channel-tickers-channel
 	OkxProvider struct {
 		provider
 	}
 
-	OkxTickersResponse struct {
-		Code    string      `json:"code"`
-		Message string      `json:"msg"`
-		Data    []OkxTicker `json:"data"`
+	OkxSubscriptionMsg struct {
+		Op   string               `json:"op"` // ex.: "subscribe"
+		Args []OkxSubscriptionArg `json:"args"`
+	}
+
+	OkxSubscriptionArg struct {
+		Channel string `json:"channel"` // ex.: "tickers"
+		InstId  string `json:"instId"`  // ex.: "BTC-USDT"
+	}
+
+	// OkxEventMsg defines the acks of (un)subscriptions and errors, ex.:
+	// {"event":"subscribe","arg":{"channel":"tickers","instId":"BTC-USDT"}}
+	// {"event":"error","code":"60012","msg":"Invalid request"}
+	OkxEventMsg struct {
+		Event   string             `json:"event"`
+		Arg     OkxSubscriptionArg `json:"arg"`
+		Code    string             `json:"code"`
+		Message string             `json:"msg"`
+	}
+
+	OkxTickersMsg struct {
+		Arg  OkxSubscriptionArg `json:"arg"`
+		Data []OkxTicker        `json:"data"`
 	}
 
 	OkxTicker struct {
 		Symbol string `json:"instId"` // Symbol ex.: BTC-USDT
 		Price  string `json:"last"`   // Last price ex.: 0.0025
 		Volume string `json:"vol24h"` // Total traded base asset volume ex.: 1000
+		Bid    string `json:"bidPx"`  // Best bid price ex.: 0.0024
+		Ask    string `json:"askPx"`  // Best ask price ex.: 0.0026
 		Time   string `json:"ts"`     // Timestamp ex.: 1675246930699
 	}
 )
@@ -55,35 +84,46 @@ func NewOkxProvider(
 		endpoints,
 		logger,
 		pairs,
-		nil,
-		nil,
+		provider.messageReceived,
+		provider.getSubscriptionMsgs,
 	)
-	go startPolling(provider, provider.endpoints.PollInterval, logger)
 	return provider, nil
 }
 
-func (p *OkxProvider) Poll() error {
-	symbols := make(map[string]string, len(p.pairs))
-	for _, pair := range p.pairs {
-		symbols[pair.Join("-")] = pair.String()
+func (p *OkxProvider) getSubscriptionMsgs(pairs ...types.CurrencyPair) []interface{} {
+	args := make([]OkxSubscriptionArg, len(pairs))
+	for i, pair := range pairs {
+		args[i] = OkxSubscriptionArg{
+			Channel: "tickers",
+			InstId:  p.CurrencyPairToProviderPair(pair),
+		}
 	}
+	return []interface{}{
+		OkxSubscriptionMsg{
+			Op:   "subscribe",
+			Args: args,
+		},
+	}
+}
 
-	content, err := p.httpGet("/api/v5/market/tickers?instType=SPOT")
-	if err != nil {
-		return err
+func (p *OkxProvider) messageReceived(messageType int, bz []byte) {
+	if p.eventReceived(bz) {
+		return
 	}
 
-	var tickers OkxTickersResponse
-	err = json.Unmarshal(content, &tickers)
+	var tickersMsg OkxTickersMsg
+	err := json.Unmarshal(bz, &tickersMsg)
 	if err != nil {
-		return err
+		p.logger.Error().Err(err).Msg("failed to unmarshal tickers")
+		return
 	}
 
 	p.mtx.Lock()
 	defer p.mtx.Unlock()
-	for _, ticker := range tickers.Data {
-		symbol, ok := symbols[ticker.Symbol]
-		if !ok {
+
+	for _, ticker := range tickersMsg.Data {
+		symbol := p.ProviderPairToCurrencyPair(ticker.Symbol).String()
+		if _, ok := p.pairs[symbol]; !ok {
 			continue
 		}
 
@@ -95,12 +135,60 @@ func (p *OkxProvider) Poll() error {
 			continue
 		}
 
+		spread := sdk.Dec{}
+		if ticker.Bid != "" && ticker.Ask != "" {
+			spread = computeSpread(strToDec(ticker.Bid), strToDec(ticker.Ask))
+		}
+
 		p.tickers[symbol] = types.TickerPrice{
 			Price:  strToDec(ticker.Price),
 			Volume: strToDec(ticker.Volume),
 			Time:   time.UnixMilli(timestamp),
+			Spread: spread,
 		}
 	}
-	p.logger.Debug().Msg("updated tickers")
-	return nil
+}
+
+// eventReceived handles the (un)subscription acks and errors, returning false
+// if the message isn't an event.
+func (p *OkxProvider) eventReceived(bz []byte) bool {
+	var eventMsg OkxEventMsg
+	err := json.Unmarshal(bz, &eventMsg)
+	if err != nil {
+		p.logger.Error().Err(err).Msg("failed to unmarshal message")
+		return true
+	}
+
+	// only the channel updates don't have an event
+	switch eventMsg.Event {
+	case "":
+		return false
+	case "subscribe", "unsubscribe":
+		p.logger.Debug().
+			Str("event", eventMsg.Event).
+			Str("inst_id", eventMsg.Arg.InstId).
+			Msg("subscription acknowledged")
+	case "error":
+		p.logger.Error().
+			Str("code", eventMsg.Code).
+			Str("msg", eventMsg.Message).
+			Msg("received error message")
+	}
+	return true
+}
+
+func (p *OkxProvider) CurrencyPairToProviderPair(pair types.CurrencyPair) string {
+	return pair.Join("-")
+}
+
+func (p *OkxProvider) ProviderPairToCurrencyPair(pair string) types.CurrencyPair {
+	tokens := strings.Split(pair, "-")
+	if len(tokens) != 2 {
+		p.logger.Warn().Str("pair", pair).Msg("failed to convert to currency pair")
+		return types.CurrencyPair{}
+	}
+	return types.CurrencyPair{
+		Base:  tokens[0],
+		Quote: tokens[1],
+	}
 }
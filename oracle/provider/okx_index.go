@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+)
+
+var (
+	_                        Provider = (*OkxIndexProvider)(nil)
+	okxIndexDefaultEndpoints          = Endpoint{
+		Name:          ProviderOkxIndex,
+		Urls:          okxDefaultEndpoints.Urls,
+		Websocket:     okxDefaultEndpoints.Websocket,
+		WebsocketPath: okxDefaultEndpoints.WebsocketPath,
+		PingDuration:  okxDefaultEndpoints.PingDuration,
+		PingType:      okxDefaultEndpoints.PingType,
+		PingMessage:   okxDefaultEndpoints.PingMessage,
+	}
+)
+
+type (
+	// OkxIndexProvider defines an oracle provider streaming the OKX index
+	// prices, ex.: "ATOM-USD", which are aggregated over several exchanges.
+	// Indices don't have a volume, so their tickers are reported with a volume
+	// of one and are best used with the `referenceOnly` role.
+	//
+	// REF: https://www.okx.com/docs-v5/en/#public-data-websocket-index-tickers-channel
+	OkxIndexProvider struct {
+		OkxProvider
+	}
+
+	OkxIndexTickersMsg struct {
+		Arg  OkxSubscriptionArg `json:"arg"`
+		Data []OkxIndexTicker   `json:"data"`
+	}
+
+	OkxIndexTicker struct {
+		Symbol string `json:"instId"` // ex.: "ATOM-USD"
+		Price  string `json:"idxPx"`  // ex.: "13.61"
+		Time   string `json:"ts"`     // ex.: "1675246930699"
+	}
+)
+
+func NewOkxIndexProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*OkxIndexProvider, error) {
+	provider := &OkxIndexProvider{}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		provider.messageReceived,
+		provider.getSubscriptionMsgs,
+	)
+	return provider, nil
+}
+
+func (p *OkxIndexProvider) getSubscriptionMsgs(pairs ...types.CurrencyPair) []interface{} {
+	args := make([]OkxSubscriptionArg, len(pairs))
+	for i, pair := range pairs {
+		args[i] = OkxSubscriptionArg{
+			Channel: "index-tickers",
+			InstId:  p.CurrencyPairToProviderPair(pair),
+		}
+	}
+	return []interface{}{
+		OkxSubscriptionMsg{
+			Op:   "subscribe",
+			Args: args,
+		},
+	}
+}
+
+func (p *OkxIndexProvider) messageReceived(messageType int, bz []byte) {
+	if p.eventReceived(bz) {
+		return
+	}
+
+	var tickersMsg OkxIndexTickersMsg
+	err := json.Unmarshal(bz, &tickersMsg)
+	if err != nil {
+		p.logger.Error().Err(err).Msg("failed to unmarshal index tickers")
+		return
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	for _, ticker := range tickersMsg.Data {
+		symbol := p.ProviderPairToCurrencyPair(ticker.Symbol).String()
+		if _, ok := p.pairs[symbol]; !ok {
+			continue
+		}
+
+		timestamp, err := strconv.ParseInt(ticker.Time, 10, 64)
+		if err != nil {
+			p.logger.Error().Err(err).Msg("failed parsing timestamp")
+			continue
+		}
+
+		p.tickers[symbol] = types.TickerPrice{
+			Price:  strToDec(ticker.Price),
+			Volume: sdk.OneDec(),
+			Time:   time.UnixMilli(timestamp),
+		}
+	}
+}
@@ -0,0 +1,186 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+)
+
+const (
+	// openExchangeRatesRefreshInterval defines how often the rates are
+	// requested, which are updated hourly by both APIs.
+	openExchangeRatesRefreshInterval = time.Hour
+	// openExchangeRatesRetryInterval defines the delay before retrying a
+	// failed request, which doubles with every consecutive failure up to the
+	// refresh interval.
+	openExchangeRatesRetryInterval = 5 * time.Minute
+	// openExchangeRatesMaxAge defines the age of the rates after which they
+	// aren't reported anymore, ex.: when the API keeps failing.
+	openExchangeRatesMaxAge = 3 * time.Hour
+)
+
+var (
+	_                                 Provider = (*OpenExchangeRatesProvider)(nil)
+	openExchangeRatesDefaultEndpoints          = Endpoint{
+		Name:         ProviderOpenExchangeRates,
+		Urls:         []string{"https://openexchangerates.org"},
+		PollInterval: 30 * time.Second,
+	}
+)
+
+type (
+	// OpenExchangeRatesProvider defines an oracle provider reporting the
+	// exchange rates of fiat currencies from Open Exchange Rates, which
+	// requires the `api_key` of the provider endpoints. The rates are only
+	// requested once an hour to stay within the request quotas, but are
+	// reported on every poll, so the tickers don't become stale. Pairs not
+	// quoted in the base currency of the rates are crossed via the base.
+	//
+	// REF: https://docs.openexchangerates.org/reference/latest-json
+	OpenExchangeRatesProvider struct {
+		provider
+		path     string
+		keyParam string
+		rates    map[string]sdk.Dec
+		// updated is the publication time of the rates, while requested
+		// is the time of the last request, successful or not.
+		updated   time.Time
+		requested time.Time
+		failures  int
+	}
+
+	// OpenExchangeRatesResponse defines the rates of the base currency, ex.:
+	// {"timestamp":1700000000,"base":"USD","rates":{"KRW":1317.88}}
+	OpenExchangeRatesResponse struct {
+		Success   *bool              `json:"success"`
+		Error     *FixerError        `json:"error"`
+		Timestamp int64              `json:"timestamp"`
+		Base      string             `json:"base"`
+		Rates     map[string]float64 `json:"rates"`
+	}
+)
+
+func NewOpenExchangeRatesProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*OpenExchangeRatesProvider, error) {
+	if endpoints.ApiKey == "" {
+		return nil, fmt.Errorf("%s requires an api key", endpoints.Name)
+	}
+
+	provider := &OpenExchangeRatesProvider{}
+	provider.path = "/api/latest.json"
+	provider.keyParam = "app_id"
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		nil,
+		nil,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
+
+func (p *OpenExchangeRatesProvider) Poll() error {
+	if time.Since(p.requested) >= p.refreshInterval() {
+		p.requested = time.Now()
+		err := p.refreshRates()
+		if err != nil {
+			p.failures++
+			p.logger.Warn().Err(err).Int("failures", p.failures).Msg("failed to refresh rates")
+		} else {
+			p.failures = 0
+		}
+	}
+	if time.Since(p.updated) > openExchangeRatesMaxAge {
+		return fmt.Errorf("rates are stale")
+	}
+
+	timestamp := time.Now()
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	for symbol, pair := range p.pairs {
+		base, ok := p.rates[pair.Base]
+		if !ok {
+			continue
+		}
+		quote, ok := p.rates[pair.Quote]
+		if !ok {
+			continue
+		}
+		p.tickers[symbol] = types.TickerPrice{
+			Price:  quote.Quo(base),
+			Volume: sdk.OneDec(),
+			Time:   timestamp,
+		}
+	}
+
+	p.logger.Debug().Msg("updated rates")
+	return nil
+}
+
+// refreshInterval returns the delay between requests, backing off after
+// failed requests, ex.: due to an invalid key or an exhausted quota.
+func (p *OpenExchangeRatesProvider) refreshInterval() time.Duration {
+	if p.failures == 0 {
+		return openExchangeRatesRefreshInterval
+	}
+	interval := openExchangeRatesRetryInterval
+	for i := 1; i < p.failures && interval < openExchangeRatesRefreshInterval; i++ {
+		interval *= 2
+	}
+	if interval > openExchangeRatesRefreshInterval {
+		return openExchangeRatesRefreshInterval
+	}
+	return interval
+}
+
+func (p *OpenExchangeRatesProvider) refreshRates() error {
+	query := url.Values{}
+	query.Set(p.keyParam, p.endpoints.ApiKey)
+
+	content, err := p.httpGet(p.path + "?" + query.Encode())
+	if err != nil {
+		return err
+	}
+
+	var response OpenExchangeRatesResponse
+	err = json.Unmarshal(content, &response)
+	if err != nil {
+		return err
+	}
+	if response.Success != nil && !*response.Success {
+		if response.Error != nil {
+			return response.Error
+		}
+		return fmt.Errorf("request failed")
+	}
+
+	// the rates define the amount of the currency per unit of the base
+	rates := map[string]sdk.Dec{
+		response.Base: sdk.OneDec(),
+	}
+	for currency, rate := range response.Rates {
+		if rate <= 0 {
+			continue
+		}
+		rates[currency] = floatToDec(rate)
+	}
+
+	p.rates = rates
+	p.updated = time.Unix(response.Timestamp, 0)
+	return nil
+}
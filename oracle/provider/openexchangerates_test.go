@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenExchangeRatesProvider_Poll(t *testing.T) {
+	server := newTestServer(func(rw http.ResponseWriter, req *http.Request) {
+		// rates published 70 minutes ago don't cause another request
+		_, _ = fmt.Fprintf(rw, `{"timestamp":%d,"base":"USD","rates":{"KRW":1250,"EUR":0.8}}`, time.Now().Add(-70*time.Minute).Unix())
+	})
+	defer server.Close()
+
+	krwUsd := types.CurrencyPair{Base: "KRW", Quote: "USD"}
+	eurKrw := types.CurrencyPair{Base: "EUR", Quote: "KRW"}
+
+	p := newTestProvider(t, NewOpenExchangeRatesProvider, server, Endpoint{
+		Name:   ProviderOpenExchangeRates,
+		ApiKey: "key",
+	}, krwUsd, eurKrw)
+
+	require.NoError(t, p.Poll())
+	require.Equal(t, sdk.MustNewDecFromStr("0.0008"), p.tickers["KRWUSD"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("1562.5"), p.tickers["EURKRW"].Price)
+
+	// the rates are only requested once an hour
+	require.NoError(t, p.Poll())
+	requests := server.Requests()
+	require.Len(t, requests, 1)
+	require.Equal(t, "/api/latest.json", requests[0].URL.Path)
+	require.Equal(t, "key", requests[0].URL.Query().Get("app_id"))
+}
+
+func TestFixerProvider_Poll(t *testing.T) {
+	server := newTestServer(func(rw http.ResponseWriter, req *http.Request) {
+		_, _ = rw.Write([]byte(`{"success":false,"error":{"code":101,"type":"invalid_access_key"}}`))
+	})
+	defer server.Close()
+
+	p := newTestProvider(t, NewFixerProvider, server, Endpoint{
+		Name:   ProviderFixer,
+		ApiKey: "key",
+	})
+
+	require.EqualError(t, p.refreshRates(), "fixer error 101: invalid_access_key")
+	require.EqualError(t, p.Poll(), "rates are stale")
+
+	// failed requests are retried with a backoff
+	require.EqualError(t, p.Poll(), "rates are stale")
+	requests := server.Requests()
+	require.Len(t, requests, 2)
+	for _, req := range requests {
+		require.Equal(t, "/api/latest", req.URL.Path)
+		require.Equal(t, "key", req.URL.Query().Get("access_key"))
+	}
+	require.Equal(t, 1, p.failures)
+	require.Equal(t, openExchangeRatesRetryInterval, p.refreshInterval())
+
+	p.failures = 3
+	require.Equal(t, 4*openExchangeRatesRetryInterval, p.refreshInterval())
+	p.failures = 10
+	require.Equal(t, openExchangeRatesRefreshInterval, p.refreshInterval())
+}
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	"github.com/rs/zerolog"
+)
+
+var (
+	_                       Provider = (*OraidexProvider)(nil)
+	oraidexDefaultEndpoints          = Endpoint{
+		Name:         ProviderOraidex,
+		Urls:         []string{"https://rest.cosmos.directory/oraichain"},
+		PollInterval: 10 * time.Second,
+	}
+)
+
+type (
+	// OraidexProvider defines an oracle provider querying OraiDEX (Oraiswap)
+	// pair contracts on Oraichain directly using the REST endpoint of a node.
+	// Oraiswap is a fork of Terraswap, so the pairs are queried like the ones
+	// of the AstroportProvider. Cw20 tokens like ORAIX are listed with the
+	// "cw20:" prefix in the `contracts` of the provider endpoints.
+	//
+	// REF: https://github.com/oraichain/oraiswap
+	OraidexProvider struct {
+		AstroportProvider
+	}
+)
+
+func NewOraidexProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*OraidexProvider, error) {
+	provider := &OraidexProvider{}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		nil,
+		nil,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+)
+
+// Offsets of the fields of the Orca whirlpool state.
+const (
+	orcaSqrtPriceOffset   = 65
+	orcaTokenMintAOffset  = 101
+	orcaTokenVaultAOffset = 133
+	orcaTokenMintBOffset  = 181
+	orcaTokenVaultBOffset = 213
+)
+
+var (
+	_                    Provider = (*OrcaProvider)(nil)
+	orcaDefaultEndpoints          = Endpoint{
+		Name:         ProviderOrca,
+		Urls:         []string{"https://api.mainnet-beta.solana.com"},
+		PollInterval: 15 * time.Second,
+	}
+)
+
+type (
+	// OrcaProvider defines an oracle provider reading Orca whirlpool accounts
+	// using a Solana JSON-RPC endpoint. The price is derived from the current
+	// Q64.64 sqrt price of the concentrated liquidity pool. The `contracts` of
+	// the provider endpoints map the denoms to their mint addresses and the
+	// pairs to their whirlpool addresses. The base token balance of the pool
+	// vault is reported as volume.
+	//
+	// REF: https://github.com/orca-so/whirlpools/blob/main/programs/whirlpool/src/state/whirlpool.rs
+	OrcaProvider struct {
+		provider
+	}
+)
+
+func NewOrcaProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*OrcaProvider, error) {
+	provider := &OrcaProvider{}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		nil,
+		nil,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
+
+func (p *OrcaProvider) Poll() error {
+	for symbol, pair := range p.pairs {
+		ticker, err := p.getTicker(pair)
+		if err != nil {
+			p.logger.Warn().Err(err).Str("pair", symbol).Msg("failed to get whirlpool")
+			continue
+		}
+
+		p.mtx.Lock()
+		p.tickers[symbol] = ticker
+		p.mtx.Unlock()
+	}
+
+	p.logger.Debug().Msg("updated tickers")
+	return nil
+}
+
+func (p *OrcaProvider) getTicker(pair types.CurrencyPair) (types.TickerPrice, error) {
+	pool, ok := p.endpoints.Contracts[pair.String()]
+	if !ok {
+		return types.TickerPrice{}, fmt.Errorf("no contract configured for %s", pair.String())
+	}
+	baseMint, ok := p.endpoints.Contracts[pair.Base]
+	if !ok {
+		return types.TickerPrice{}, fmt.Errorf("no contract configured for %s", pair.Base)
+	}
+
+	data, err := p.solanaAccountData(pool)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	sqrtPrice, err := solanaDecodeUint128(data, orcaSqrtPriceOffset)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+	if sqrtPrice.Sign() == 0 {
+		return types.TickerPrice{}, fmt.Errorf("whirlpool %s isn't initialized", pool)
+	}
+	mintA, err := solanaDecodePubkey(data, orcaTokenMintAOffset)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+	vaultA, err := solanaDecodePubkey(data, orcaTokenVaultAOffset)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+	mintB, err := solanaDecodePubkey(data, orcaTokenMintBOffset)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+	vaultB, err := solanaDecodePubkey(data, orcaTokenVaultBOffset)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+	if baseMint != mintA && baseMint != mintB {
+		return types.TickerPrice{}, fmt.Errorf("whirlpool %s doesn't contain %s", pool, pair.Base)
+	}
+
+	// the vault balances provide the decimals of the tokens
+	amountA, decimalsA, err := p.solanaTokenBalance(vaultA)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+	amountB, decimalsB, err := p.solanaTokenBalance(vaultB)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	// the sqrt price is the price of token A in token B
+	price := sqrtPriceToDec(sqrtPrice, 64, decimalsA-decimalsB)
+	volume := bigIntToDec(amountA, decimalsA)
+	if baseMint == mintB {
+		if !price.IsPositive() {
+			return types.TickerPrice{}, fmt.Errorf("price of whirlpool %s out of range", pool)
+		}
+		price = sdk.OneDec().Quo(price)
+		volume = bigIntToDec(amountB, decimalsB)
+	}
+
+	return types.TickerPrice{
+		Price:  price,
+		Volume: volume,
+		Time:   time.Now(),
+	}, nil
+}
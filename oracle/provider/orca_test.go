@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"testing"
+
+	"price-feeder/oracle/types"
+
+	"github.com/cosmos/btcutil/base58"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrcaProvider_Poll(t *testing.T) {
+	const (
+		pool      = "Czfq3xZZDmsdGdUyrNLtRhGc47cXcZtLG4crryfu44zE"
+		sol       = "So11111111111111111111111111111111111111112"
+		usdc      = "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v"
+		solVault  = "EUuUbDcafPrmVTD5M6qoJAoyyNbihBhugADAxRMn5he9"
+		usdcVault = "2WLWEuKDgkDUccTpbwYp1GToYktiSB1cXvreHUwiSUVP"
+	)
+
+	// a sqrt price of 0.5 in Q64.64
+	data := make([]byte, 653)
+	binary.LittleEndian.PutUint64(data[orcaSqrtPriceOffset:], 1<<63)
+	copy(data[orcaTokenMintAOffset:], base58.Decode(sol))
+	copy(data[orcaTokenVaultAOffset:], base58.Decode(solVault))
+	copy(data[orcaTokenMintBOffset:], base58.Decode(usdc))
+	copy(data[orcaTokenVaultBOffset:], base58.Decode(usdcVault))
+
+	server := newSolanaTestServer(func(method string, params []interface{}) interface{} {
+		switch {
+		case method == "getAccountInfo" && params[0] == pool:
+			return SolanaAccountInfoResult{Value: &SolanaAccountInfo{
+				Data: []string{base64.StdEncoding.EncodeToString(data), "base64"},
+			}}
+		case method == "getTokenAccountBalance" && params[0] == solVault:
+			return SolanaTokenBalanceResult{Value: SolanaTokenBalance{Amount: "40000000000000", Decimals: 9}}
+		case method == "getTokenAccountBalance" && params[0] == usdcVault:
+			return SolanaTokenBalanceResult{Value: SolanaTokenBalance{Amount: "10000000000000", Decimals: 6}}
+		}
+		return nil
+	})
+	defer server.Close()
+
+	solUsdc := types.CurrencyPair{Base: "SOL", Quote: "USDC"}
+	usdcSol := types.CurrencyPair{Base: "USDC", Quote: "SOL"}
+
+	p := newTestProvider(t, NewOrcaProvider, server, Endpoint{
+		Name:      ProviderOrca,
+		Contracts: map[string]string{"SOL": sol, "USDC": usdc, "SOLUSDC": pool, "USDCSOL": pool},
+	}, solUsdc, usdcSol)
+
+	require.NoError(t, p.Poll())
+	require.Equal(t, sdk.MustNewDecFromStr("250"), p.tickers["SOLUSDC"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("40000"), p.tickers["SOLUSDC"].Volume)
+	require.Equal(t, sdk.MustNewDecFromStr("0.004"), p.tickers["USDCSOL"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("10000000"), p.tickers["USDCSOL"].Volume)
+}
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// orderbookDepth returns the base and quote amounts resting on both sides of
+// the book within the band around the mid price, ex.: a band of 0.02 sums all
+// levels priced within ±2% of the mid price. Levels are [price, base amount]
+// tuples. It returns false if either side of the book is empty.
+func orderbookDepth(bids, asks [][2]string, band sdk.Dec) (sdk.Dec, sdk.Dec, bool) {
+	if len(bids) == 0 || len(asks) == 0 {
+		return sdk.ZeroDec(), sdk.ZeroDec(), false
+	}
+
+	bestBid := strToDec(bids[0][0])
+	for _, level := range bids[1:] {
+		bestBid = sdk.MaxDec(bestBid, strToDec(level[0]))
+	}
+	bestAsk := strToDec(asks[0][0])
+	for _, level := range asks[1:] {
+		bestAsk = sdk.MinDec(bestAsk, strToDec(level[0]))
+	}
+
+	mid := bestBid.Add(bestAsk).QuoInt64(2)
+	lower := mid.Mul(sdk.OneDec().Sub(band))
+	upper := mid.Mul(sdk.OneDec().Add(band))
+
+	baseDepth := sdk.ZeroDec()
+	quoteDepth := sdk.ZeroDec()
+	for _, level := range bids {
+		price := strToDec(level[0])
+		if price.LT(lower) {
+			continue
+		}
+		amount := strToDec(level[1])
+		baseDepth = baseDepth.Add(amount)
+		quoteDepth = quoteDepth.Add(amount.Mul(price))
+	}
+	for _, level := range asks {
+		price := strToDec(level[0])
+		if price.GT(upper) {
+			continue
+		}
+		amount := strToDec(level[1])
+		baseDepth = baseDepth.Add(amount)
+		quoteDepth = quoteDepth.Add(amount.Mul(price))
+	}
+
+	return baseDepth, quoteDepth, true
+}
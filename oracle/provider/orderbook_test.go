@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderbookDepth(t *testing.T) {
+	// mid price is 10, so a 2% band covers [9.8, 10.2]
+	bids := [][2]string{
+		{"9.9", "10"},
+		{"9.8", "20"},
+		{"9.5", "1000"},
+	}
+	asks := [][2]string{
+		{"10.1", "5"},
+		{"10.2", "15"},
+		{"11", "1000"},
+	}
+
+	baseDepth, quoteDepth, ok := orderbookDepth(bids, asks, sdk.MustNewDecFromStr("0.02"))
+	require.True(t, ok)
+	require.Equal(t, sdk.MustNewDecFromStr("50"), baseDepth)
+	// 9.9 * 10 + 9.8 * 20 + 10.1 * 5 + 10.2 * 15
+	require.Equal(t, sdk.MustNewDecFromStr("498.5"), quoteDepth)
+
+	baseDepth, _, ok = orderbookDepth(bids, asks, sdk.MustNewDecFromStr("0.1"))
+	require.True(t, ok)
+	require.Equal(t, sdk.MustNewDecFromStr("2050"), baseDepth)
+
+	_, _, ok = orderbookDepth(bids, nil, sdk.MustNewDecFromStr("0.02"))
+	require.False(t, ok)
+}
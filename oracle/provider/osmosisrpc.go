@@ -0,0 +1,187 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+)
+
+var (
+	_                          Provider = (*OsmosisRPCProvider)(nil)
+	osmosisRPCDefaultEndpoints          = Endpoint{
+		Name:         ProviderOsmosisRPC,
+		PollInterval: 6 * time.Second,
+	}
+)
+
+type (
+	// OsmosisRPCProvider defines an oracle provider querying the spot prices
+	// and liquidity of Osmosis pools from the poolmanager module of an Osmosis
+	// node, as opposed to the OsmosisProvider, which depends on a third-party
+	// indexer. There's no default node, so the `urls` of the provider
+	// endpoints must be set to the REST endpoint of a node of the operator.
+	// The `contracts` map the denoms to their chain denoms and the pairs to
+	// their pool ids, ex.:
+	// {"ATOM": "ibc/27394FB0...", "OSMO": "uosmo", "ATOMOSMO": "1"}. Denoms
+	// have six decimals unless set in `decimals`. Since neither the
+	// poolmanager nor the twap module track trading volume, the base denom
+	// liquidity of the pool is reported as volume.
+	//
+	// REF: https://github.com/osmosis-labs/osmosis/tree/main/x/poolmanager
+	OsmosisRPCProvider struct {
+		provider
+	}
+
+	OsmosisSpotPriceResponse struct {
+		SpotPrice string `json:"spot_price"` // ex.: "10.734391411042944785"
+	}
+
+	OsmosisPoolLiquidityResponse struct {
+		Liquidity []OsmosisCoin `json:"liquidity"`
+	}
+
+	OsmosisCoin struct {
+		Denom  string `json:"denom"`  // ex.: "uosmo"
+		Amount string `json:"amount"` // ex.: "2458325154613"
+	}
+
+	// osmosisPool defines the pool and chain denoms of a pair.
+	osmosisPool struct {
+		id    string
+		base  string
+		quote string
+	}
+)
+
+func NewOsmosisRPCProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*OsmosisRPCProvider, error) {
+	if len(endpoints.Urls) == 0 {
+		return nil, fmt.Errorf("%s requires the urls of an osmosis node", ProviderOsmosisRPC)
+	}
+
+	provider := &OsmosisRPCProvider{}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		nil,
+		nil,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
+
+func (p *OsmosisRPCProvider) Poll() error {
+	for symbol, pair := range p.pairs {
+		ticker, err := p.getTicker(pair)
+		if err != nil {
+			p.logger.Warn().Err(err).Str("pair", symbol).Msg("failed to get pool")
+			continue
+		}
+
+		p.mtx.Lock()
+		p.tickers[symbol] = ticker
+		p.mtx.Unlock()
+	}
+
+	p.logger.Debug().Msg("updated tickers")
+	return nil
+}
+
+func (p *OsmosisRPCProvider) getTicker(pair types.CurrencyPair) (types.TickerPrice, error) {
+	pool, err := p.getPool(pair)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	query := url.Values{}
+	query.Set("base_asset_denom", pool.base)
+	query.Set("quote_asset_denom", pool.quote)
+	content, err := p.httpGet(fmt.Sprintf(
+		"/osmosis/poolmanager/v1beta1/pools/%s/prices?%s",
+		pool.id,
+		query.Encode(),
+	))
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	var spotPrice OsmosisSpotPriceResponse
+	err = json.Unmarshal(content, &spotPrice)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+	if spotPrice.SpotPrice == "" {
+		return types.TickerPrice{}, fmt.Errorf("no spot price for pool %s", pool.id)
+	}
+
+	volume, err := p.getLiquidity(pool.id, pool.base, p.cosmosDecimals(pair.Base))
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	return types.TickerPrice{
+		Price:  p.cosmosScalePrice(pair, strToDec(spotPrice.SpotPrice)),
+		Volume: volume,
+		Time:   time.Now(),
+	}, nil
+}
+
+// getPool returns the pool id and chain denoms configured for the pair.
+func (p *OsmosisRPCProvider) getPool(pair types.CurrencyPair) (osmosisPool, error) {
+	id, ok := p.endpoints.Contracts[pair.String()]
+	if !ok {
+		return osmosisPool{}, fmt.Errorf("no pool configured for %s", pair.String())
+	}
+	base, ok := p.endpoints.Contracts[pair.Base]
+	if !ok {
+		return osmosisPool{}, fmt.Errorf("no denom configured for %s", pair.Base)
+	}
+	quote, ok := p.endpoints.Contracts[pair.Quote]
+	if !ok {
+		return osmosisPool{}, fmt.Errorf("no denom configured for %s", pair.Quote)
+	}
+	return osmosisPool{
+		id:    id,
+		base:  base,
+		quote: quote,
+	}, nil
+}
+
+// getLiquidity returns the amount of the denom in the pool.
+func (p *OsmosisRPCProvider) getLiquidity(id, denom string, decimals int64) (sdk.Dec, error) {
+	content, err := p.httpGet("/osmosis/poolmanager/v1beta1/pools/" + id + "/total_pool_liquidity")
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	var liquidity OsmosisPoolLiquidityResponse
+	err = json.Unmarshal(content, &liquidity)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	for _, coin := range liquidity.Liquidity {
+		if coin.Denom != denom {
+			continue
+		}
+		amount, err := cosmosParseAmount(coin.Amount)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		return bigIntToDec(amount, decimals), nil
+	}
+	return sdk.Dec{}, fmt.Errorf("pool %s doesn't contain %s", id, denom)
+}
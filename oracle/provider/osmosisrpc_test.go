@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOsmosisRPCProvider_Poll(t *testing.T) {
+	const atom = "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+
+	server := newTestServer(func(rw http.ResponseWriter, req *http.Request) {
+		var response string
+		switch req.URL.Path {
+		case "/osmosis/poolmanager/v1beta1/pools/1/prices":
+			response = `{"spot_price":"10.734391411042944785"}`
+		case "/osmosis/poolmanager/v1beta1/pools/1/total_pool_liquidity":
+			response = `{"liquidity":[{"denom":"` + atom + `","amount":"229047256301"},{"denom":"uosmo","amount":"2458325154613"}]}`
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = rw.Write([]byte(response))
+	})
+	defer server.Close()
+
+	atomOsmo := types.CurrencyPair{Base: "ATOM", Quote: "OSMO"}
+
+	p := newTestProvider(t, NewOsmosisRPCProvider, server, Endpoint{
+		Name:      ProviderOsmosisRPC,
+		Contracts: map[string]string{"ATOM": atom, "OSMO": "uosmo", "ATOMOSMO": "1"},
+	}, atomOsmo)
+
+	require.NoError(t, p.Poll())
+	for _, req := range server.Requests() {
+		if req.URL.Path == "/osmosis/poolmanager/v1beta1/pools/1/prices" {
+			require.Equal(t, atom, req.URL.Query().Get("base_asset_denom"))
+			require.Equal(t, "uosmo", req.URL.Query().Get("quote_asset_denom"))
+		}
+	}
+	require.Equal(t, sdk.MustNewDecFromStr("10.734391411042944785"), p.tickers["ATOMOSMO"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("229047.256301"), p.tickers["ATOMOSMO"].Volume)
+
+	// the prices of chain denoms are scaled by the decimals
+	p.endpoints.Decimals = map[string]int64{"ATOM": 18, "OSMO": 6}
+	require.NoError(t, p.Poll())
+	require.Equal(t, sdk.MustNewDecFromStr("10734391411042.944785"), p.tickers["ATOMOSMO"].Price)
+}
+
+func TestNewOsmosisRPCProvider(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// there's no default node
+	_, err := NewOsmosisRPCProvider(ctx, zerolog.Nop(), Endpoint{Name: ProviderOsmosisRPC})
+	require.Error(t, err)
+	_, err = NewOsmosisTwapProvider(ctx, zerolog.Nop(), Endpoint{Name: ProviderOsmosisTwap})
+	require.Error(t, err)
+}
@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	"github.com/rs/zerolog"
+)
+
+const (
+	// osmosisTwapDefaultWindow defines the default window of the TWAPs.
+	osmosisTwapDefaultWindow = 30 * time.Minute
+)
+
+var (
+	_                           Provider = (*OsmosisTwapProvider)(nil)
+	osmosisTwapDefaultEndpoints          = Endpoint{
+		Name:         ProviderOsmosisTwap,
+		PollInterval: 30 * time.Second,
+	}
+)
+
+type (
+	// OsmosisTwapProvider defines an oracle provider querying the geometric
+	// TWAPs of Osmosis pools from the twap module of an Osmosis node, which
+	// makes the prices less sensitive to manipulation within a single block
+	// than the spot prices of the OsmosisRPCProvider. The pools are configured
+	// the same way, and the TWAP window can be set using `twap_window`.
+	//
+	// REF: https://github.com/osmosis-labs/osmosis/tree/main/x/twap
+	OsmosisTwapProvider struct {
+		OsmosisRPCProvider
+	}
+
+	OsmosisGeometricTwapResponse struct {
+		GeometricTwap string `json:"geometric_twap"` // ex.: "10.734391411042944785"
+	}
+)
+
+func NewOsmosisTwapProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*OsmosisTwapProvider, error) {
+	if len(endpoints.Urls) == 0 {
+		return nil, fmt.Errorf("%s requires the urls of an osmosis node", ProviderOsmosisTwap)
+	}
+
+	provider := &OsmosisTwapProvider{}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		nil,
+		nil,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
+
+func (p *OsmosisTwapProvider) Poll() error {
+	for symbol, pair := range p.pairs {
+		ticker, err := p.getTicker(pair)
+		if err != nil {
+			p.logger.Warn().Err(err).Str("pair", symbol).Msg("failed to get twap")
+			continue
+		}
+
+		p.mtx.Lock()
+		p.tickers[symbol] = ticker
+		p.mtx.Unlock()
+	}
+
+	p.logger.Debug().Msg("updated tickers")
+	return nil
+}
+
+func (p *OsmosisTwapProvider) getTicker(pair types.CurrencyPair) (types.TickerPrice, error) {
+	pool, err := p.getPool(pair)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	window := osmosisTwapDefaultWindow
+	if p.endpoints.TwapWindow != 0 {
+		window = p.endpoints.TwapWindow
+	}
+
+	query := url.Values{}
+	query.Set("pool_id", pool.id)
+	query.Set("base_asset", pool.base)
+	query.Set("quote_asset", pool.quote)
+	query.Set("start_time", time.Now().Add(-window).UTC().Format(time.RFC3339))
+	content, err := p.httpGet("/osmosis/twap/v1beta1/GeometricTwapToNow?" + query.Encode())
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	var twap OsmosisGeometricTwapResponse
+	err = json.Unmarshal(content, &twap)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+	if twap.GeometricTwap == "" {
+		return types.TickerPrice{}, fmt.Errorf("no twap for pool %s", pool.id)
+	}
+
+	volume, err := p.getLiquidity(pool.id, pool.base, p.cosmosDecimals(pair.Base))
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	return types.TickerPrice{
+		Price:  p.cosmosScalePrice(pair, strToDec(twap.GeometricTwap)),
+		Volume: volume,
+		Time:   time.Now(),
+	}, nil
+}
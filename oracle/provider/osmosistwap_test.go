@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOsmosisTwapProvider_Poll(t *testing.T) {
+	server := newTestServer(func(rw http.ResponseWriter, req *http.Request) {
+		var response string
+		switch req.URL.Path {
+		case "/osmosis/twap/v1beta1/GeometricTwapToNow":
+			response = `{"geometric_twap":"0.512345"}`
+		case "/osmosis/poolmanager/v1beta1/pools/1464/total_pool_liquidity":
+			response = `{"liquidity":[{"denom":"uosmo","amount":"1000000000000"}]}`
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = rw.Write([]byte(response))
+	})
+	defer server.Close()
+
+	osmoUsdc := types.CurrencyPair{Base: "OSMO", Quote: "USDC"}
+
+	p := newTestProvider(t, NewOsmosisTwapProvider, server, Endpoint{
+		Name:       ProviderOsmosisTwap,
+		Contracts:  map[string]string{"OSMO": "uosmo", "USDC": "ibc/498A0751C798A0D9A389AA3691123DADA57DAA4FE165D5C75894505B876BA6E4", "OSMOUSDC": "1464"},
+		TwapWindow: time.Hour,
+	}, osmoUsdc)
+
+	require.NoError(t, p.Poll())
+	for _, req := range server.Requests() {
+		if req.URL.Path == "/osmosis/twap/v1beta1/GeometricTwapToNow" {
+			query := req.URL.Query()
+			require.Equal(t, "1464", query.Get("pool_id"))
+			require.Equal(t, "uosmo", query.Get("base_asset"))
+			startTime, err := time.Parse(time.RFC3339, query.Get("start_time"))
+			require.NoError(t, err)
+			require.WithinDuration(t, time.Now().Add(-time.Hour), startTime, time.Minute)
+		}
+	}
+	require.Equal(t, sdk.MustNewDecFromStr("0.512345"), p.tickers["OSMOUSDC"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("1000000"), p.tickers["OSMOUSDC"].Volume)
+}
@@ -68,7 +68,7 @@ func NewOsmosisV2Provider(
 	provider.pools["STATOMATOM"] = "803"
 	provider.pools["STOSMOOSMO"] = "833"
 
-	go startPolling(provider, provider.endpoints.PollInterval, logger)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
 	return provider, nil
 }
 
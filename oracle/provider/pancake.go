@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	"github.com/rs/zerolog"
+)
+
+const (
+	// pancakeFactory defines the address of the PancakeSwap V3 factory on BNB
+	// Chain.
+	pancakeFactory = "0x0bfbcf9fa4f9c56b0f40a671ad40e0805a091865"
+)
+
+var (
+	_                       Provider = (*PancakeProvider)(nil)
+	pancakeDefaultEndpoints          = Endpoint{
+		Name:         ProviderPancake,
+		Urls:         []string{"https://bsc-dataseed.bnbchain.org"},
+		PollInterval: 30 * time.Second,
+	}
+
+	// pancakeFeeTiers defines the fee tiers the pools are searched in.
+	pancakeFeeTiers = []int64{100, 500, 2500, 10000}
+)
+
+type (
+	// PancakeProvider defines an oracle provider reading the TWAPs of
+	// PancakeSwap V3 pools on BNB Chain. PancakeSwap V3 is a fork of Uniswap V3
+	// with different fee tiers, so the pools are read like the ones of the
+	// UniswapV3Provider.
+	//
+	// REF: https://developer.pancakeswap.finance/contracts/v3/addresses
+	PancakeProvider struct {
+		UniswapV3Provider
+	}
+)
+
+func NewPancakeProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*PancakeProvider, error) {
+	provider := &PancakeProvider{
+		UniswapV3Provider{
+			factory:  pancakeFactory,
+			feeTiers: pancakeFeeTiers,
+			decimals: newEvmDecimalsCache(),
+		},
+	}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		nil,
+		nil,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+)
+
+var (
+	_                           Provider = (*PersistenceProvider)(nil)
+	persistenceDefaultEndpoints          = Endpoint{
+		Name:         ProviderPersistence,
+		Urls:         []string{"https://rest.cosmos.directory/persistence"},
+		PollInterval: 30 * time.Second,
+	}
+)
+
+type (
+	// PersistenceProvider defines an oracle provider using the exchange rates
+	// of the pSTAKE liquidstakeibc host chains on the Persistence chain. The
+	// c value of a host chain is the amount of stkTokens per underlying, so
+	// its inverse is reported as the price of the stkToken quoted in its
+	// underlying, ex.: STKATOM/ATOM, which the USD conversion multiplies with
+	// the aggregated price of the underlying. Inactive host chains are
+	// skipped.
+	//
+	// When a Dexter pool is set for the pair in the `contracts` of the
+	// provider endpoints, ex.: "STKATOMATOM", along with the denoms of the
+	// stkToken and its underlying, its spot price is used as a sanity check
+	// and the exchange rate is rejected if it deviates by more than the
+	// `max_deviation` of the provider endpoints, which defaults to 5%.
+	//
+	// REF: https://github.com/persistenceOne/pstake-native/tree/main/x/liquidstakeibc
+	PersistenceProvider struct {
+		DexterProvider
+	}
+
+	PersistenceHostChainsResponse struct {
+		HostChains []PersistenceHostChain `json:"host_chains"`
+	}
+
+	PersistenceHostChain struct {
+		ChainId   string `json:"chain_id"`   // ex.: "cosmoshub-4"
+		HostDenom string `json:"host_denom"` // ex.: "uatom"
+		CValue    string `json:"c_value"`    // ex.: "0.891165247364197441"
+		Active    bool   `json:"active"`
+	}
+)
+
+func NewPersistenceProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*PersistenceProvider, error) {
+	provider := &PersistenceProvider{}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		nil,
+		nil,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
+
+func (p *PersistenceProvider) Poll() error {
+	content, err := p.httpGet("/pstake/liquidstakeibc/v1beta1/host_chains")
+	if err != nil {
+		return err
+	}
+
+	var hostChains PersistenceHostChainsResponse
+	err = json.Unmarshal(content, &hostChains)
+	if err != nil {
+		return err
+	}
+
+	timestamp := time.Now()
+	tickers := map[string]types.TickerPrice{}
+
+	for _, hostChain := range hostChains.HostChains {
+		denom := strideTranslateHostDenom(hostChain.HostDenom)
+		symbol := "STK" + denom + denom
+		if _, ok := p.pairs[symbol]; !ok {
+			continue
+		}
+
+		if !hostChain.Active {
+			p.logger.Warn().
+				Str("chain_id", hostChain.ChainId).
+				Msg("host chain is inactive")
+			continue
+		}
+
+		cValue, err := sdk.NewDecFromStr(hostChain.CValue)
+		if err != nil || !cValue.IsPositive() {
+			p.logger.Error().
+				Str("chain_id", hostChain.ChainId).
+				Str("c_value", hostChain.CValue).
+				Msg("invalid c value")
+			continue
+		}
+
+		rate := sdk.OneDec().Quo(cValue)
+		if !isRedemptionRateValid(rate) {
+			p.logger.Warn().
+				Str("chain_id", hostChain.ChainId).
+				Str("rate", rate.String()).
+				Msg("redemption rate out of bounds")
+			continue
+		}
+
+		if _, ok := p.endpoints.Contracts[symbol]; ok {
+			spot, err := p.getTicker(p.pairs[symbol])
+			if err != nil {
+				p.logger.Warn().
+					Err(err).
+					Str("chain_id", hostChain.ChainId).
+					Msg("failed to query spot price")
+				continue
+			}
+			err = checkRedemptionRateDeviation(p.endpoints, rate, spot.Price, pstakeDefaultMaxDeviation)
+			if err != nil {
+				p.logger.Warn().
+					Err(err).
+					Str("chain_id", hostChain.ChainId).
+					Msg("redemption rate rejected")
+				continue
+			}
+		}
+
+		tickers[symbol] = types.TickerPrice{
+			Price:  rate,
+			Volume: sdk.OneDec(),
+			Time:   timestamp,
+		}
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	for symbol, ticker := range tickers {
+		p.tickers[symbol] = ticker
+	}
+
+	p.logger.Debug().Msg("updated tickers")
+	return nil
+}
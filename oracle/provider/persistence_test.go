@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPersistenceProvider_Poll(t *testing.T) {
+	const (
+		pool    = "persistence1pool"
+		stkatom = "stk/uatom"
+		atom    = "ibc/C8A74ABBE2AF892E15680D916A7C22130585CE5704F9B17A10F184A90D53BECA"
+	)
+
+	amountOut := "1200000"
+	server := newTestServer(func(rw http.ResponseWriter, req *http.Request) {
+		if !strings.HasPrefix(req.URL.Path, "/cosmwasm/") {
+			_, _ = rw.Write([]byte(`{"host_chains":[
+				{"chain_id":"cosmoshub-4","host_denom":"uatom","c_value":"0.8","active":true},
+				{"chain_id":"osmosis-1","host_denom":"uosmo","c_value":"0.9","active":false},
+				{"chain_id":"dydx-mainnet-1","host_denom":"adydx","c_value":"1.1","active":true}
+			]}`))
+			return
+		}
+
+		query, err := decodeWasmTestQuery(req)
+		if err != nil {
+			rw.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		var response interface{}
+		switch {
+		case query.query["config"] != nil:
+			response = DexterConfigResponse{Assets: []CosmwasmAsset{
+				{Info: newCosmwasmAssetInfo(atom), Amount: "1200000000000"},
+				{Info: newCosmwasmAssetInfo(stkatom), Amount: "1000000000000"},
+			}}
+		case query.query["on_swap"] != nil:
+			response = DexterSwapResponse{
+				TradeParams: DexterTradeParams{AmountIn: "1000000", AmountOut: amountOut},
+			}
+		}
+		data, _ := json.Marshal(response)
+		bz, _ := json.Marshal(CosmwasmQueryResponse{Data: data})
+		_, _ = rw.Write(bz)
+	})
+	defer server.Close()
+
+	stkatomAtom := types.CurrencyPair{Base: "STKATOM", Quote: "ATOM"}
+	stkosmo := types.CurrencyPair{Base: "STKOSMO", Quote: "OSMO"}
+	stkdydx := types.CurrencyPair{Base: "STKDYDX", Quote: "DYDX"}
+
+	p := newTestProvider(t, NewPersistenceProvider, server, Endpoint{
+		Name: ProviderPersistence,
+	}, stkatomAtom, stkosmo, stkdydx)
+
+	require.NoError(t, p.Poll())
+	for _, req := range server.Requests() {
+		require.Equal(t, "/pstake/liquidstakeibc/v1beta1/host_chains", req.URL.Path)
+	}
+	require.Len(t, p.tickers, 1)
+	require.Equal(t, sdk.MustNewDecFromStr("1.25"), p.tickers["STKATOMATOM"].Price)
+
+	newProvider := func(maxDeviation sdk.Dec) *PersistenceProvider {
+		return newTestProvider(t, NewPersistenceProvider, server, Endpoint{
+			Name:         ProviderPersistence,
+			Contracts:    map[string]string{"STKATOM": stkatom, "ATOM": atom, "STKATOMATOM": pool},
+			MaxDeviation: maxDeviation,
+		}, stkatomAtom)
+	}
+
+	// the spot price of 1.2 ATOM is within the default max deviation
+	p = newProvider(sdk.Dec{})
+	require.NoError(t, p.Poll())
+	require.Equal(t, sdk.MustNewDecFromStr("1.25"), p.tickers["STKATOMATOM"].Price)
+
+	// the spot price of 1.1 ATOM deviates by more than 13%
+	amountOut = "1100000"
+	p = newProvider(sdk.Dec{})
+	require.NoError(t, p.Poll())
+	require.Empty(t, p.tickers)
+
+	p = newProvider(sdk.MustNewDecFromStr("0.15"))
+	require.NoError(t, p.Poll())
+	require.Equal(t, sdk.MustNewDecFromStr("1.25"), p.tickers["STKATOMATOM"].Price)
+
+	// a spot price of zero never passes the check
+	amountOut = "0"
+	p = newProvider(sdk.MustNewDecFromStr("1000"))
+	require.NoError(t, p.Poll())
+	require.Empty(t, p.tickers)
+}
@@ -3,14 +3,20 @@ package provider
 import (
 	"context"
 	"encoding/json"
-	"math"
 	"time"
 
 	"price-feeder/oracle/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/rs/zerolog"
 )
 
+const (
+	// phemexDefaultScale defines the scale of the prices and values of
+	// products and currencies without a scale listed.
+	phemexDefaultScale = 8
+)
+
 var (
 	_                      Provider = (*PhemexProvider)(nil)
 	phemexDefaultEndpoints          = Endpoint{
@@ -22,13 +28,14 @@ var (
 
 type (
 	// PhemexProvider defines an oracle provider implemented by the Phemex
-	// public API.
+	// public API. Prices and volumes are encoded as integers, scaled by the
+	// price scale of the product and the value scale of the base currency.
 	//
 	// REF: https://phemex-docs.github.io
 	PhemexProvider struct {
 		provider
-		priceScales map[string]float64
-		valueScales map[string]float64
+		priceScales map[string]int64
+		valueScales map[string]int64
 	}
 
 	PhemexTickerResponse struct {
@@ -37,8 +44,8 @@ type (
 
 	PhemexTicker struct {
 		Symbol string `json:"symbol"`    // ex.: "sBTCUSDT"
-		Price  uint64 `json:"lastEp"`    // ex.: 2323102000000
-		Volume uint64 `json:"volumeEv"`  // ex.: 450522008300
+		Price  int64  `json:"lastEp"`    // ex.: 2323102000000
+		Volume int64  `json:"volumeEv"`  // ex.: 450522008300
 		Time   int64  `json:"timestamp"` // ex.: 1675843104642440505
 	}
 
@@ -80,8 +87,8 @@ func NewPhemexProvider(
 		nil,
 	)
 
-	provider.priceScales = map[string]float64{}
-	provider.valueScales = map[string]float64{}
+	provider.priceScales = map[string]int64{}
+	provider.valueScales = map[string]int64{}
 
 	content, err := provider.httpGet("/public/products")
 	if err != nil {
@@ -104,7 +111,7 @@ func NewPhemexProvider(
 		if !ok {
 			continue
 		}
-		provider.valueScales[currency.Denom] = float64(currency.ValueScale)
+		provider.valueScales[currency.Denom] = currency.ValueScale
 	}
 
 	for _, product := range info.Data.Products {
@@ -113,13 +120,13 @@ func NewPhemexProvider(
 		if !ok {
 			continue
 		}
-		provider.priceScales[symbol] = float64(product.PriceScale)
+		provider.priceScales[symbol] = product.PriceScale
 	}
 
 	// rate limit 100req/min ~1.66req/s
 	interval := time.Duration(len(pairs)*1700+2000) * time.Millisecond
 
-	go startPolling(provider, interval, logger)
+	go startPolling(ctx, provider, interval, logger)
 	return provider, nil
 }
 
@@ -132,7 +139,8 @@ func (p *PhemexProvider) Poll() error {
 			if err != nil {
 				p.logger.Error().
 					Str("symbol", symbol).
-					Err(err)
+					Err(err).
+					Msg("failed to get ticker")
 				return
 			}
 
@@ -141,41 +149,39 @@ func (p *PhemexProvider) Poll() error {
 			if err != nil {
 				p.logger.Error().
 					Str("symbol", symbol).
-					Err(err)
-				return
-			}
-
-			priceScale, ok := p.priceScales[symbol]
-			if !ok {
-				p.logger.Error().
-					Str("symbol", symbol).
-					Msg("no price scale")
-				return
-			}
-
-			valueScale, ok := p.valueScales[pair.Base]
-			if !ok {
-				p.logger.Error().
-					Str("denom", pair.Base).
-					Msg("no value scale")
+					Err(err).
+					Msg("failed to unmarshal ticker")
 				return
 			}
 
-			price := float64(ticker.Result.Price) / math.Pow(10, valueScale)
-			volume := float64(ticker.Result.Volume) / math.Pow(10, priceScale)
-
 			p.mtx.Lock()
 			defer p.mtx.Unlock()
 
-			p.tickers[pair.String()] = types.TickerPrice{
-				Price:  floatToDec(price),
-				Volume: floatToDec(volume),
-				Time:   time.UnixMicro(int64(ticker.Result.Time)),
-			}
-
+			p.tickers[symbol] = p.toTickerPrice(pair, ticker.Result)
 		}(p, pair)
 	}
 
 	p.logger.Debug().Msg("updated tickers")
 	return nil
 }
+
+// toTickerPrice decodes the scaled integers of a ticker, ex.: a lastEp of
+// 2323102000000 with a price scale of 8 is a price of 23231.02.
+func (p *PhemexProvider) toTickerPrice(pair types.CurrencyPair, ticker PhemexTicker) types.TickerPrice {
+	priceScale, ok := p.priceScales[pair.String()]
+	if !ok {
+		priceScale = phemexDefaultScale
+	}
+
+	valueScale, ok := p.valueScales[pair.Base]
+	if !ok {
+		valueScale = phemexDefaultScale
+	}
+
+	return types.TickerPrice{
+		Price:  sdk.NewDecWithPrec(ticker.Price, priceScale),
+		Volume: sdk.NewDecWithPrec(ticker.Volume, valueScale),
+		// the timestamp is in nanoseconds
+		Time: time.Unix(0, ticker.Time),
+	}
+}
@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"testing"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPhemexProvider_ToTickerPrice(t *testing.T) {
+	btcUsdt := types.CurrencyPair{Base: "BTC", Quote: "USDT"}
+	atomUsdt := types.CurrencyPair{Base: "ATOM", Quote: "USDT"}
+
+	p := &PhemexProvider{
+		priceScales: map[string]int64{"BTCUSDT": 8},
+		valueScales: map[string]int64{"BTC": 8},
+	}
+
+	ticker := p.toTickerPrice(btcUsdt, PhemexTicker{
+		Symbol: "sBTCUSDT",
+		Price:  2323102000000,
+		Volume: 450522008300,
+		Time:   1675843104642440505,
+	})
+	require.Equal(t, sdk.MustNewDecFromStr("23231.02"), ticker.Price)
+	require.Equal(t, sdk.MustNewDecFromStr("4505.220083"), ticker.Volume)
+	require.Equal(t, int64(1675843104642), ticker.Time.UnixMilli())
+
+	// products without a listed scale default to 10^8
+	ticker = p.toTickerPrice(atomUsdt, PhemexTicker{
+		Symbol: "sATOMUSDT",
+		Price:  1361000000,
+		Volume: 10000000000,
+	})
+	require.Equal(t, sdk.MustNewDecFromStr("13.61"), ticker.Price)
+	require.Equal(t, sdk.MustNewDecFromStr("100"), ticker.Volume)
+}
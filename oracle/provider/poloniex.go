@@ -3,36 +3,60 @@ package provider
 import (
 	"context"
 	"encoding/json"
+	"strings"
 	"time"
 
 	"price-feeder/oracle/types"
 
+	"github.com/gorilla/websocket"
 	"github.com/rs/zerolog"
 )
 
 var (
 	_                        Provider = (*PoloniexProvider)(nil)
 	poloniexDefaultEndpoints          = Endpoint{
-		Name:         ProviderPoloniex,
-		Urls:         []string{"https://api.poloniex.com"},
-		PollInterval: 2 * time.Second,
+		Name:          ProviderPoloniex,
+		Urls:          []string{"https://api.poloniex.com"},
+		Websocket:     "ws.poloniex.com",
+		WebsocketPath: "/ws/public",
+		// Poloniex closes connections without any pings for 30 seconds
+		PingDuration: 20 * time.Second,
+		PingType:     websocket.TextMessage,
+		PingMessage:  `{"event":"ping"}`,
 	}
 )
 
 type (
 	// PoloniexProvider defines an oracle provider implemented by the Poloniex
-	// public API.
+	// public websocket API.
 	//
-	// REF: https://docs.poloniex.com
+	// REF: https://docs.poloniex.com/#public-channels-market-data-ticker
 	PoloniexProvider struct {
 		provider
 	}
 
+	PoloniexSubscriptionMsg struct {
+		Event   string   `json:"event"`   // ex.: "subscribe"
+		Channel []string `json:"channel"` // ex.: ["ticker"]
+		Symbols []string `json:"symbols"` // ex.: ["BTC_USDT"]
+	}
+
+	// PoloniexTickerMsg defines a ticker update, subscription ack, pong or
+	// error, ex.:
+	// {"event":"subscribe","channel":"ticker","symbols":["BTC_USDT"]}
+	// {"event":"error","message":"Subscription failed"}
+	PoloniexTickerMsg struct {
+		Event   string           `json:"event"`
+		Message string           `json:"message"`
+		Channel string           `json:"channel"` // ex.: "ticker"
+		Data    []PoloniexTicker `json:"data"`
+	}
+
 	PoloniexTicker struct {
-		Symbol string `json:"symbol"`    // ec.: "BTC_USDT"
-		Price  string `json:"close"`     // ex.: "23114.84"
-		Volume string `json:"quantity"`  // ex.: "118.065209"
-		Time   int64  `json:"closeTime"` // ex.: 1675862101027
+		Symbol string `json:"symbol"`   // ec.: "BTC_USDT"
+		Price  string `json:"close"`    // ex.: "23114.84"
+		Volume string `json:"quantity"` // ex.: "118.065209"
+		Time   int64  `json:"ts"`       // ex.: 1675862101027
 	}
 )
 
@@ -48,37 +72,48 @@ func NewPoloniexProvider(
 		endpoints,
 		logger,
 		pairs,
-		nil,
-		nil,
+		provider.messageReceived,
+		provider.getSubscriptionMsgs,
 	)
-	go startPolling(provider, provider.endpoints.PollInterval, logger)
 	return provider, nil
 }
 
-func (p *PoloniexProvider) Poll() error {
-	symbols := make(map[string]string, len(p.pairs))
-	for _, pair := range p.pairs {
-		symbols[pair.Join("_")] = pair.String()
+func (p *PoloniexProvider) getSubscriptionMsgs(pairs ...types.CurrencyPair) []interface{} {
+	symbols := make([]string, len(pairs))
+	for i, pair := range pairs {
+		symbols[i] = p.CurrencyPairToProviderPair(pair)
 	}
+	return []interface{}{
+		PoloniexSubscriptionMsg{
+			Event:   "subscribe",
+			Channel: []string{"ticker"},
+			Symbols: symbols,
+		},
+	}
+}
 
-	content, err := p.httpGet("/markets/ticker24h")
+func (p *PoloniexProvider) messageReceived(messageType int, bz []byte) {
+	var tickerMsg PoloniexTickerMsg
+	err := json.Unmarshal(bz, &tickerMsg)
 	if err != nil {
-		return err
+		p.logger.Error().Err(err).Msg("failed to unmarshal message")
+		return
 	}
 
-	var tickers []PoloniexTicker
-	err = json.Unmarshal(content, &tickers)
-	if err != nil {
-		return err
+	// only the ticker updates don't have an event
+	if tickerMsg.Event != "" {
+		if tickerMsg.Event == "error" {
+			p.logger.Error().Str("msg", tickerMsg.Message).Msg("received error message")
+		}
+		return
 	}
 
 	p.mtx.Lock()
 	defer p.mtx.Unlock()
 
-	for _, ticker := range tickers {
-
-		symbol, ok := symbols[ticker.Symbol]
-		if !ok {
+	for _, ticker := range tickerMsg.Data {
+		symbol := p.ProviderPairToCurrencyPair(ticker.Symbol).String()
+		if _, ok := p.pairs[symbol]; !ok {
 			continue
 		}
 
@@ -88,6 +123,20 @@ func (p *PoloniexProvider) Poll() error {
 			Time:   time.UnixMilli(ticker.Time),
 		}
 	}
-	p.logger.Debug().Msg("updated tickers")
-	return nil
+}
+
+func (p *PoloniexProvider) CurrencyPairToProviderPair(pair types.CurrencyPair) string {
+	return pair.Join("_")
+}
+
+func (p *PoloniexProvider) ProviderPairToCurrencyPair(pair string) types.CurrencyPair {
+	tokens := strings.Split(pair, "_")
+	if len(tokens) != 2 {
+		p.logger.Warn().Str("pair", pair).Msg("failed to convert to currency pair")
+		return types.CurrencyPair{}
+	}
+	return types.CurrencyPair{
+		Base:  tokens[0],
+		Quote: tokens[1],
+	}
 }
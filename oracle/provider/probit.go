@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	"github.com/rs/zerolog"
+)
+
+var (
+	_                      Provider = (*ProbitProvider)(nil)
+	probitDefaultEndpoints          = Endpoint{
+		Name:         ProviderProbit,
+		Urls:         []string{"https://api.probit.com"},
+		PollInterval: 3 * time.Second,
+	}
+)
+
+type (
+	// ProbitProvider defines an oracle provider implemented by the ProBit
+	// public API. The poll interval can be changed using the `poll_interval`
+	// of the provider endpoints.
+	//
+	// REF: https://docs-en.probit.com/reference/ticker
+	ProbitProvider struct {
+		provider
+	}
+
+	ProbitTickersResponse struct {
+		Data []ProbitTicker `json:"data"`
+	}
+
+	ProbitTicker struct {
+		MarketId string `json:"market_id"`   // ex.: "ATOM-USDT"
+		Price    string `json:"last"`        // ex.: "13.61"
+		Volume   string `json:"base_volume"` // ex.: "433812.95"
+		Time     string `json:"time"`        // ex.: "2023-03-01T13:14:36.000Z"
+	}
+)
+
+func NewProbitProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*ProbitProvider, error) {
+	provider := &ProbitProvider{}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		nil,
+		nil,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
+
+func (p *ProbitProvider) Poll() error {
+	marketIds := make([]string, 0, len(p.pairs))
+	for _, pair := range p.pairs {
+		marketIds = append(marketIds, p.CurrencyPairToProviderPair(pair))
+	}
+
+	content, err := p.httpGet("/api/exchange/v1/ticker?market_ids=" + strings.Join(marketIds, ","))
+	if err != nil {
+		return err
+	}
+
+	var tickers ProbitTickersResponse
+	err = json.Unmarshal(content, &tickers)
+	if err != nil {
+		return err
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	for _, ticker := range tickers.Data {
+		symbol := strings.ReplaceAll(ticker.MarketId, "-", "")
+		if _, ok := p.pairs[symbol]; !ok {
+			continue
+		}
+
+		timestamp, err := time.Parse(time.RFC3339, ticker.Time)
+		if err != nil {
+			p.logger.Error().Err(err).Msg("failed parsing timestamp")
+			continue
+		}
+
+		p.tickers[symbol] = types.TickerPrice{
+			Price:  strToDec(ticker.Price),
+			Volume: strToDec(ticker.Volume),
+			Time:   timestamp,
+		}
+	}
+
+	p.logger.Debug().Msg("updated tickers")
+	return nil
+}
+
+func (p *ProbitProvider) CurrencyPairToProviderPair(pair types.CurrencyPair) string {
+	return pair.Join("-")
+}
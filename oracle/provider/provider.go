@@ -1,11 +1,15 @@
 package provider
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io/ioutil"
+	"math"
+	"math/big"
 	"net/http"
 	"net/url"
+	"regexp"
 	"strconv"
 	"strings"
 	"sync"
@@ -22,35 +26,111 @@ const (
 	staleTickersCutoff   = 1 * time.Minute
 	providerCandlePeriod = 10 * time.Minute
 
-	ProviderFin       Name = "fin"
-	ProviderFinUsk    Name = "finusk"
-	ProviderKraken    Name = "kraken"
-	ProviderBinance   Name = "binance"
-	ProviderBinanceUS Name = "binanceus"
-	ProviderOsmosis   Name = "osmosis"
-	ProviderOsmosisV2 Name = "osmosisv2"
-	ProviderHuobi     Name = "huobi"
-	ProviderOkx       Name = "okx"
-	ProviderGate      Name = "gate"
-	ProviderCoinbase  Name = "coinbase"
-	ProviderBitget    Name = "bitget"
-	ProviderBitmart   Name = "bitmart"
-	ProviderBkex      Name = "bkex"
-	ProviderBitfinex  Name = "bitfinex"
-	ProviderBitforex  Name = "bitforex"
-	ProviderHitBtc    Name = "hitbtc"
-	ProviderPoloniex  Name = "poloniex"
-	ProviderPhemex    Name = "phemex"
-	ProviderLbank     Name = "lbank"
-	ProviderKucoin    Name = "kucoin"
-	ProviderBybit     Name = "bybit"
-	ProviderMexc      Name = "mexc"
-	ProviderCrypto    Name = "crypto"
-	ProviderCurve     Name = "curve"
-	ProviderMock      Name = "mock"
-	ProviderStride    Name = "stride"
-	ProviderXt        Name = "xt"
-	ProviderZero      Name = "zero"
+	ProviderFin               Name = "fin"
+	ProviderFinUsk            Name = "finusk"
+	ProviderKraken            Name = "kraken"
+	ProviderBinance           Name = "binance"
+	ProviderBinanceUS         Name = "binanceus"
+	ProviderOsmosis           Name = "osmosis"
+	ProviderOsmosisV2         Name = "osmosisv2"
+	ProviderHuobi             Name = "huobi"
+	ProviderOkx               Name = "okx"
+	ProviderGate              Name = "gate"
+	ProviderCoinbase          Name = "coinbase"
+	ProviderBitget            Name = "bitget"
+	ProviderBitmart           Name = "bitmart"
+	ProviderBkex              Name = "bkex"
+	ProviderBitfinex          Name = "bitfinex"
+	ProviderBitforex          Name = "bitforex"
+	ProviderHitBtc            Name = "hitbtc"
+	ProviderPoloniex          Name = "poloniex"
+	ProviderPhemex            Name = "phemex"
+	ProviderLbank             Name = "lbank"
+	ProviderKucoin            Name = "kucoin"
+	ProviderBybit             Name = "bybit"
+	ProviderMexc              Name = "mexc"
+	ProviderCrypto            Name = "crypto"
+	ProviderCurve             Name = "curve"
+	ProviderMock              Name = "mock"
+	ProviderStride            Name = "stride"
+	ProviderXt                Name = "xt"
+	ProviderZero              Name = "zero"
+	ProviderBinanceFutures    Name = "binancefutures"
+	ProviderDeribit           Name = "deribit"
+	ProviderWhitebit          Name = "whitebit"
+	ProviderProbit            Name = "probit"
+	ProviderBtse              Name = "btse"
+	ProviderAscendex          Name = "ascendex"
+	ProviderBitflyer          Name = "bitflyer"
+	ProviderGemini            Name = "gemini"
+	ProviderBithumb           Name = "bithumb"
+	ProviderUpbit             Name = "upbit"
+	ProviderFx                Name = "fx"
+	ProviderBitstamp          Name = "bitstamp"
+	ProviderOkxIndex          Name = "okxindex"
+	ProviderKrakenFutures     Name = "krakenfutures"
+	ProviderCfBenchmarks      Name = "cfbenchmarks"
+	ProviderHyperliquid       Name = "hyperliquid"
+	ProviderBackpack          Name = "backpack"
+	ProviderUniswapV3         Name = "uniswapv3"
+	ProviderUniswapV2         Name = "uniswapv2"
+	ProviderCurvePools        Name = "curvepools"
+	ProviderBalancer          Name = "balancer"
+	ProviderPancake           Name = "pancake"
+	ProviderTraderJoe         Name = "traderjoe"
+	ProviderVelodrome         Name = "velodrome"
+	ProviderAerodrome         Name = "aerodrome"
+	ProviderGmx               Name = "gmx"
+	ProviderCamelot           Name = "camelot"
+	ProviderSushi             Name = "sushi"
+	ProviderRaydium           Name = "raydium"
+	ProviderOrca              Name = "orca"
+	ProviderJupiter           Name = "jupiter"
+	ProviderDrift             Name = "drift"
+	ProviderOsmosisRPC        Name = "osmosisrpc"
+	ProviderOsmosisTwap       Name = "osmosistwap"
+	ProviderAstroport         Name = "astroport"
+	ProviderFinRPC            Name = "finrpc"
+	ProviderWhiteWhale        Name = "whitewhale"
+	ProviderAstrovault        Name = "astrovault"
+	ProviderDexter            Name = "dexter"
+	ProviderDemex             Name = "demex"
+	ProviderShade             Name = "shade"
+	ProviderOraidex           Name = "oraidex"
+	ProviderThorchain         Name = "thorchain"
+	ProviderInjective         Name = "injective"
+	ProviderDydx              Name = "dydx"
+	ProviderLevana            Name = "levana"
+	ProviderCrescent          Name = "crescent"
+	ProviderTerraswap         Name = "terraswap"
+	ProviderCosmwasmPool      Name = "cosmwasm"
+	ProviderEvmCall           Name = "evmcall"
+	ProviderRestJSON          Name = "restjson"
+	ProviderGraphQL           Name = "graphql"
+	ProviderCoinGecko         Name = "coingecko"
+	ProviderCoinMarketCap     Name = "coinmarketcap"
+	ProviderCryptoCompare     Name = "cryptocompare"
+	ProviderKaiko             Name = "kaiko"
+	ProviderPyth              Name = "pyth"
+	ProviderChainlink         Name = "chainlink"
+	ProviderBand              Name = "band"
+	ProviderRedstone          Name = "redstone"
+	ProviderDia               Name = "dia"
+	ProviderEcb               Name = "ecb"
+	ProviderOpenExchangeRates Name = "openexchangerates"
+	ProviderFixer             Name = "fixer"
+	ProviderAlphaVantage      Name = "alphavantage"
+	ProviderSwissquote        Name = "swissquote"
+	ProviderTreasury          Name = "treasury"
+	ProviderLido              Name = "lido"
+	ProviderPersistence       Name = "persistence"
+	ProviderMilkyway          Name = "milkyway"
+	ProviderPstake            Name = "pstake"
+	ProviderFrankfurter       Name = "frankfurter"
+
+	RoleVote          Role = "vote"
+	RoleReferenceOnly Role = "referenceOnly"
+	RoleFallback      Role = "fallback"
 )
 
 type (
@@ -75,6 +155,13 @@ type (
 		pairs     map[string]types.CurrencyPair
 		tickers   map[string]types.TickerPrice
 		websocket *WebsocketController
+		// websocketURLHandler optionally resolves the websocket url on every
+		// connect, ex.: for providers requiring a token, and must be set
+		// before calling Init.
+		websocketURLHandler URLHandler
+		// httpHeaders are added to every http request, ex.: to authenticate
+		// using the api key of the endpoint.
+		httpHeaders http.Header
 	}
 
 	PollingProvider interface {
@@ -86,6 +173,11 @@ type (
 	// examples.: "binance", "osmosis", "kraken".
 	Name string
 
+	// Role defines whether the prices of a provider are voted, only
+	// collected for monitoring, ex.: a canary or a low trust source, or only
+	// voted for bases no other provider prices, ex.: an aggregator.
+	Role string
+
 	// AggregatedProviderPrices defines a type alias for a map
 	// of provider -> asset -> TickerPrice
 	AggregatedProviderPrices map[Name]map[string]types.TickerPrice
@@ -101,6 +193,43 @@ type (
 		PingDuration  time.Duration
 		PingType      uint
 		PingMessage   string
+		// DepthBand enables orderbook providers to report the depth within
+		// the band around the mid price as volume instead of the 24h volume.
+		DepthBand sdk.Dec // ex. 0.02
+		// SymbolsTTL defines how long providers cache the symbols discovered
+		// from their listing endpoint before refreshing them.
+		SymbolsTTL time.Duration
+		Role       Role // ex. "referenceOnly"
+		// ApiKey authenticates providers requiring credentials, ex.: paid
+		// data vendors.
+		ApiKey string
+		// Contracts maps the denoms or pairs of on-chain providers to their
+		// contract addresses, ex.: {"USDC": "0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48"}.
+		Contracts map[string]string
+		// Decimals defines the decimals of the tokens of on-chain providers by
+		// their denom, ex.: {"USDC": 6}. Tokens without configured decimals
+		// are queried on-chain.
+		Decimals map[string]int64
+		// TwapWindow defines the window of providers reporting time weighted
+		// average prices.
+		TwapWindow time.Duration // ex. 30m
+		// MaxAge defines the age after which providers reading on-chain
+		// oracles consider their updates stale, ex.: the heartbeat of a feed.
+		MaxAge time.Duration // ex. 25h
+		// MaxDeviation defines the relative deviation from the spot price
+		// after which providers reading exchange rates reject them.
+		MaxDeviation sdk.Dec // ex. 0.05
+		// Pools lists the pair contracts of the CosmwasmPoolProvider, which
+		// may be deployed on different chains.
+		Pools []CosmwasmPool
+		// Calls lists the contract calls of the EvmCallProvider, which may be
+		// executed on different chains.
+		Calls []EvmCall
+		// Tickers lists the REST tickers of the RestJSONProvider.
+		Tickers []RestTicker
+
+		// Queries lists the GraphQL queries of the GraphQLProvider.
+		Queries []GraphQLQuery
 	}
 )
 
@@ -122,7 +251,9 @@ func (p *provider) Init(
 	}
 	p.tickers = make(map[string]types.TickerPrice, len(pairs))
 	p.http = newDefaultHTTPClient()
-	p.httpBase = p.endpoints.Urls[0]
+	if len(p.endpoints.Urls) > 0 {
+		p.httpBase = p.endpoints.Urls[0]
+	}
 	if p.endpoints.Websocket != "" {
 		websocketUrl := url.URL{
 			Scheme: "wss",
@@ -136,6 +267,7 @@ func (p *provider) Init(
 			pairs,
 			websocketMessageHandler,
 			websocketSubscribeHandler,
+			p.websocketURLHandler,
 			p.endpoints.PingDuration,
 			p.endpoints.PingType,
 			p.endpoints.PingMessage,
@@ -161,7 +293,9 @@ func (p *provider) GetTickerPrices(pairs ...types.CurrencyPair) (map[string]type
 					Msg("ticker price is '0'")
 				continue
 			}
-			if time.Since(price.Time) > staleTickersCutoff {
+			// tickers carried while the market is closed keep their
+			// original time and are aged out by their provider instead
+			if !price.Stale && time.Since(price.Time) > staleTickersCutoff {
 				p.logger.Warn().Str("pair", symbol).Time("time", price.Time).Msg("tickers data is stale")
 			} else {
 				tickers[symbol] = price
@@ -231,13 +365,42 @@ func (p *provider) httpGet(path string) ([]byte, error) {
 }
 
 func (p *provider) makeHttpRequest(url string) ([]byte, error) {
-	res, err := p.http.Get(url)
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	for key, values := range p.httpHeaders {
+		req.Header[key] = values
+	}
+	res, err := p.http.Do(req)
+	if err != nil {
+		p.logger.Warn().
+			Err(err).
+			Msg("http request failed")
+		return nil, err
+	}
+	return p.readHttpResponse(url, res)
+}
+
+// httpPost sends a JSON body to the path of the selected http endpoint.
+func (p *provider) httpPost(path string, body []byte) ([]byte, error) {
+	return p.makeHttpPost(p.httpBase+path, body)
+}
+
+// makeHttpPost sends a JSON body to the url.
+func (p *provider) makeHttpPost(url string, body []byte) ([]byte, error) {
+	res, err := p.http.Post(url, "application/json", bytes.NewReader(body))
 	if err != nil {
 		p.logger.Warn().
 			Err(err).
 			Msg("http request failed")
 		return nil, err
 	}
+	return p.readHttpResponse(url, res)
+}
+
+func (p *provider) readHttpResponse(url string, res *http.Response) ([]byte, error) {
+	defer res.Body.Close()
 	if res.StatusCode != 200 {
 		p.logger.Warn().
 			Int("code", res.StatusCode).
@@ -260,56 +423,202 @@ func (p *provider) makeHttpRequest(url string) ([]byte, error) {
 func (e *Endpoint) SetDefaults() {
 	var defaults Endpoint
 	switch e.Name {
+	case ProviderAerodrome:
+		defaults = aerodromeDefaultEndpoints
+	case ProviderAlphaVantage:
+		defaults = alphaVantageDefaultEndpoints
+	case ProviderAscendex:
+		defaults = ascendexDefaultEndpoints
+	case ProviderAstroport:
+		defaults = astroportDefaultEndpoints
+	case ProviderAstrovault:
+		defaults = astrovaultDefaultEndpoints
+	case ProviderBackpack:
+		defaults = backpackDefaultEndpoints
+	case ProviderBalancer:
+		defaults = balancerDefaultEndpoints
+	case ProviderBand:
+		defaults = bandDefaultEndpoints
 	case ProviderBinance:
 		defaults = binanceDefaultEndpoints
+	case ProviderBinanceFutures:
+		defaults = binanceFuturesDefaultEndpoints
 	case ProviderBitfinex:
 		defaults = bitfinexDefaultEndpoints
 	case ProviderBinanceUS:
 		defaults = binanceUSDefaultEndpoints
+	case ProviderBitflyer:
+		defaults = bitflyerDefaultEndpoints
 	case ProviderBitget:
 		defaults = bitgetDefaultEndpoints
+	case ProviderBithumb:
+		defaults = bithumbDefaultEndpoints
 	case ProviderBitmart:
 		defaults = bitmartDefaultEndpoints
+	case ProviderBitstamp:
+		defaults = bitstampDefaultEndpoints
 	case ProviderBkex:
 		defaults = bkexDefaultEndpoints
+	case ProviderBtse:
+		defaults = btseDefaultEndpoints
 	case ProviderBybit:
 		defaults = bybitDefaultEndpoints
+	case ProviderCamelot:
+		defaults = camelotDefaultEndpoints
+	case ProviderCfBenchmarks:
+		defaults = cfBenchmarksDefaultEndpoints
+	case ProviderChainlink:
+		defaults = chainlinkDefaultEndpoints
 	case ProviderCoinbase:
 		defaults = coinbaseDefaultEndpoints
+	case ProviderCoinGecko:
+		defaults = coinGeckoDefaultEndpoints
+	case ProviderCoinMarketCap:
+		defaults = coinMarketCapDefaultEndpoints
+	case ProviderCosmwasmPool:
+		defaults = cosmwasmPoolDefaultEndpoints
+	case ProviderCrescent:
+		defaults = crescentDefaultEndpoints
 	case ProviderCrypto:
 		defaults = cryptoDefaultEndpoints
+	case ProviderCryptoCompare:
+		defaults = cryptoCompareDefaultEndpoints
 	case ProviderCurve:
 		defaults = curveDefaultEndpoints
+	case ProviderCurvePools:
+		defaults = curvePoolsDefaultEndpoints
+	case ProviderDemex:
+		defaults = demexDefaultEndpoints
+	case ProviderDeribit:
+		defaults = deribitDefaultEndpoints
+	case ProviderDexter:
+		defaults = dexterDefaultEndpoints
+	case ProviderDia:
+		defaults = diaDefaultEndpoints
+	case ProviderDrift:
+		defaults = driftDefaultEndpoints
+	case ProviderDydx:
+		defaults = dydxDefaultEndpoints
+	case ProviderEcb:
+		defaults = ecbDefaultEndpoints
+	case ProviderEvmCall:
+		defaults = evmCallDefaultEndpoints
 	case ProviderFin:
 		defaults = finDefaultEndpoints
+	case ProviderFinRPC:
+		defaults = finRPCDefaultEndpoints
 	case ProviderFinUsk:
 		defaults = finUskDefaultEndpoints
+	case ProviderFixer:
+		defaults = fixerDefaultEndpoints
+	case ProviderFrankfurter:
+		defaults = frankfurterDefaultEndpoints
+	case ProviderFx:
+		defaults = fxDefaultEndpoints
 	case ProviderGate:
 		defaults = gateDefaultEndpoints
+	case ProviderGemini:
+		defaults = geminiDefaultEndpoints
+	case ProviderGmx:
+		defaults = gmxDefaultEndpoints
+	case ProviderGraphQL:
+		defaults = graphQLDefaultEndpoints
 	case ProviderHitBtc:
 		defaults = hitbtcDefaultEndpoints
 	case ProviderHuobi:
 		defaults = huobiDefaultEndpoints
+	case ProviderHyperliquid:
+		defaults = hyperliquidDefaultEndpoints
+	case ProviderInjective:
+		defaults = injectiveDefaultEndpoints
+	case ProviderJupiter:
+		defaults = jupiterDefaultEndpoints
+	case ProviderKaiko:
+		defaults = kaikoDefaultEndpoints
 	case ProviderKraken:
 		defaults = krakenDefaultEndpoints
+	case ProviderKrakenFutures:
+		defaults = krakenFuturesDefaultEndpoints
 	case ProviderKucoin:
 		defaults = kucoinDefaultEndpoints
 	case ProviderLbank:
 		defaults = lbankDefaultEndpoints
+	case ProviderLevana:
+		defaults = levanaDefaultEndpoints
+	case ProviderLido:
+		defaults = lidoDefaultEndpoints
 	case ProviderMexc:
 		defaults = mexcDefaultEndpoints
+	case ProviderMilkyway:
+		defaults = milkywayDefaultEndpoints
 	case ProviderMock:
 		defaults = mockDefaultEndpoints
 	case ProviderOkx:
 		defaults = okxDefaultEndpoints
+	case ProviderOkxIndex:
+		defaults = okxIndexDefaultEndpoints
+	case ProviderOpenExchangeRates:
+		defaults = openExchangeRatesDefaultEndpoints
+	case ProviderOraidex:
+		defaults = oraidexDefaultEndpoints
+	case ProviderOrca:
+		defaults = orcaDefaultEndpoints
 	case ProviderOsmosis:
 		defaults = osmosisDefaultEndpoints
+	case ProviderOsmosisRPC:
+		defaults = osmosisRPCDefaultEndpoints
+	case ProviderOsmosisTwap:
+		defaults = osmosisTwapDefaultEndpoints
 	case ProviderOsmosisV2:
 		defaults = osmosisv2DefaultEndpoints
+	case ProviderPancake:
+		defaults = pancakeDefaultEndpoints
+	case ProviderPersistence:
+		defaults = persistenceDefaultEndpoints
 	case ProviderPhemex:
 		defaults = phemexDefaultEndpoints
 	case ProviderPoloniex:
 		defaults = poloniexDefaultEndpoints
+	case ProviderProbit:
+		defaults = probitDefaultEndpoints
+	case ProviderPstake:
+		defaults = pstakeDefaultEndpoints
+	case ProviderPyth:
+		defaults = pythDefaultEndpoints
+	case ProviderRaydium:
+		defaults = raydiumDefaultEndpoints
+	case ProviderRedstone:
+		defaults = redstoneDefaultEndpoints
+	case ProviderRestJSON:
+		defaults = restJSONDefaultEndpoints
+	case ProviderShade:
+		defaults = shadeDefaultEndpoints
+	case ProviderStride:
+		defaults = strideDefaultEndpoints
+	case ProviderSushi:
+		defaults = sushiDefaultEndpoints
+	case ProviderSwissquote:
+		defaults = swissquoteDefaultEndpoints
+	case ProviderTerraswap:
+		defaults = terraswapDefaultEndpoints
+	case ProviderThorchain:
+		defaults = thorchainDefaultEndpoints
+	case ProviderTraderJoe:
+		defaults = traderJoeDefaultEndpoints
+	case ProviderTreasury:
+		defaults = treasuryDefaultEndpoints
+	case ProviderUniswapV2:
+		defaults = uniswapV2DefaultEndpoints
+	case ProviderUniswapV3:
+		defaults = uniswapV3DefaultEndpoints
+	case ProviderUpbit:
+		defaults = upbitDefaultEndpoints
+	case ProviderVelodrome:
+		defaults = velodromeDefaultEndpoints
+	case ProviderWhitebit:
+		defaults = whitebitDefaultEndpoints
+	case ProviderWhiteWhale:
+		defaults = whiteWhaleDefaultEndpoints
 	case ProviderXt:
 		defaults = xtDefaultEndpoints
 	case ProviderZero:
@@ -335,6 +644,12 @@ func (e *Endpoint) SetDefaults() {
 	if e.PingType == 0 {
 		e.PingType = defaults.PingType
 	}
+	if e.SymbolsTTL == time.Duration(0) {
+		e.SymbolsTTL = defaultSymbolsTTL
+	}
+	if e.Role == "" {
+		e.Role = defaults.Role
+	}
 	if e.PingMessage == "" {
 		if defaults.PingMessage != "" {
 			e.PingMessage = defaults.PingMessage
@@ -344,14 +659,26 @@ func (e *Endpoint) SetDefaults() {
 	}
 }
 
-func startPolling(p PollingProvider, interval time.Duration, logger zerolog.Logger) {
+// startPolling polls the provider at the interval until the context is done.
+func startPolling(ctx context.Context, p PollingProvider, interval time.Duration, logger zerolog.Logger) {
 	logger.Debug().Dur("interval", interval).Msg("starting poll loop")
 	for {
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+
 		err := p.Poll()
 		if err != nil {
 			logger.Error().Err(err).Msg("failed to poll")
 		}
-		time.Sleep(interval)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(interval):
+		}
 	}
 }
 
@@ -400,6 +727,41 @@ func checkHTTPStatus(resp *http.Response) error {
 	return nil
 }
 
+// isRedemptionRateValid returns whether the redemption rate of a liquid
+// staking token is plausible. It can't be worth less than its underlying and
+// shouldn't realistically double in value.
+func isRedemptionRateValid(rate sdk.Dec) bool {
+	return rate.GTE(sdk.OneDec()) && rate.LTE(sdk.NewDec(2))
+}
+
+// checkRedemptionRateDeviation returns an error if the redemption rate of a
+// liquid staking token deviates from its spot price by more than the
+// `max_deviation` of the endpoints, or the default if it isn't set.
+func checkRedemptionRateDeviation(endpoints Endpoint, rate, spot, defaultMaxDeviation sdk.Dec) error {
+	if spot.IsNil() || !spot.IsPositive() {
+		return fmt.Errorf("invalid spot price: %s", spot)
+	}
+	maxDeviation := defaultMaxDeviation
+	if !endpoints.MaxDeviation.IsNil() && endpoints.MaxDeviation.IsPositive() {
+		maxDeviation = endpoints.MaxDeviation
+	}
+	deviation := rate.Sub(spot).Abs().Quo(spot)
+	if deviation.GT(maxDeviation) {
+		return fmt.Errorf("exchange rate %s deviates %s from spot price %s", rate, deviation, spot)
+	}
+	return nil
+}
+
+// computeSpread returns the bid/ask spread relative to the mid price or a nil
+// sdk.Dec if the order book top is invalid.
+func computeSpread(bid, ask sdk.Dec) sdk.Dec {
+	if bid.IsNil() || ask.IsNil() || !bid.IsPositive() || ask.LT(bid) {
+		return sdk.Dec{}
+	}
+	mid := bid.Add(ask).QuoInt64(2)
+	return ask.Sub(bid).Quo(mid)
+}
+
 func strToDec(str string) sdk.Dec {
 	if strings.Contains(str, ".") {
 		split := strings.Split(str, ".")
@@ -411,6 +773,76 @@ func strToDec(str string) sdk.Dec {
 	return sdk.MustNewDecFromStr(str)
 }
 
+// decimalPattern matches plain decimal numbers with an optional exponent,
+// rejecting NaN, Inf and hex floats which strconv.ParseFloat would accept.
+var decimalPattern = regexp.MustCompile(`^([+-]?)([0-9]*)(?:\.([0-9]*))?(?:[eE]([+-]?[0-9]{1,4}))?$`)
+
+// decFromString converts a decimal number, including numbers in scientific
+// notation, to a decimal truncated to sdk.Precision. Unlike strToDec it
+// returns an error instead of panicking on values read from untrusted
+// responses.
+func decFromString(str string) (sdk.Dec, error) {
+	match := decimalPattern.FindStringSubmatch(strings.TrimSpace(str))
+	if match == nil || match[2]+match[3] == "" {
+		return sdk.Dec{}, fmt.Errorf("invalid number: %s", str)
+	}
+	sign, digits, point := match[1], match[2]+match[3], len(match[2])
+	if match[4] != "" {
+		exponent, err := strconv.Atoi(match[4])
+		if err != nil {
+			return sdk.Dec{}, fmt.Errorf("invalid number: %s", str)
+		}
+		point += exponent
+	}
+
+	// move the decimal point by the exponent, padding with zeros
+	if point < 0 {
+		digits = strings.Repeat("0", -point) + digits
+		point = 0
+	}
+	if point > len(digits) {
+		digits += strings.Repeat("0", point-len(digits))
+	}
+	integer, fraction := digits[:point], digits[point:]
+	if integer == "" {
+		integer = "0"
+	}
+	if len(fraction) > sdk.Precision {
+		fraction = fraction[:sdk.Precision]
+	}
+	if fraction != "" {
+		integer += "." + fraction
+	}
+	return sdk.NewDecFromStr(sign + integer)
+}
+
+// decFromFloat converts a float to a decimal truncated to sdk.Precision,
+// returning an error for NaN, infinite and out of range values.
+func decFromFloat(f float64) (sdk.Dec, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return sdk.Dec{}, fmt.Errorf("invalid number: %v", f)
+	}
+	return decFromString(strconv.FormatFloat(f, 'f', -1, 64))
+}
+
 func floatToDec(f float64) sdk.Dec {
-	return sdk.MustNewDecFromStr(strconv.FormatFloat(f, 'f', -1, 64))
+	// small prices may be formatted with more than 18 decimals
+	return strToDec(strconv.FormatFloat(f, 'f', -1, 64))
+}
+
+// sqrtPriceToDec returns the price of token0 in token1 for the fixed point
+// sqrt price of concentrated liquidity pools with the amount of fractional
+// bits, ex.: 96 for Q64.96. The decimals are the token0 decimals minus the
+// token1 decimals.
+func sqrtPriceToDec(sqrtPrice *big.Int, bits uint, decimals int64) sdk.Dec {
+	// price = sqrtPrice^2 / 2^(2*bits), scaled by the decimals and sdk.Precision
+	price := new(big.Int).Mul(sqrtPrice, sqrtPrice)
+	scale := decimals + sdk.Precision
+	if scale >= 0 {
+		price.Mul(price, new(big.Int).Exp(big.NewInt(10), big.NewInt(scale), nil))
+	} else {
+		price.Quo(price, new(big.Int).Exp(big.NewInt(10), big.NewInt(-scale), nil))
+	}
+	price.Rsh(price, 2*bits)
+	return sdk.NewDecFromBigIntWithPrec(price, sdk.Precision)
 }
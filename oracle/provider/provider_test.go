@@ -1,10 +1,20 @@
 package provider
 
 import (
-	"price-feeder/oracle/types"
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
 	"time"
 
+	"price-feeder/oracle/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
 )
 
 var (
@@ -58,3 +68,85 @@ var (
 		"BTCUSDT":  testBtcTicker,
 	}
 )
+
+type (
+	// testServer serves the responses of a provider test and records the
+	// requests it receives, so that they are asserted in the test goroutine
+	// rather than in the handler.
+	testServer struct {
+		*httptest.Server
+		mtx      sync.Mutex
+		requests []testRequest
+	}
+
+	// testRequest defines a request received by a testServer along with its
+	// body.
+	testRequest struct {
+		*http.Request
+		body []byte
+	}
+
+	// testConstructor defines the constructor of a provider, ex.:
+	// NewBandProvider.
+	testConstructor[P any] func(
+		context.Context,
+		zerolog.Logger,
+		Endpoint,
+		...types.CurrencyPair,
+	) (P, error)
+)
+
+// newTestServer returns a server answering all requests using the handler.
+func newTestServer(handler http.HandlerFunc) *testServer {
+	s := &testServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		req.Body = io.NopCloser(bytes.NewReader(body))
+
+		s.mtx.Lock()
+		s.requests = append(s.requests, testRequest{
+			Request: req.Clone(context.Background()),
+			body:    body,
+		})
+		s.mtx.Unlock()
+
+		handler(rw, req)
+	}))
+	return s
+}
+
+// Requests returns the requests received by the server so far.
+func (s *testServer) Requests() []testRequest {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return append([]testRequest{}, s.requests...)
+}
+
+// newTestProvider builds a provider through its constructor using the server
+// as endpoint. The poll loop of the provider is stopped before its first
+// poll, so that the test polls the provider explicitly.
+func newTestProvider[P any](
+	t *testing.T,
+	constructor testConstructor[P],
+	server *testServer,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) P {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if endpoints.Urls == nil {
+		endpoints.Urls = []string{server.URL}
+	}
+	defaults := Endpoint{Name: endpoints.Name}
+	defaults.SetDefaults()
+	if defaults.Websocket != "" && endpoints.Websocket == "" {
+		// the websocket fails to connect to the test server and, since the
+		// context is done, isn't retried
+		endpoints.Websocket = server.Listener.Addr().String()
+	}
+
+	p, err := constructor(ctx, zerolog.Nop(), endpoints, pairs...)
+	require.NoError(t, err)
+	return p
+}
@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+)
+
+const (
+	// pstakeStakePool defines the address of the stkBNB stake pool on BNB
+	// Chain.
+	pstakeStakePool = "0xc228cefdf841defdbd5b3a18dfd414cc0dbfa0d8"
+	// pstakeStkBnb defines the address of the stkBNB token on BNB Chain.
+	pstakeStkBnb = "0xc2e9d07f66a89c44062459a47a0d2dc038e4fb16"
+	// pstakeWbnb defines the address of the WBNB token on BNB Chain.
+	pstakeWbnb = "0xbb4cdb9cbd36b01bd1cbaebf2de08d9173bc095c"
+)
+
+var (
+	_                      Provider = (*PstakeProvider)(nil)
+	pstakeDefaultEndpoints          = Endpoint{
+		Name:         ProviderPstake,
+		Urls:         []string{"https://bsc-dataseed.bnbchain.org"},
+		PollInterval: 30 * time.Second,
+	}
+	// pstakeDefaultMaxDeviation defines the default relative deviation of
+	// the exchange rates of stkBNB and the stkTokens of the
+	// PersistenceProvider from their spot prices after which they're
+	// rejected.
+	pstakeDefaultMaxDeviation = sdk.MustNewDecFromStr("0.05")
+)
+
+type (
+	// PstakeProvider defines an oracle provider reading the exchange rate of
+	// the pSTAKE stkBNB stake pool on BNB Chain, which is reported as the
+	// price of STKBNB quoted in BNB. Like the PersistenceProvider, which
+	// covers stkATOM and the other liquidstakeibc host chains, the USD
+	// conversion multiplies it with the aggregated price of BNB.
+	//
+	// When a Uniswap V2 style pair is set as "STKBNBBNB" in the `contracts`
+	// of the provider endpoints, ex.: a PancakeSwap pair, its spot price is
+	// used as a sanity check and the exchange rate is rejected if it deviates
+	// by more than the `max_deviation` of the provider endpoints, which
+	// defaults to 5%. The stake pool and token addresses can be overridden as
+	// "STAKEPOOL", "STKBNB" and "BNB".
+	//
+	// REF: https://github.com/persistenceOne/stkBNB
+	PstakeProvider struct {
+		UniswapV2Provider
+	}
+)
+
+func NewPstakeProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*PstakeProvider, error) {
+	contracts := map[string]string{
+		"STAKEPOOL": pstakeStakePool,
+		"STKBNB":    pstakeStkBnb,
+		"BNB":       pstakeWbnb,
+	}
+	for key, address := range endpoints.Contracts {
+		contracts[key] = address
+	}
+	endpoints.Contracts = contracts
+
+	provider := &PstakeProvider{}
+	provider.decimals = newEvmDecimalsCache()
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		nil,
+		nil,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
+
+func (p *PstakeProvider) Poll() error {
+	symbol := "STKBNBBNB"
+	if _, ok := p.pairs[symbol]; !ok {
+		return nil
+	}
+
+	rate, err := p.getExchangeRate()
+	if err != nil {
+		return err
+	}
+	if !isRedemptionRateValid(rate) {
+		return fmt.Errorf("stkBNB exchange rate out of bounds: %s", rate)
+	}
+
+	if _, ok := p.endpoints.Contracts[symbol]; ok {
+		spot, err := p.getTicker(types.CurrencyPair{Base: "STKBNB", Quote: "BNB"})
+		if err != nil {
+			return err
+		}
+		err = checkRedemptionRateDeviation(p.endpoints, rate, spot.Price, pstakeDefaultMaxDeviation)
+		if err != nil {
+			return fmt.Errorf("stkBNB %w", err)
+		}
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	p.tickers[symbol] = types.TickerPrice{
+		Price:  rate,
+		Volume: sdk.OneDec(),
+		Time:   time.Now(),
+	}
+
+	p.logger.Debug().Msg("updated tickers")
+	return nil
+}
+
+// getExchangeRate returns the amount of BNB per stkBNB of the stake pool.
+func (p *PstakeProvider) getExchangeRate() (sdk.Dec, error) {
+	contract := strings.ToLower(p.endpoints.Contracts["STAKEPOOL"])
+	result, err := p.evmCall(contract, evmEncodeCall("exchangeRate()"))
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	totalWei, err := evmDecodeUint(result, 0)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	poolTokenSupply, err := evmDecodeUint(result, 1)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	if poolTokenSupply.Sign() == 0 {
+		return sdk.Dec{}, fmt.Errorf("stkBNB pool token supply is zero")
+	}
+	return bigIntToDec(totalWei, 18).Quo(bigIntToDec(poolTokenSupply, 18)), nil
+}
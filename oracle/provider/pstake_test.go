@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPstakeProvider_Poll(t *testing.T) {
+	pair := "0x1111111111111111111111111111111111111111"
+	reserve := "1000000000000000000000"
+
+	server := newEvmTestServer(func(params EvmCallParams) []byte {
+		switch params.To {
+		case pstakeStakePool:
+			totalWei, _ := new(big.Int).SetString("1100000000000000000000", 10)
+			supply, _ := new(big.Int).SetString("1000000000000000000000", 10)
+			return append(evmEncodeInt(totalWei), evmEncodeInt(supply)...)
+		case pair:
+			// WBNB sorts before stkBNB, so token0 is WBNB
+			reserve0, _ := new(big.Int).SetString(reserve, 10)
+			reserve1, _ := new(big.Int).SetString("1000000000000000000000", 10)
+			return append(evmEncodeInt(reserve0), evmEncodeInt(reserve1)...)
+		}
+		return nil
+	})
+	defer server.Close()
+
+	newProvider := func(contracts map[string]string, maxDeviation sdk.Dec) *PstakeProvider {
+		return newTestProvider(t, NewPstakeProvider, server, Endpoint{
+			Name:         ProviderPstake,
+			Contracts:    contracts,
+			Decimals:     map[string]int64{"STKBNB": 18, "BNB": 18},
+			MaxDeviation: maxDeviation,
+		}, types.CurrencyPair{Base: "STKBNB", Quote: "BNB"})
+	}
+
+	// the default contracts are used unless overridden
+	p := newProvider(nil, sdk.Dec{})
+	require.NoError(t, p.Poll())
+	require.Equal(t, sdk.MustNewDecFromStr("1.1"), p.tickers["STKBNBBNB"].Price)
+
+	// the spot price of 1.08 BNB is within the default max deviation
+	contracts := map[string]string{"STKBNBBNB": pair}
+	reserve = "1080000000000000000000"
+	p = newProvider(contracts, sdk.Dec{})
+	require.NoError(t, p.Poll())
+	require.Equal(t, sdk.MustNewDecFromStr("1.1"), p.tickers["STKBNBBNB"].Price)
+
+	// the spot price of 1 BNB deviates by 10%
+	reserve = "1000000000000000000000"
+	p = newProvider(contracts, sdk.Dec{})
+	require.Error(t, p.Poll())
+	require.Empty(t, p.tickers)
+
+	p = newProvider(contracts, sdk.MustNewDecFromStr("0.15"))
+	require.NoError(t, p.Poll())
+	require.Equal(t, sdk.MustNewDecFromStr("1.1"), p.tickers["STKBNBBNB"].Price)
+
+	for _, call := range evmTestCalls(t, server) {
+		switch call.To {
+		case pstakeStakePool:
+			require.Equal(t, "0x"+hex.EncodeToString(evmEncodeCall("exchangeRate()")), call.Data)
+		case pair:
+			require.Equal(t, "0x"+hex.EncodeToString(evmEncodeCall("getReserves()")), call.Data)
+		}
+	}
+}
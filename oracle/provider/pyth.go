@@ -0,0 +1,161 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/url"
+	"strings"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+)
+
+var (
+	_                    Provider = (*PythProvider)(nil)
+	pythDefaultEndpoints          = Endpoint{
+		Name:         ProviderPyth,
+		Urls:         []string{"https://hermes.pyth.network"},
+		PollInterval: 5 * time.Second,
+	}
+
+	// pythDefaultFeeds maps the pairs to their Pyth price feed ids.
+	pythDefaultFeeds = map[string]string{
+		"BTCUSD":  "e62df6c8b4a85fe1a67db44dc12de5db330f7ac66b72dc658afedf0f4a415b43",
+		"ETHUSD":  "ff61491a931112ddf1bd8147cd1b641375f79f5825126d665480874634fd0ace",
+		"SOLUSD":  "ef0d8b6fda2ceba41da15d4095d1da392a0d2f8ed0c6c7bc0f4cfac8c280b56d",
+		"USDCUSD": "eaa020c61cc479712813461ce153894a96a6c00b21ed0cfc2798d1f9a9e9c94a",
+		"USDTUSD": "2b89b9dc8fdf9f34709a5b106b472f0f39bb6ca9ce04b0fd7f2e971688e2e53b",
+	}
+)
+
+type (
+	// PythProvider defines an oracle provider polling the latest price
+	// updates of the Pyth network from a Hermes endpoint. The `contracts` of
+	// the provider endpoints map the pairs to their price feed ids, ex.
+	// "ATOMUSD" = "0xb00b60f8...". The confidence interval of the price is
+	// reported as spread, twice the confidence relative to the price, so
+	// uncertain prices show up in the spread monitoring. The prices don't
+	// have a volume, so their tickers are reported with a volume of one.
+	//
+	// REF: https://hermes.pyth.network/docs/#/rest/latest_price_updates
+	PythProvider struct {
+		provider
+	}
+
+	PythUpdatesResponse struct {
+		Parsed []PythPriceUpdate `json:"parsed"`
+	}
+
+	PythPriceUpdate struct {
+		Id    string    `json:"id"` // ex.: "e62df6c8b4a85fe1a67db44dc12de5db330f7ac66b72dc658afedf0f4a415b43"
+		Price PythPrice `json:"price"`
+	}
+
+	PythPrice struct {
+		Price       string `json:"price"`        // ex.: "3701234567890"
+		Conf        string `json:"conf"`         // ex.: "1234567"
+		Expo        int64  `json:"expo"`         // ex.: -8
+		PublishTime int64  `json:"publish_time"` // ex.: 1700000000
+	}
+)
+
+func NewPythProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*PythProvider, error) {
+	provider := &PythProvider{}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		nil,
+		nil,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
+
+func (p *PythProvider) Poll() error {
+	symbols := map[string]string{}
+	query := url.Values{}
+	for symbol := range p.pairs {
+		id, ok := p.endpoints.Contracts[symbol]
+		if !ok {
+			id, ok = pythDefaultFeeds[symbol]
+		}
+		if !ok {
+			p.logger.Warn().Str("pair", symbol).Msg("no price feed configured")
+			continue
+		}
+		id = strings.ToLower(strings.TrimPrefix(id, "0x"))
+		symbols[id] = symbol
+		query.Add("ids[]", id)
+	}
+	if len(symbols) == 0 {
+		return nil
+	}
+	query.Set("parsed", "true")
+
+	content, err := p.httpGet("/v2/updates/price/latest?" + query.Encode())
+	if err != nil {
+		return err
+	}
+
+	var response PythUpdatesResponse
+	err = json.Unmarshal(content, &response)
+	if err != nil {
+		return err
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	for _, update := range response.Parsed {
+		symbol, ok := symbols[strings.ToLower(strings.TrimPrefix(update.Id, "0x"))]
+		if !ok {
+			continue
+		}
+
+		price, err := pythScale(update.Price.Price, update.Price.Expo)
+		if err != nil || !price.IsPositive() {
+			p.logger.Warn().Err(err).Str("pair", symbol).Msg("invalid price")
+			continue
+		}
+		conf, err := pythScale(update.Price.Conf, update.Price.Expo)
+		if err != nil {
+			p.logger.Warn().Err(err).Str("pair", symbol).Msg("invalid confidence")
+			continue
+		}
+
+		p.tickers[symbol] = types.TickerPrice{
+			Price:  price,
+			Volume: sdk.OneDec(),
+			Time:   time.Unix(update.Price.PublishTime, 0),
+			Spread: conf.MulInt64(2).Quo(price),
+		}
+	}
+
+	p.logger.Debug().Msg("updated tickers")
+	return nil
+}
+
+// pythScale returns the integer value scaled by the exponent of the feed.
+func pythScale(value string, expo int64) (sdk.Dec, error) {
+	integer, ok := new(big.Int).SetString(value, 10)
+	if !ok {
+		return sdk.Dec{}, fmt.Errorf("invalid integer: %s", value)
+	}
+	if expo > 0 {
+		scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(expo), nil)
+		return sdk.NewDecFromBigInt(integer.Mul(integer, scale)), nil
+	}
+	return bigIntToDec(integer, -expo), nil
+}
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPythProvider_Poll(t *testing.T) {
+	server := newTestServer(func(rw http.ResponseWriter, req *http.Request) {
+		_, _ = rw.Write([]byte(`{"parsed":[
+			{"id":"b00b60f88b03a6a625a8d1c048c3f66653edf217439983d037e7222c4e612819","price":{"price":"987000000","conf":"4935000","expo":-8,"publish_time":1700000000}},
+			{"id":"e62df6c8b4a85fe1a67db44dc12de5db330f7ac66b72dc658afedf0f4a415b43","price":{"price":"3700000000000","conf":"1850000000","expo":-8,"publish_time":1700000001}}
+		]}`))
+	})
+	defer server.Close()
+
+	atomUsd := types.CurrencyPair{Base: "ATOM", Quote: "USD"}
+	btcUsd := types.CurrencyPair{Base: "BTC", Quote: "USD"}
+	osmoUsd := types.CurrencyPair{Base: "OSMO", Quote: "USD"}
+
+	p := newTestProvider(t, NewPythProvider, server, Endpoint{
+		Name: ProviderPyth,
+		Contracts: map[string]string{
+			"ATOMUSD": "0xB00B60F88B03A6A625A8D1C048C3F66653EDF217439983D037E7222C4E612819",
+		},
+	}, atomUsd, btcUsd, osmoUsd)
+
+	require.NoError(t, p.Poll())
+	requests := server.Requests()
+	require.Len(t, requests, 1)
+	require.Equal(t, "/v2/updates/price/latest", requests[0].URL.Path)
+	require.ElementsMatch(t, []string{
+		"b00b60f88b03a6a625a8d1c048c3f66653edf217439983d037e7222c4e612819",
+		"e62df6c8b4a85fe1a67db44dc12de5db330f7ac66b72dc658afedf0f4a415b43",
+	}, requests[0].URL.Query()["ids[]"])
+	require.Equal(t, sdk.MustNewDecFromStr("9.87"), p.tickers["ATOMUSD"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("0.01"), p.tickers["ATOMUSD"].Spread)
+	require.Equal(t, sdk.MustNewDecFromStr("37000"), p.tickers["BTCUSD"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("0.001"), p.tickers["BTCUSD"].Spread)
+	require.Equal(t, int64(1700000001), p.tickers["BTCUSD"].Time.Unix())
+	require.NotContains(t, p.tickers, "OSMOUSD")
+}
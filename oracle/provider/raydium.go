@@ -0,0 +1,179 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	"github.com/rs/zerolog"
+)
+
+// Offsets of the fields of the Raydium AMM v4 pool state.
+const (
+	raydiumBaseDecimalOffset      = 32
+	raydiumQuoteDecimalOffset     = 40
+	raydiumBaseNeedTakePnlOffset  = 192
+	raydiumQuoteNeedTakePnlOffset = 200
+	raydiumBaseVaultOffset        = 336
+	raydiumQuoteVaultOffset       = 368
+	raydiumBaseMintOffset         = 400
+	raydiumQuoteMintOffset        = 432
+)
+
+var (
+	_                       Provider = (*RaydiumProvider)(nil)
+	raydiumDefaultEndpoints          = Endpoint{
+		Name:         ProviderRaydium,
+		Urls:         []string{"https://api.mainnet-beta.solana.com"},
+		PollInterval: 15 * time.Second,
+	}
+)
+
+type (
+	// RaydiumProvider defines an oracle provider reading Raydium AMM v4 pool
+	// accounts using a Solana JSON-RPC endpoint. The reserves are the vault
+	// balances of the pool minus the pnl owed to the protocol. The `contracts`
+	// of the provider endpoints map the denoms to their mint addresses and the
+	// pairs to their pool ids, ex.: {"SOL": "So11...", "USDC": "EPjF...",
+	// "SOLUSDC": "58oQ..."}. The base token reserve is reported as volume.
+	//
+	// REF: https://github.com/raydium-io/raydium-amm/blob/master/program/src/state.rs
+	RaydiumProvider struct {
+		provider
+	}
+
+	// raydiumReserve defines the reserve of one of the tokens of a pool.
+	raydiumReserve struct {
+		mint     string
+		amount   *big.Int
+		decimals int64
+	}
+)
+
+func NewRaydiumProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*RaydiumProvider, error) {
+	provider := &RaydiumProvider{}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		nil,
+		nil,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
+
+func (p *RaydiumProvider) Poll() error {
+	for symbol, pair := range p.pairs {
+		ticker, err := p.getTicker(pair)
+		if err != nil {
+			p.logger.Warn().Err(err).Str("pair", symbol).Msg("failed to get pool")
+			continue
+		}
+
+		p.mtx.Lock()
+		p.tickers[symbol] = ticker
+		p.mtx.Unlock()
+	}
+
+	p.logger.Debug().Msg("updated tickers")
+	return nil
+}
+
+func (p *RaydiumProvider) getTicker(pair types.CurrencyPair) (types.TickerPrice, error) {
+	pool, ok := p.endpoints.Contracts[pair.String()]
+	if !ok {
+		return types.TickerPrice{}, fmt.Errorf("no contract configured for %s", pair.String())
+	}
+	baseMint, ok := p.endpoints.Contracts[pair.Base]
+	if !ok {
+		return types.TickerPrice{}, fmt.Errorf("no contract configured for %s", pair.Base)
+	}
+
+	data, err := p.solanaAccountData(pool)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	reserve0, err := p.getReserve(
+		data,
+		raydiumBaseMintOffset,
+		raydiumBaseVaultOffset,
+		raydiumBaseNeedTakePnlOffset,
+		raydiumBaseDecimalOffset,
+	)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+	reserve1, err := p.getReserve(
+		data,
+		raydiumQuoteMintOffset,
+		raydiumQuoteVaultOffset,
+		raydiumQuoteNeedTakePnlOffset,
+		raydiumQuoteDecimalOffset,
+	)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	// the base of the pair may be the quote of the pool
+	base, quote := reserve0, reserve1
+	if reserve1.mint == baseMint {
+		base, quote = reserve1, reserve0
+	} else if reserve0.mint != baseMint {
+		return types.TickerPrice{}, fmt.Errorf("pool %s doesn't contain %s", pool, pair.Base)
+	}
+	if base.amount.Sign() <= 0 || quote.amount.Sign() <= 0 {
+		return types.TickerPrice{}, fmt.Errorf("no liquidity in pool %s", pool)
+	}
+
+	baseAmount := bigIntToDec(base.amount, base.decimals)
+	quoteAmount := bigIntToDec(quote.amount, quote.decimals)
+
+	return types.TickerPrice{
+		Price:  quoteAmount.Quo(baseAmount),
+		Volume: baseAmount,
+		Time:   time.Now(),
+	}, nil
+}
+
+// getReserve returns the reserve of one of the tokens of the pool, which is
+// the balance of its vault minus the pnl which is yet to be taken.
+func (p *RaydiumProvider) getReserve(data []byte, mintOffset, vaultOffset, pnlOffset, decimalsOffset int) (raydiumReserve, error) {
+	mint, err := solanaDecodePubkey(data, mintOffset)
+	if err != nil {
+		return raydiumReserve{}, err
+	}
+	vault, err := solanaDecodePubkey(data, vaultOffset)
+	if err != nil {
+		return raydiumReserve{}, err
+	}
+	pnl, err := solanaDecodeUint64(data, pnlOffset)
+	if err != nil {
+		return raydiumReserve{}, err
+	}
+	decimals, err := solanaDecodeUint64(data, decimalsOffset)
+	if err != nil {
+		return raydiumReserve{}, err
+	}
+
+	amount, _, err := p.solanaTokenBalance(vault)
+	if err != nil {
+		return raydiumReserve{}, err
+	}
+
+	return raydiumReserve{
+		mint:     mint,
+		amount:   amount.Sub(amount, new(big.Int).SetUint64(pnl)),
+		decimals: int64(decimals),
+	}, nil
+}
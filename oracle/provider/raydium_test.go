@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"testing"
+
+	"price-feeder/oracle/types"
+
+	"github.com/cosmos/btcutil/base58"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRaydiumProvider_Poll(t *testing.T) {
+	const (
+		pool       = "58oQChx4yWmvKdwLLZzBi4ChoCc2fqCUWBkwMihLYQo2"
+		sol        = "So11111111111111111111111111111111111111112"
+		usdc       = "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v"
+		solVault   = "DQyrAcCrDXQ7NeoqGgDCZwBvWDcYmFCjSb9JtteuvPpz"
+		usdcVault  = "HLmqeL62xR1QoZ1HKKbXRrdN1p3phKpxRMb2VVopvBBz"
+		accountLen = 752
+	)
+
+	data := make([]byte, accountLen)
+	binary.LittleEndian.PutUint64(data[raydiumBaseDecimalOffset:], 9)
+	binary.LittleEndian.PutUint64(data[raydiumQuoteDecimalOffset:], 6)
+	binary.LittleEndian.PutUint64(data[raydiumQuoteNeedTakePnlOffset:], 1000000)
+	copy(data[raydiumBaseVaultOffset:], base58.Decode(solVault))
+	copy(data[raydiumQuoteVaultOffset:], base58.Decode(usdcVault))
+	copy(data[raydiumBaseMintOffset:], base58.Decode(sol))
+	copy(data[raydiumQuoteMintOffset:], base58.Decode(usdc))
+
+	server := newSolanaTestServer(func(method string, params []interface{}) interface{} {
+		switch {
+		case method == "getAccountInfo" && params[0] == pool:
+			return SolanaAccountInfoResult{Value: &SolanaAccountInfo{
+				Data: []string{base64.StdEncoding.EncodeToString(data), "base64"},
+			}}
+		case method == "getTokenAccountBalance" && params[0] == solVault:
+			return SolanaTokenBalanceResult{Value: SolanaTokenBalance{Amount: "100000000000000", Decimals: 9}}
+		case method == "getTokenAccountBalance" && params[0] == usdcVault:
+			return SolanaTokenBalanceResult{Value: SolanaTokenBalance{Amount: "15000001000000", Decimals: 6}}
+		}
+		return nil
+	})
+	defer server.Close()
+
+	solUsdc := types.CurrencyPair{Base: "SOL", Quote: "USDC"}
+	usdcSol := types.CurrencyPair{Base: "USDC", Quote: "SOL"}
+
+	p := newTestProvider(t, NewRaydiumProvider, server, Endpoint{
+		Name:      ProviderRaydium,
+		Contracts: map[string]string{"SOL": sol, "USDC": usdc, "SOLUSDC": pool, "USDCSOL": pool},
+	}, solUsdc, usdcSol)
+
+	require.NoError(t, p.Poll())
+	require.Equal(t, sdk.MustNewDecFromStr("150"), p.tickers["SOLUSDC"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("100000"), p.tickers["SOLUSDC"].Volume)
+	require.Equal(t, sdk.MustNewDecFromStr("15000000"), p.tickers["USDCSOL"].Volume)
+}
@@ -0,0 +1,299 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"github.com/rs/zerolog"
+	"golang.org/x/crypto/sha3"
+)
+
+const (
+	// redstoneDataService defines the default data service of the packages.
+	redstoneDataService = "redstone-primary-prod"
+	// redstoneMinSigners defines the amount of unique authorized signers
+	// required for a price, like the default threshold of the RedStone
+	// consumer contracts.
+	redstoneMinSigners = 3
+	// redstoneDefaultDecimals defines the decimals numeric values are
+	// serialized with.
+	redstoneDefaultDecimals = 8
+)
+
+var (
+	_                        Provider = (*RedstoneProvider)(nil)
+	redstoneDefaultEndpoints          = Endpoint{
+		Name:         ProviderRedstone,
+		Urls:         []string{"https://oracle-gateway-1.a.redstone.finance"},
+		PollInterval: 10 * time.Second,
+	}
+
+	// redstoneDefaultSigners lists the signers of the primary data service.
+	redstoneDefaultSigners = []string{
+		"0x8bb8f32df04c8b654987daaed53d6b6091e3b774",
+		"0xdeb22f54738d54976c4c0fe5ce6d408e40d88499",
+		"0x51ce04be4b3e32572c4ec9135221d0691ba7d202",
+		"0xdd682daec5a90dd295d14da4b0bec9281017b5be",
+		"0x9c5ae89c4af6aa32ce58588dbaf90d18a855b6de",
+	}
+)
+
+type (
+	// RedstoneProvider defines an oracle provider polling the latest signed
+	// data packages of a RedStone gateway. Every package is serialized like
+	// the RedStone contracts do and its signer is recovered from the
+	// signature, so only values of authorized signers are used, regardless
+	// of the gateway. The price is the median of the values of at least
+	// three unique signers. The signers default to the ones of the primary
+	// data service and can be set as comma separated list with the
+	// "signers" key of the `contracts` of the provider endpoints, the data
+	// service with the "service" key. All values are quoted in USD and
+	// don't have a volume, so their tickers are reported with a volume of
+	// one.
+	//
+	// REF: https://docs.redstone.finance/docs/get-started/data-formatting-processing
+	RedstoneProvider struct {
+		provider
+	}
+
+	// RedstonePackagesResponse maps the data feeds to their latest packages.
+	RedstonePackagesResponse map[string][]RedstoneDataPackage
+
+	RedstoneDataPackage struct {
+		Timestamp  int64               `json:"timestampMilliseconds"` // ex.: 1700000000000
+		Signature  string              `json:"signature"`             // ex.: "x4hC...HA=" (base64 of r, s and v)
+		DataPoints []RedstoneDataPoint `json:"dataPoints"`
+	}
+
+	RedstoneDataPoint struct {
+		DataFeedId string      `json:"dataFeedId"` // ex.: "ATOM"
+		Value      json.Number `json:"value"`      // ex.: 9.87
+		Decimals   *int64      `json:"decimals"`   // ex.: 8
+	}
+)
+
+func NewRedstoneProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*RedstoneProvider, error) {
+	provider := &RedstoneProvider{}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		nil,
+		nil,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
+
+func (p *RedstoneProvider) Poll() error {
+	service := redstoneDataService
+	if value, ok := p.endpoints.Contracts["service"]; ok {
+		service = value
+	}
+
+	content, err := p.httpGet("/data-packages/latest/" + service)
+	if err != nil {
+		return err
+	}
+
+	var packages RedstonePackagesResponse
+	err = json.Unmarshal(content, &packages)
+	if err != nil {
+		return err
+	}
+
+	signers := map[string]struct{}{}
+	for _, signer := range p.getSigners() {
+		signers[strings.ToLower(strings.TrimSpace(signer))] = struct{}{}
+	}
+
+	for symbol, pair := range p.pairs {
+		if pair.Quote != "USD" {
+			continue
+		}
+
+		ticker, err := p.getTicker(pair.Base, packages[pair.Base], signers)
+		if err != nil {
+			p.logger.Warn().Err(err).Str("pair", symbol).Msg("failed to get ticker")
+			continue
+		}
+
+		p.mtx.Lock()
+		p.tickers[symbol] = ticker
+		p.mtx.Unlock()
+	}
+
+	p.logger.Debug().Msg("updated tickers")
+	return nil
+}
+
+func (p *RedstoneProvider) getSigners() []string {
+	if signers, ok := p.endpoints.Contracts["signers"]; ok {
+		return strings.Split(signers, ",")
+	}
+	return redstoneDefaultSigners
+}
+
+func (p *RedstoneProvider) getTicker(
+	feed string,
+	packages []RedstoneDataPackage,
+	signers map[string]struct{},
+) (types.TickerPrice, error) {
+	values := map[string]sdk.Dec{}
+	var timestamp int64
+	for _, dataPackage := range packages {
+		signer, err := dataPackage.recoverSigner()
+		if err != nil {
+			p.logger.Debug().Err(err).Str("feed", feed).Msg("invalid package signature")
+			continue
+		}
+		if _, ok := signers[signer]; !ok {
+			continue
+		}
+
+		for _, dataPoint := range dataPackage.DataPoints {
+			if dataPoint.DataFeedId != feed {
+				continue
+			}
+			value, err := jsonValueToDec(dataPoint.Value)
+			if err != nil || !value.IsPositive() {
+				continue
+			}
+			values[signer] = value
+			if dataPackage.Timestamp > timestamp {
+				timestamp = dataPackage.Timestamp
+			}
+		}
+	}
+
+	if len(values) < redstoneMinSigners {
+		return types.TickerPrice{}, fmt.Errorf(
+			"%d of %d required signers found", len(values), redstoneMinSigners,
+		)
+	}
+
+	prices := make([]sdk.Dec, 0, len(values))
+	for _, value := range values {
+		prices = append(prices, value)
+	}
+	sort.Slice(prices, func(i, j int) bool {
+		return prices[i].LT(prices[j])
+	})
+	median := prices[len(prices)/2]
+	if len(prices)%2 == 0 {
+		median = median.Add(prices[len(prices)/2-1]).QuoInt64(2)
+	}
+
+	return types.TickerPrice{
+		Price:  median,
+		Volume: sdk.OneDec(),
+		Time:   time.UnixMilli(timestamp),
+	}, nil
+}
+
+// serialize returns the bytes of the package signed by its signer: the data
+// points sorted by their feed id, each as 32 bytes of the feed id and 32
+// bytes of the value, followed by 6 bytes of the timestamp, 4 bytes of the
+// value size and 3 bytes of the amount of data points.
+func (d RedstoneDataPackage) serialize() ([]byte, error) {
+	dataPoints := make([][]byte, 0, len(d.DataPoints))
+	for _, dataPoint := range d.DataPoints {
+		decimals := int64(redstoneDefaultDecimals)
+		if dataPoint.Decimals != nil {
+			decimals = *dataPoint.Decimals
+		}
+		if decimals < 0 || decimals > sdk.Precision {
+			return nil, fmt.Errorf("invalid decimals: %d", decimals)
+		}
+		value, err := jsonValueToDec(dataPoint.Value)
+		if err != nil {
+			return nil, err
+		}
+		scaled := value.Mul(sdk.NewDec(10).Power(uint64(decimals))).RoundInt()
+		if scaled.IsNegative() {
+			return nil, fmt.Errorf("negative value: %s", value)
+		}
+
+		serialized := redstoneFeedId(dataPoint.DataFeedId)
+		serialized = append(serialized, evmEncodeInt(scaled.BigInt())...)
+		dataPoints = append(dataPoints, serialized)
+	}
+	sort.Slice(dataPoints, func(i, j int) bool {
+		return bytes.Compare(dataPoints[i][:evmWordSize], dataPoints[j][:evmWordSize]) < 0
+	})
+
+	serialized := bytes.Join(dataPoints, nil)
+	timestamp := make([]byte, 8)
+	binary.BigEndian.PutUint64(timestamp, uint64(d.Timestamp))
+	serialized = append(serialized, timestamp[2:]...)
+	size := make([]byte, 4)
+	binary.BigEndian.PutUint32(size, evmWordSize)
+	serialized = append(serialized, size...)
+	count := make([]byte, 4)
+	binary.BigEndian.PutUint32(count, uint32(len(d.DataPoints)))
+	return append(serialized, count[1:]...), nil
+}
+
+// recoverSigner returns the lowercase address of the signer of the package.
+func (d RedstoneDataPackage) recoverSigner() (string, error) {
+	signature, err := base64.StdEncoding.DecodeString(d.Signature)
+	if err != nil {
+		return "", err
+	}
+	if len(signature) != 65 {
+		return "", fmt.Errorf("invalid signature length: %d", len(signature))
+	}
+
+	serialized, err := d.serialize()
+	if err != nil {
+		return "", err
+	}
+	hash := sha3.NewLegacyKeccak256()
+	hash.Write(serialized)
+
+	// the signature is encoded as r, s and v, the compact format as v, r
+	// and s with v offset by 27
+	v := signature[64]
+	if v < 27 {
+		v += 27
+	}
+	compact := append([]byte{v}, signature[:64]...)
+	publicKey, _, err := ecdsa.RecoverCompact(compact, hash.Sum(nil))
+	if err != nil {
+		return "", err
+	}
+
+	hash = sha3.NewLegacyKeccak256()
+	hash.Write(publicKey.SerializeUncompressed()[1:])
+	return "0x" + hex.EncodeToString(hash.Sum(nil)[12:]), nil
+}
+
+// redstoneFeedId returns the feed id as bytes32 string, long ids are hashed.
+func redstoneFeedId(id string) []byte {
+	if len(id) > 31 {
+		hash := sha3.NewLegacyKeccak256()
+		hash.Write([]byte(id))
+		return hash.Sum(nil)
+	}
+	word := make([]byte, evmWordSize)
+	copy(word, id)
+	return word
+}
@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/sha3"
+)
+
+func TestRedstoneProvider_Poll(t *testing.T) {
+	keys := make([]*secp256k1.PrivateKey, 5)
+	signers := make([]string, 4)
+	for i := range keys {
+		keys[i] = secp256k1.PrivKeyFromBytes([]byte{byte(i + 1)})
+		if i < len(signers) {
+			hash := sha3.NewLegacyKeccak256()
+			hash.Write(keys[i].PubKey().SerializeUncompressed()[1:])
+			signers[i] = "0x" + hex.EncodeToString(hash.Sum(nil)[12:])
+		}
+	}
+
+	signedPackage := func(key *secp256k1.PrivateKey, feed, value string) RedstoneDataPackage {
+		dataPackage := RedstoneDataPackage{
+			Timestamp:  1700000000000,
+			DataPoints: []RedstoneDataPoint{{DataFeedId: feed, Value: json.Number(value)}},
+		}
+		serialized, err := dataPackage.serialize()
+		require.NoError(t, err)
+		hash := sha3.NewLegacyKeccak256()
+		hash.Write(serialized)
+		compact := ecdsa.SignCompact(key, hash.Sum(nil), false)
+		signature := append(compact[1:], compact[0])
+		dataPackage.Signature = base64.StdEncoding.EncodeToString(signature)
+		return dataPackage
+	}
+
+	tampered := signedPackage(keys[3], "ATOM", "9.9")
+	tampered.DataPoints[0].Value = "1000"
+
+	packages := RedstonePackagesResponse{
+		"ATOM": {
+			signedPackage(keys[0], "ATOM", "9.87"),
+			signedPackage(keys[1], "ATOM", "9.86"),
+			signedPackage(keys[2], "ATOM", "9.89"),
+			tampered,
+			// not an authorized signer
+			signedPackage(keys[4], "ATOM", "1000"),
+		},
+		"OSMO": {
+			signedPackage(keys[0], "OSMO", "0.55"),
+			signedPackage(keys[1], "OSMO", "0.56"),
+		},
+	}
+
+	server := newTestServer(func(rw http.ResponseWriter, req *http.Request) {
+		_ = json.NewEncoder(rw).Encode(packages)
+	})
+	defer server.Close()
+
+	atomUsd := types.CurrencyPair{Base: "ATOM", Quote: "USD"}
+	osmoUsd := types.CurrencyPair{Base: "OSMO", Quote: "USD"}
+
+	p := newTestProvider(t, NewRedstoneProvider, server, Endpoint{
+		Name:      ProviderRedstone,
+		Contracts: map[string]string{"signers": strings.Join(signers, ",")},
+	}, atomUsd, osmoUsd)
+
+	require.NoError(t, p.Poll())
+	for _, req := range server.Requests() {
+		require.Equal(t, "/data-packages/latest/redstone-primary-prod", req.URL.Path)
+	}
+	require.Equal(t, sdk.MustNewDecFromStr("9.87"), p.tickers["ATOMUSD"].Price)
+	require.Equal(t, int64(1700000000000), p.tickers["ATOMUSD"].Time.UnixMilli())
+	// only two signers
+	require.NotContains(t, p.tickers, "OSMOUSD")
+}
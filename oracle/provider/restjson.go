@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	"github.com/rs/zerolog"
+)
+
+var (
+	_                        Provider = (*RestJSONProvider)(nil)
+	restJSONDefaultEndpoints          = Endpoint{
+		Name:         ProviderRestJSON,
+		PollInterval: 15 * time.Second,
+	}
+)
+
+type (
+	// RestJSONProvider defines an oracle provider polling arbitrary REST
+	// tickers, so venues with a simple ticker endpoint don't need a provider
+	// of their own. Every pair is listed in the `tickers` of the provider
+	// endpoints with an url, in which "{base}" and "{quote}" are replaced by
+	// the currencies of the pair, and the JSONPath expressions of the price
+	// and the optional volume and time. Urls starting with "/" are requested
+	// from the `urls` of the provider endpoints. Tickers without a volume
+	// are reported with a volume of one.
+	RestJSONProvider struct {
+		provider
+	}
+
+	// RestTicker defines a ticker of the RestJSONProvider, ex.:
+	// {"url": "https://api.example.com/ticker/{base}-{quote}", "price": "$.data.last"}.
+	RestTicker struct {
+		Base   string // ex.: "ATOM"
+		Quote  string // ex.: "USDT"
+		Url    string // ex.: "https://api.example.com/ticker/{base}-{quote}"
+		Price  string // ex.: "$.data.last"
+		Volume string // ex.: "$.data.volume"
+		Time   string // ex.: "$.data.timestamp"
+	}
+)
+
+func NewRestJSONProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*RestJSONProvider, error) {
+	provider := &RestJSONProvider{}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		nil,
+		nil,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
+
+func (p *RestJSONProvider) Poll() error {
+	for _, restTicker := range p.endpoints.Tickers {
+		symbol := restTicker.Base + restTicker.Quote
+		if _, ok := p.pairs[symbol]; !ok {
+			continue
+		}
+
+		ticker, err := p.getTicker(restTicker)
+		if err != nil {
+			p.logger.Warn().Err(err).Str("pair", symbol).Msg("failed to get ticker")
+			continue
+		}
+
+		p.mtx.Lock()
+		p.tickers[symbol] = ticker
+		p.mtx.Unlock()
+	}
+
+	p.logger.Debug().Msg("updated tickers")
+	return nil
+}
+
+func (p *RestJSONProvider) getTicker(restTicker RestTicker) (types.TickerPrice, error) {
+	url := strings.NewReplacer(
+		"{base}", restTicker.Base,
+		"{quote}", restTicker.Quote,
+	).Replace(restTicker.Url)
+
+	var (
+		content []byte
+		err     error
+	)
+	if strings.HasPrefix(url, "/") {
+		content, err = p.httpGet(url)
+	} else {
+		content, err = p.makeHttpRequest(url)
+	}
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	return jsonPathTickerPrice(
+		content,
+		restTicker.Price,
+		restTicker.Volume,
+		restTicker.Time,
+	)
+}
@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJsonPathLookup(t *testing.T) {
+	decoder := json.NewDecoder(strings.NewReader(`{"data":[{"last":"13.61","ATOM-USDT":{"p":1e-5}}]}`))
+	decoder.UseNumber()
+	var document interface{}
+	require.NoError(t, decoder.Decode(&document))
+
+	value, err := jsonPathLookup(document, "$.data[0].last")
+	require.NoError(t, err)
+	require.Equal(t, "13.61", value)
+
+	value, err = jsonPathLookup(document, "$.data[0]['ATOM-USDT'].p")
+	require.NoError(t, err)
+	dec, err := jsonValueToDec(value)
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("0.00001"), dec)
+
+	for _, path := range []string{"data", "$.data[1]", "$.data.last", "$.data[0]['last", "$.missing"} {
+		_, err = jsonPathLookup(document, path)
+		require.Error(t, err, path)
+	}
+}
+
+func TestJsonValueToDec(t *testing.T) {
+	valid := map[interface{}]string{
+		"13.61":                    "13.61",
+		json.Number("-2.5E3"):      "-2500",
+		"1.2345678901234567e-6":    "0.000001234567890123",
+		"0.0000218873434191178661": "0.000021887343419117",
+		1.2345678901234567e-6:      "0.000001234567890123",
+		0.000021887343419117866:    "0.000021887343419117",
+		json.Number("1e-30"):       "0",
+		"12":                       "12",
+		".5":                       "0.5",
+	}
+	for value, expected := range valid {
+		dec, err := jsonValueToDec(value)
+		require.NoError(t, err, value)
+		require.Equal(t, sdk.MustNewDecFromStr(expected), dec, value)
+	}
+
+	invalid := []interface{}{
+		"NaN", "nan", "Inf", "-Inf", "Infinity", "0x1p-2", "0x10", "", ".", "1e", "1.2.3", "1e9999", true, nil,
+	}
+	for _, value := range invalid {
+		_, err := jsonValueToDec(value)
+		require.Error(t, err, value)
+	}
+}
+
+func TestRestJSONProvider_Poll(t *testing.T) {
+	server := newTestServer(func(rw http.ResponseWriter, req *http.Request) {
+		_, _ = rw.Write([]byte(`{"data":{"last":"13.61","vol24h":433812.95,"ts":1700000000123}}`))
+	})
+	defer server.Close()
+
+	atomUsdt := types.CurrencyPair{Base: "ATOM", Quote: "USDT"}
+	osmoUsdt := types.CurrencyPair{Base: "OSMO", Quote: "USDT"}
+
+	p := newTestProvider(t, NewRestJSONProvider, server, Endpoint{
+		Name: ProviderRestJSON,
+		Tickers: []RestTicker{
+			{
+				Base:   "ATOM",
+				Quote:  "USDT",
+				Url:    server.URL + "/v1/ticker?symbol={base}{quote}",
+				Price:  "$.data.last",
+				Volume: "$.data.vol24h",
+				Time:   "$.data.ts",
+			},
+			{
+				Base:  "OSMO",
+				Quote: "USDT",
+				Url:   "/v1/ticker?symbol=ATOMUSDT",
+				Price: "$.data.last",
+			},
+		},
+	}, atomUsdt, osmoUsdt)
+
+	require.NoError(t, p.Poll())
+	for _, req := range server.Requests() {
+		require.Equal(t, "/v1/ticker", req.URL.Path)
+		require.Equal(t, "ATOMUSDT", req.URL.Query().Get("symbol"))
+	}
+	require.Equal(t, sdk.MustNewDecFromStr("13.61"), p.tickers["ATOMUSDT"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("433812.95"), p.tickers["ATOMUSDT"].Volume)
+	require.Equal(t, int64(1700000000123), p.tickers["ATOMUSDT"].Time.UnixMilli())
+	require.Equal(t, sdk.OneDec(), p.tickers["OSMOUSDT"].Volume)
+}
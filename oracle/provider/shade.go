@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+)
+
+var (
+	_                     Provider = (*ShadeProvider)(nil)
+	shadeDefaultEndpoints          = Endpoint{
+		Name:         ProviderShade,
+		Urls:         []string{"https://na36v10ce3.execute-api.us-east-1.amazonaws.com/API-mainnet-STAGE"},
+		PollInterval: 15 * time.Second,
+	}
+)
+
+type (
+	// ShadeProvider defines an oracle provider polling the USD prices of the
+	// Shade API, which derives them from the ShadeSwap pools on Secret
+	// Network. Querying the pool contracts directly would require encrypted
+	// queries, so the API is used instead. The tokens are matched by their
+	// symbol unless the `contracts` of the provider endpoints map the denoms
+	// to their Secret Network contract addresses. The prices don't have a
+	// volume, so their tickers are reported with a volume of one.
+	//
+	// REF: https://docs.shadeprotocol.io/shade-protocol/shadeswap
+	ShadeProvider struct {
+		provider
+	}
+
+	ShadeToken struct {
+		Id              string `json:"id"`               // ex.: "06180689-8b1c-4c4e-a2b4-c5c2b8b6f5f0"
+		Symbol          string `json:"symbol"`           // ex.: "SHD"
+		ContractAddress string `json:"contract_address"` // ex.: "secret153wu605vvp934xhd4k9dtd640zsep5jkesstdm"
+	}
+
+	ShadeTokenPrice struct {
+		Id    string `json:"id"`    // ex.: "06180689-8b1c-4c4e-a2b4-c5c2b8b6f5f0"
+		Value string `json:"value"` // ex.: "1.4512"
+	}
+)
+
+func NewShadeProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*ShadeProvider, error) {
+	provider := &ShadeProvider{}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		nil,
+		nil,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
+
+func (p *ShadeProvider) Poll() error {
+	content, err := p.httpGet("/tokens")
+	if err != nil {
+		return err
+	}
+
+	var tokens []ShadeToken
+	err = json.Unmarshal(content, &tokens)
+	if err != nil {
+		return err
+	}
+
+	// all prices are quoted in USD
+	symbols := map[string]string{}
+	for _, token := range tokens {
+		for symbol, pair := range p.pairs {
+			if pair.Quote != "USD" {
+				continue
+			}
+			if contract, ok := p.endpoints.Contracts[pair.Base]; ok {
+				if contract == token.ContractAddress {
+					symbols[token.Id] = symbol
+				}
+			} else if strings.EqualFold(pair.Base, token.Symbol) {
+				symbols[token.Id] = symbol
+			}
+		}
+	}
+
+	content, err = p.httpGet("/token_prices")
+	if err != nil {
+		return err
+	}
+
+	var prices []ShadeTokenPrice
+	err = json.Unmarshal(content, &prices)
+	if err != nil {
+		return err
+	}
+
+	timestamp := time.Now()
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	for _, price := range prices {
+		symbol, ok := symbols[price.Id]
+		if !ok {
+			continue
+		}
+		value, err := strconv.ParseFloat(price.Value, 64)
+		if err != nil || value <= 0 {
+			continue
+		}
+		p.tickers[symbol] = types.TickerPrice{
+			Price:  floatToDec(value),
+			Volume: sdk.OneDec(),
+			Time:   timestamp,
+		}
+	}
+
+	p.logger.Debug().Msg("updated tickers")
+	return nil
+}
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShadeProvider_Poll(t *testing.T) {
+	server := newTestServer(func(rw http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/tokens":
+			_, _ = rw.Write([]byte(`[
+				{"id":"1","symbol":"SHD","contract_address":"secret153wu605vvp934xhd4k9dtd640zsep5jkesstdm"},
+				{"id":"2","symbol":"SCRT","contract_address":"secret1k0jntykt7e4g3y88ltc60czgjuqdy4c9e8fzek"},
+				{"id":"3","symbol":"SILK","contract_address":"secret1fl449muk5yq8dlad7a22nje4p5d2pnsgymhjfd"}
+			]`))
+		case "/token_prices":
+			_, _ = rw.Write([]byte(`[
+				{"id":"1","value":"1.4512"},
+				{"id":"2","value":"0.2105"},
+				{"id":"3","value":"1.1"}
+			]`))
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer server.Close()
+
+	shdUsd := types.CurrencyPair{Base: "SHD", Quote: "USD"}
+	scrtUsd := types.CurrencyPair{Base: "SCRT", Quote: "USD"}
+
+	p := newTestProvider(t, NewShadeProvider, server, Endpoint{
+		Name:      ProviderShade,
+		Contracts: map[string]string{"SCRT": "secret1k0jntykt7e4g3y88ltc60czgjuqdy4c9e8fzek"},
+	}, shdUsd, scrtUsd)
+
+	require.NoError(t, p.Poll())
+	require.Len(t, p.tickers, 2)
+	require.Equal(t, sdk.MustNewDecFromStr("1.4512"), p.tickers["SHDUSD"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("0.2105"), p.tickers["SCRTUSD"].Price)
+	require.Equal(t, sdk.OneDec(), p.tickers["SCRTUSD"].Volume)
+}
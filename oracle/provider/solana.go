@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/cosmos/btcutil/base58"
+)
+
+const (
+	// solanaPubkeySize defines the size of Solana public keys.
+	solanaPubkeySize = 32
+)
+
+type (
+	SolanaRpcRequest struct {
+		JsonRpc string        `json:"jsonrpc"` // ex.: "2.0"
+		Id      int64         `json:"id"`
+		Method  string        `json:"method"` // ex.: "getAccountInfo"
+		Params  []interface{} `json:"params"`
+	}
+
+	SolanaRpcResponse struct {
+		Result json.RawMessage `json:"result"`
+		Error  *SolanaRpcError `json:"error"`
+	}
+
+	SolanaRpcError struct {
+		Code    int64  `json:"code"`
+		Message string `json:"message"`
+	}
+
+	SolanaAccountInfoResult struct {
+		Value *SolanaAccountInfo `json:"value"`
+	}
+
+	SolanaAccountInfo struct {
+		Data  []string `json:"data"`  // ex.: ["AQAAAA...", "base64"]
+		Owner string   `json:"owner"` // ex.: "675kPX9MHTjS2zt1qfr1NYHuzeLXfQM9H24wFSUt1Mp8"
+	}
+
+	SolanaTokenBalanceResult struct {
+		Value SolanaTokenBalance `json:"value"`
+	}
+
+	SolanaTokenBalance struct {
+		Amount   string `json:"amount"`   // ex.: "1500000"
+		Decimals int64  `json:"decimals"` // ex.: 6
+	}
+)
+
+func (e *SolanaRpcError) Error() string {
+	return fmt.Sprintf("solana rpc failed: %d: %s", e.Code, e.Message)
+}
+
+// solanaCall executes a method of the Solana JSON-RPC endpoint and unmarshals
+// its result.
+func (p *provider) solanaCall(method string, params []interface{}, result interface{}) error {
+	body, err := json.Marshal(SolanaRpcRequest{
+		JsonRpc: "2.0",
+		Id:      1,
+		Method:  method,
+		Params:  params,
+	})
+	if err != nil {
+		return err
+	}
+
+	content, err := p.httpPost("", body)
+	if err != nil {
+		return err
+	}
+
+	var response SolanaRpcResponse
+	err = json.Unmarshal(content, &response)
+	if err != nil {
+		return err
+	}
+
+	if response.Error != nil {
+		return response.Error
+	}
+
+	return json.Unmarshal(response.Result, result)
+}
+
+// solanaAccountData returns the data of the account.
+func (p *provider) solanaAccountData(address string) ([]byte, error) {
+	var result SolanaAccountInfoResult
+	err := p.solanaCall(
+		"getAccountInfo",
+		[]interface{}{address, map[string]string{"encoding": "base64"}},
+		&result,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Value == nil {
+		return nil, fmt.Errorf("account %s not found", address)
+	}
+	if len(result.Value.Data) != 2 || result.Value.Data[1] != "base64" {
+		return nil, fmt.Errorf("invalid data of account %s", address)
+	}
+	return base64.StdEncoding.DecodeString(result.Value.Data[0])
+}
+
+// solanaTokenBalance returns the raw amount and decimals of the SPL token
+// account.
+func (p *provider) solanaTokenBalance(address string) (*big.Int, int64, error) {
+	var result SolanaTokenBalanceResult
+	err := p.solanaCall("getTokenAccountBalance", []interface{}{address}, &result)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	amount, ok := new(big.Int).SetString(result.Value.Amount, 10)
+	if !ok {
+		return nil, 0, fmt.Errorf("invalid amount: %s", result.Value.Amount)
+	}
+	return amount, result.Value.Decimals, nil
+}
+
+// solanaDecodePubkey returns the base58 encoded public key at the offset of
+// the account data.
+func solanaDecodePubkey(data []byte, offset int) (string, error) {
+	if len(data) < offset+solanaPubkeySize {
+		return "", fmt.Errorf("data too short: %d bytes", len(data))
+	}
+	return base58.Encode(data[offset : offset+solanaPubkeySize]), nil
+}
+
+// solanaDecodeUint64 returns the little endian integer at the offset of the
+// account data.
+func solanaDecodeUint64(data []byte, offset int) (uint64, error) {
+	if len(data) < offset+8 {
+		return 0, fmt.Errorf("data too short: %d bytes", len(data))
+	}
+	return binary.LittleEndian.Uint64(data[offset : offset+8]), nil
+}
+
+// solanaDecodeUint128 returns the little endian 128 bit integer at the offset
+// of the account data.
+func solanaDecodeUint128(data []byte, offset int) (*big.Int, error) {
+	if len(data) < offset+16 {
+		return nil, fmt.Errorf("data too short: %d bytes", len(data))
+	}
+	low := new(big.Int).SetUint64(binary.LittleEndian.Uint64(data[offset : offset+8]))
+	high := new(big.Int).SetUint64(binary.LittleEndian.Uint64(data[offset+8 : offset+16]))
+	return high.Lsh(high, 64).Or(high, low), nil
+}
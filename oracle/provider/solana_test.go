@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/cosmos/btcutil/base58"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSolanaDecode(t *testing.T) {
+	const mint = "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v"
+
+	data := make([]byte, 48)
+	copy(data[8:], base58.Decode(mint))
+	data[40] = 6
+
+	pubkey, err := solanaDecodePubkey(data, 8)
+	require.NoError(t, err)
+	require.Equal(t, mint, pubkey)
+
+	value, err := solanaDecodeUint64(data, 40)
+	require.NoError(t, err)
+	require.Equal(t, uint64(6), value)
+
+	_, err = solanaDecodePubkey(data, 24)
+	require.Error(t, err)
+}
+
+// newSolanaTestServer returns a JSON-RPC server answering requests with the
+// result of the handler. Malformed requests are answered with a bad request.
+func newSolanaTestServer(handler func(method string, params []interface{}) interface{}) *testServer {
+	return newTestServer(func(rw http.ResponseWriter, req *http.Request) {
+		var request SolanaRpcRequest
+		if err := json.NewDecoder(req.Body).Decode(&request); err != nil {
+			rw.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		result, _ := json.Marshal(handler(request.Method, request.Params))
+		bz, _ := json.Marshal(SolanaRpcResponse{Result: result})
+		_, _ = rw.Write(bz)
+	})
+}
@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+)
+
+var (
+	_                      Provider = (*StrideProvider)(nil)
+	strideDefaultEndpoints          = Endpoint{
+		Name:         ProviderStride,
+		Urls:         []string{"https://rest.cosmos.directory/stride"},
+		PollInterval: 30 * time.Second,
+	}
+)
+
+type (
+	// StrideProvider defines an oracle provider using the redemption rates of
+	// the stakeibc host zones on the Stride chain.
+	//
+	// The redemption rate is reported as the price of the stToken quoted in
+	// its underlying, e.g. STATOM/ATOM, so the USD conversion multiplies it
+	// with the aggregated price of the underlying. Host zones halted by
+	// Stride, ex.: after their redemption rate left the bounds of the chain,
+	// are skipped.
+	//
+	// REF: https://github.com/Stride-Labs/stride/tree/main/x/stakeibc
+	StrideProvider struct {
+		provider
+	}
+
+	StrideHostZonesResponse struct {
+		HostZones []StrideHostZone `json:"host_zone"`
+	}
+
+	StrideHostZone struct {
+		ChainId        string `json:"chain_id"`        // ex.: "cosmoshub-4"
+		HostDenom      string `json:"host_denom"`      // ex.: "uatom"
+		RedemptionRate string `json:"redemption_rate"` // ex.: "1.198588819852823521"
+		Halted         bool   `json:"halted"`
+	}
+)
+
+func NewStrideProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*StrideProvider, error) {
+	provider := &StrideProvider{}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		nil,
+		nil,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
+
+func (p *StrideProvider) Poll() error {
+	content, err := p.httpGet("/Stride-Labs/stride/stakeibc/host_zone")
+	if err != nil {
+		return err
+	}
+
+	var hostZones StrideHostZonesResponse
+	err = json.Unmarshal(content, &hostZones)
+	if err != nil {
+		return err
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	timestamp := time.Now()
+
+	for _, hostZone := range hostZones.HostZones {
+		denom := strideTranslateHostDenom(hostZone.HostDenom)
+		symbol := "ST" + denom + denom
+		_, ok := p.pairs[symbol]
+		if !ok {
+			continue
+		}
+
+		if hostZone.Halted {
+			p.logger.Warn().
+				Str("chain_id", hostZone.ChainId).
+				Msg("host zone is halted")
+			continue
+		}
+
+		rate, err := sdk.NewDecFromStr(hostZone.RedemptionRate)
+		if err != nil {
+			p.logger.Error().
+				Str("chain_id", hostZone.ChainId).
+				Err(err).
+				Msg("failed to parse redemption rate")
+			continue
+		}
+
+		if !isRedemptionRateValid(rate) {
+			p.logger.Warn().
+				Str("chain_id", hostZone.ChainId).
+				Str("rate", rate.String()).
+				Msg("redemption rate out of bounds")
+			continue
+		}
+
+		p.tickers[symbol] = types.TickerPrice{
+			Price:  rate,
+			Volume: sdk.OneDec(),
+			Time:   timestamp,
+		}
+	}
+	p.logger.Debug().Msg("updated tickers")
+	return nil
+}
+
+// strideTranslateHostDenom strips the micro (u) or atto (a) prefix of a host
+// denom, ex.: "uatom" -> "ATOM", "aevmos" -> "EVMOS".
+func strideTranslateHostDenom(denom string) string {
+	if len(denom) > 3 && (strings.HasPrefix(denom, "u") || strings.HasPrefix(denom, "a")) {
+		denom = denom[1:]
+	}
+	return strings.ToUpper(denom)
+}
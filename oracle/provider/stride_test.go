@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStrideProvider_Poll(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		require.Equal(t, "/Stride-Labs/stride/stakeibc/host_zone", req.URL.String())
+		resp := `{
+			"host_zone": [
+				{"chain_id": "cosmoshub-4", "host_denom": "uatom", "redemption_rate": "1.198588819852823521"},
+				{"chain_id": "osmosis-1", "host_denom": "uosmo", "redemption_rate": "2.500000000000000000"},
+				{"chain_id": "evmos_9001-2", "host_denom": "aevmos", "redemption_rate": "0.900000000000000000"},
+				{"chain_id": "celestia", "host_denom": "utia", "redemption_rate": "1.020000000000000000", "halted": true}
+			],
+			"pagination": {"next_key": null, "total": "4"}
+		}`
+		rw.Write([]byte(resp))
+	}))
+	defer server.Close()
+
+	statom := types.CurrencyPair{Base: "STATOM", Quote: "ATOM"}
+	stosmo := types.CurrencyPair{Base: "STOSMO", Quote: "OSMO"}
+	stevmos := types.CurrencyPair{Base: "STEVMOS", Quote: "EVMOS"}
+	sttia := types.CurrencyPair{Base: "STTIA", Quote: "TIA"}
+
+	p, err := NewStrideProvider(
+		context.TODO(),
+		zerolog.Nop(),
+		Endpoint{
+			Name:         ProviderStride,
+			Urls:         []string{server.URL},
+			PollInterval: time.Hour,
+		},
+		statom, stosmo, stevmos, sttia,
+	)
+	require.NoError(t, err)
+	require.NoError(t, p.Poll())
+
+	prices, err := p.GetTickerPrices(statom, stosmo, stevmos, sttia)
+	require.NoError(t, err)
+	require.Len(t, prices, 1)
+	require.Equal(t, sdk.MustNewDecFromStr("1.198588819852823521"), prices["STATOMATOM"].Price)
+}
+
+func TestRedemptionRateBand(t *testing.T) {
+	require.True(t, isRedemptionRateValid(sdk.MustNewDecFromStr("1.0")))
+	require.True(t, isRedemptionRateValid(sdk.MustNewDecFromStr("1.25")))
+	require.True(t, isRedemptionRateValid(sdk.MustNewDecFromStr("2.0")))
+	require.False(t, isRedemptionRateValid(sdk.MustNewDecFromStr("0.99")))
+	require.False(t, isRedemptionRateValid(sdk.MustNewDecFromStr("2.01")))
+}
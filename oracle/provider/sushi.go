@@ -0,0 +1,254 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+)
+
+var (
+	_                     Provider = (*SushiProvider)(nil)
+	sushiDefaultEndpoints          = Endpoint{
+		Name: ProviderSushi,
+		Urls: []string{
+			"https://cloudflare-eth.com",
+			"https://arb1.arbitrum.io/rpc",
+		},
+		PollInterval: 15 * time.Second,
+	}
+)
+
+type (
+	// SushiProvider defines an oracle provider reading the reserves of
+	// SushiSwap pairs on several EVM chains, so that a pair keeps being
+	// priced while the RPC of a chain is down. The `urls` of the provider
+	// endpoints list the JSON-RPC endpoints of the chains, whose chain ids
+	// are requested once. The `contracts` map the denoms to their token
+	// addresses and the pairs to their pair contract addresses as a comma
+	// separated list of "chainId:address", ex.:
+	// {"WETH": "1:0xc02a...,42161:0x82af...", "WETHUSDC": "1:0x397f...,42161:0x905d..."}.
+	// The reserves of the pairs on all available chains are summed up, which
+	// weights their prices by liquidity, and the base token reserve is
+	// reported as volume. Decimals set in `decimals` apply to all chains.
+	//
+	// REF: https://docs.sushi.com/contracts/cpamm
+	SushiProvider struct {
+		provider
+		decimals *evmDecimalsCache
+
+		chainsMtx sync.Mutex
+		// chains maps the chain ids to their JSON-RPC endpoints.
+		chains map[string]string
+	}
+)
+
+func NewSushiProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*SushiProvider, error) {
+	provider := &SushiProvider{
+		decimals: newEvmDecimalsCache(),
+		chains:   map[string]string{},
+	}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		nil,
+		nil,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
+
+func (p *SushiProvider) Poll() error {
+	chains := p.getChains()
+	if len(chains) == 0 {
+		return fmt.Errorf("no chain available")
+	}
+
+	for symbol, pair := range p.pairs {
+		ticker, err := p.getTicker(chains, pair)
+		if err != nil {
+			p.logger.Warn().Err(err).Str("pair", symbol).Msg("failed to get reserves")
+			continue
+		}
+
+		p.mtx.Lock()
+		p.tickers[symbol] = ticker
+		p.mtx.Unlock()
+	}
+
+	p.logger.Debug().Msg("updated tickers")
+	return nil
+}
+
+// getChains returns the JSON-RPC endpoints by chain id, requesting the chain
+// ids of the endpoints which weren't resolved yet.
+func (p *SushiProvider) getChains() map[string]string {
+	p.chainsMtx.Lock()
+	defer p.chainsMtx.Unlock()
+
+	if len(p.chains) < len(p.endpoints.Urls) {
+		resolved := map[string]struct{}{}
+		for _, url := range p.chains {
+			resolved[url] = struct{}{}
+		}
+		for _, url := range p.endpoints.Urls {
+			if _, ok := resolved[url]; ok {
+				continue
+			}
+			chainId, err := p.getChainId(url)
+			if err != nil {
+				p.logger.Warn().Err(err).Str("url", url).Msg("failed to get chain id")
+				continue
+			}
+			p.chains[chainId] = url
+		}
+	}
+
+	chains := make(map[string]string, len(p.chains))
+	for chainId, url := range p.chains {
+		chains[chainId] = url
+	}
+	return chains
+}
+
+func (p *SushiProvider) getChainId(url string) (string, error) {
+	body, err := json.Marshal(EvmRpcRequest{
+		JsonRpc: "2.0",
+		Id:      1,
+		Method:  "eth_chainId",
+		Params:  []interface{}{},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	content, err := p.makeHttpPost(url, body)
+	if err != nil {
+		return "", err
+	}
+
+	var response EvmRpcResponse
+	err = json.Unmarshal(content, &response)
+	if err != nil {
+		return "", err
+	}
+	if response.Error != nil {
+		return "", response.Error
+	}
+
+	chainId, ok := new(big.Int).SetString(strings.TrimPrefix(response.Result, "0x"), 16)
+	if !ok {
+		return "", fmt.Errorf("invalid chain id: %s", response.Result)
+	}
+	return chainId.String(), nil
+}
+
+func (p *SushiProvider) getTicker(chains map[string]string, pair types.CurrencyPair) (types.TickerPrice, error) {
+	pools, err := p.getContracts(pair.String())
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+	bases, err := p.getContracts(pair.Base)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+	quotes, err := p.getContracts(pair.Quote)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	baseAmount, quoteAmount := sdk.ZeroDec(), sdk.ZeroDec()
+	for chainId, address := range pools {
+		url, ok := chains[chainId]
+		if !ok {
+			p.logger.Warn().Str("pair", pair.String()).Str("chain", chainId).Msg("chain not available")
+			continue
+		}
+		base, ok := bases[chainId]
+		if !ok {
+			p.logger.Warn().Str("chain", chainId).Msgf("no contract configured for %s", pair.Base)
+			continue
+		}
+		quote, ok := quotes[chainId]
+		if !ok {
+			p.logger.Warn().Str("chain", chainId).Msgf("no contract configured for %s", pair.Quote)
+			continue
+		}
+
+		baseReserve, quoteReserve, err := p.getReserves(url, address, pair, base, quote)
+		if err != nil {
+			p.logger.Warn().Err(err).Str("pair", pair.String()).Str("chain", chainId).Msg("failed to get reserves")
+			continue
+		}
+		baseAmount = baseAmount.Add(baseReserve)
+		quoteAmount = quoteAmount.Add(quoteReserve)
+	}
+
+	if !baseAmount.IsPositive() {
+		return types.TickerPrice{}, fmt.Errorf("no pair of %s available", pair.String())
+	}
+
+	return types.TickerPrice{
+		Price:  quoteAmount.Quo(baseAmount),
+		Volume: baseAmount,
+		Time:   time.Now(),
+	}, nil
+}
+
+// getReserves returns the reserves of the pair on the chain of the url scaled
+// by the decimals of their tokens.
+func (p *SushiProvider) getReserves(
+	url, address string,
+	pair types.CurrencyPair,
+	base, quote string,
+) (sdk.Dec, sdk.Dec, error) {
+	baseReserve, quoteReserve, err := uniswapV2GetReserves(&p.provider, url, address, base, quote)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+
+	baseDecimals, err := p.decimals.getUrl(&p.provider, url, pair.Base, base)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+	quoteDecimals, err := p.decimals.getUrl(&p.provider, url, pair.Quote, quote)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+
+	return bigIntToDec(baseReserve, baseDecimals), bigIntToDec(quoteReserve, quoteDecimals), nil
+}
+
+// getContracts returns the contracts configured for the denom or pair by
+// chain id.
+func (p *SushiProvider) getContracts(key string) (map[string]string, error) {
+	contracts, ok := p.endpoints.Contracts[key]
+	if !ok {
+		return nil, fmt.Errorf("no contract configured for %s", key)
+	}
+
+	parsed := map[string]string{}
+	for _, contract := range strings.Split(contracts, ",") {
+		parts := strings.Split(strings.TrimSpace(contract), ":")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid contract: %s", contract)
+		}
+		parsed[parts[0]] = strings.ToLower(parts[1])
+	}
+	return parsed, nil
+}
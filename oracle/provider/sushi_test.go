@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"testing"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+// newSushiTestServer returns a JSON-RPC server of the chain answering
+// getReserves calls of the pair with the reserves.
+func newSushiTestServer(chainId int64, pair string, reserve0, reserve1 *big.Int) *testServer {
+	return newTestServer(func(rw http.ResponseWriter, req *http.Request) {
+		var request EvmRpcRequest
+		if err := json.NewDecoder(req.Body).Decode(&request); err != nil {
+			rw.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		response := `{"jsonrpc":"2.0","id":1,"error":{"code":3,"message":"execution reverted"}}`
+		switch request.Method {
+		case "eth_chainId":
+			response = fmt.Sprintf(`{"jsonrpc":"2.0","id":1,"result":"0x%x"}`, chainId)
+		case "eth_call":
+			bz, _ := json.Marshal(request.Params[0])
+			var params EvmCallParams
+			_ = json.Unmarshal(bz, &params)
+			if params.To == pair && params.Data == "0x0902f1ac" {
+				result := append(evmEncodeInt(reserve0), evmEncodeInt(reserve1)...)
+				result = append(result, evmEncodeInt(big.NewInt(1700000000))...)
+				response = `{"jsonrpc":"2.0","id":1,"result":"0x` + hex.EncodeToString(result) + `"}`
+			}
+		}
+		_, _ = rw.Write([]byte(response))
+	})
+}
+
+func TestSushiProvider_Poll(t *testing.T) {
+	const (
+		ethereumPair = "0x397ff1542f962076d0bfe58ea045ffa2d347aca0"
+		arbitrumPair = "0x905dfcd5649217c42684f23958568e533c711aa3"
+	)
+
+	weth := func(amount int64) *big.Int {
+		return new(big.Int).Mul(big.NewInt(amount), big.NewInt(1e18))
+	}
+	usdc := func(amount int64) *big.Int {
+		return new(big.Int).Mul(big.NewInt(amount), big.NewInt(1e6))
+	}
+
+	// USDC is token0 on Ethereum and WETH on Arbitrum
+	ethereum := newSushiTestServer(1, ethereumPair, usdc(200000), weth(100))
+	defer ethereum.Close()
+	arbitrum := newSushiTestServer(42161, arbitrumPair, weth(300), usdc(660000))
+	defer arbitrum.Close()
+	down := newTestServer(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(http.StatusServiceUnavailable)
+	})
+	defer down.Close()
+
+	wethUsdc := types.CurrencyPair{Base: "WETH", Quote: "USDC"}
+
+	p := newTestProvider(t, NewSushiProvider, ethereum, Endpoint{
+		Name: ProviderSushi,
+		Urls: []string{ethereum.URL, down.URL, arbitrum.URL},
+		Contracts: map[string]string{
+			"WETH":     "1:0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2, 42161:0x82af49447d8a07e3bd95bd0d56f35241523fbab1",
+			"USDC":     "1:0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48, 42161:0xaf88d065e77c8cc2239327c5edb3a432268e5831",
+			"WETHUSDC": "1:" + ethereumPair + ", 42161:" + arbitrumPair + ", 10:0xd25711edfbf747efce181442cc1d8f5f8fc8a0d3",
+		},
+		Decimals: map[string]int64{"WETH": 18, "USDC": 6},
+	}, wethUsdc)
+
+	// the reserves of the available chains are summed up
+	require.NoError(t, p.Poll())
+	require.Equal(t, sdk.MustNewDecFromStr("2150"), p.tickers["WETHUSDC"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("400"), p.tickers["WETHUSDC"].Volume)
+
+	// the chain ids are only requested until they're resolved
+	require.NoError(t, p.Poll())
+	require.Len(t, ethereum.Requests(), 3)
+	require.Len(t, down.Requests(), 2)
+
+	// pairs are still priced while a chain is down
+	arbitrum.Close()
+	require.NoError(t, p.Poll())
+	require.Equal(t, sdk.MustNewDecFromStr("2000"), p.tickers["WETHUSDC"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("100"), p.tickers["WETHUSDC"].Volume)
+}
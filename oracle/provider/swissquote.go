@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+)
+
+const (
+	// swissquoteSpreadProfile defines the spread profile with the tightest
+	// spreads, other profiles are only used if it's missing.
+	swissquoteSpreadProfile = "prime"
+)
+
+var (
+	_                          Provider = (*SwissquoteProvider)(nil)
+	swissquoteDefaultEndpoints          = Endpoint{
+		Name:         ProviderSwissquote,
+		Urls:         []string{"https://forex-data-feed.swissquote.com"},
+		PollInterval: 10 * time.Second,
+	}
+)
+
+type (
+	// SwissquoteProvider defines an oracle provider polling the public forex
+	// feed of Swissquote, which quotes the spot prices of precious metals,
+	// ex.: "XAUUSD" or "XAGUSD", as well as fiat currencies. The price is the
+	// mid price of the latest quote and its bid/ask spread is reported. The
+	// quotes don't have a volume, so their tickers are reported with a
+	// volume of one.
+	SwissquoteProvider struct {
+		provider
+	}
+
+	SwissquoteQuote struct {
+		Prices    []SwissquotePrice `json:"spreadProfilePrices"`
+		Timestamp int64             `json:"ts"` // ex.: 1700000000000
+	}
+
+	SwissquotePrice struct {
+		SpreadProfile string  `json:"spreadProfile"` // ex.: "prime"
+		Bid           float64 `json:"bid"`           // ex.: 1962.21
+		Ask           float64 `json:"ask"`           // ex.: 1962.57
+	}
+)
+
+func NewSwissquoteProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*SwissquoteProvider, error) {
+	provider := &SwissquoteProvider{}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		nil,
+		nil,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
+
+func (p *SwissquoteProvider) Poll() error {
+	for symbol, pair := range p.pairs {
+		ticker, err := p.getTicker(pair)
+		if err != nil {
+			p.logger.Warn().Err(err).Str("pair", symbol).Msg("failed to get quote")
+			continue
+		}
+
+		p.mtx.Lock()
+		p.tickers[symbol] = ticker
+		p.mtx.Unlock()
+	}
+
+	p.logger.Debug().Msg("updated tickers")
+	return nil
+}
+
+func (p *SwissquoteProvider) getTicker(pair types.CurrencyPair) (types.TickerPrice, error) {
+	path := "/public-quotes/bboquotes/instrument/" + pair.Base + "/" + pair.Quote
+	content, err := p.httpGet(path)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	// the instrument is quoted by several trading platforms
+	var quotes []SwissquoteQuote
+	err = json.Unmarshal(content, &quotes)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	var latest *SwissquoteQuote
+	for i, quote := range quotes {
+		if len(quote.Prices) == 0 {
+			continue
+		}
+		if latest == nil || quote.Timestamp > latest.Timestamp {
+			latest = &quotes[i]
+		}
+	}
+	if latest == nil {
+		return types.TickerPrice{}, fmt.Errorf("no quotes received")
+	}
+
+	price := latest.Prices[0]
+	for _, profile := range latest.Prices {
+		if profile.SpreadProfile == swissquoteSpreadProfile {
+			price = profile
+		}
+	}
+	if price.Bid <= 0 || price.Ask < price.Bid {
+		return types.TickerPrice{}, fmt.Errorf("invalid quote: %f/%f", price.Bid, price.Ask)
+	}
+
+	bid := floatToDec(price.Bid)
+	ask := floatToDec(price.Ask)
+	return types.TickerPrice{
+		Price:  bid.Add(ask).QuoInt64(2),
+		Volume: sdk.OneDec(),
+		Time:   time.UnixMilli(latest.Timestamp),
+		Spread: computeSpread(bid, ask),
+	}, nil
+}
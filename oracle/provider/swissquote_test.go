@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSwissquoteProvider_Poll(t *testing.T) {
+	server := newTestServer(func(rw http.ResponseWriter, req *http.Request) {
+		var response string
+		switch req.URL.Path {
+		case "/public-quotes/bboquotes/instrument/XAU/USD":
+			response = `[
+				{"topo":{"platform":"AT"},"spreadProfilePrices":[{"spreadProfile":"prime","bid":1960,"ask":1961}],"ts":1700000000000},
+				{"topo":{"platform":"MT5"},"spreadProfilePrices":[
+					{"spreadProfile":"standard","bid":1961,"ask":1964},
+					{"spreadProfile":"prime","bid":1962,"ask":1963}
+				],"ts":1700000001000}
+			]`
+		case "/public-quotes/bboquotes/instrument/XAG/USD":
+			response = `[{"topo":{"platform":"AT"},"spreadProfilePrices":[{"spreadProfile":"standard","bid":23.1,"ask":23.0}],"ts":1700000000000}]`
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = rw.Write([]byte(response))
+	})
+	defer server.Close()
+
+	xauUsd := types.CurrencyPair{Base: "XAU", Quote: "USD"}
+	xagUsd := types.CurrencyPair{Base: "XAG", Quote: "USD"}
+
+	p := newTestProvider(t, NewSwissquoteProvider, server, Endpoint{
+		Name: ProviderSwissquote,
+	}, xauUsd, xagUsd)
+
+	require.NoError(t, p.Poll())
+	require.Equal(t, sdk.MustNewDecFromStr("1962.5"), p.tickers["XAUUSD"].Price)
+	require.Equal(t, sdk.NewDec(1).Quo(sdk.MustNewDecFromStr("1962.5")), p.tickers["XAUUSD"].Spread)
+	require.Equal(t, int64(1700000001000), p.tickers["XAUUSD"].Time.UnixMilli())
+	// crossed quotes are rejected
+	require.NotContains(t, p.tickers, "XAGUSD")
+}
@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// defaultSymbolsTTL defines how long the symbols discovered from a provider's
+// listing endpoint are used before being refreshed.
+const defaultSymbolsTTL = 1 * time.Hour
+
+// symbolsMinAge defines how old the cached symbols have to be before an
+// invalidation refreshes them, so that a configured pair which isn't listed
+// doesn't trigger a refresh on every poll.
+const symbolsMinAge = 5 * time.Minute
+
+type (
+	// symbolCache caches the symbols discovered from a provider's listing
+	// endpoint, ex.: {"BTCUSD": "XXBTZUSD"}. Once expired or invalidated, the
+	// cached symbols are refreshed in the background.
+	symbolCache struct {
+		mtx        sync.RWMutex
+		logger     zerolog.Logger
+		ttl        time.Duration
+		minAge     time.Duration
+		fetch      symbolFetcher
+		symbols    map[string]string
+		fetched    time.Time
+		expiry     time.Time
+		refreshing bool
+	}
+
+	// symbolFetcher returns the symbols of a provider's listing endpoint.
+	symbolFetcher func() (map[string]string, error)
+)
+
+// newSymbolCache creates a symbol cache and fetches the symbols once, returning
+// an error if the initial discovery fails.
+func newSymbolCache(
+	logger zerolog.Logger,
+	ttl time.Duration,
+	fetch symbolFetcher,
+) (*symbolCache, error) {
+	c := &symbolCache{
+		logger: logger,
+		ttl:    ttl,
+		minAge: symbolsMinAge,
+		fetch:  fetch,
+	}
+	symbols, err := fetch()
+	if err != nil {
+		return nil, err
+	}
+	c.symbols = symbols
+	c.fetched = time.Now()
+	c.expiry = c.fetched.Add(ttl)
+	return c, nil
+}
+
+// Symbols returns the cached symbols, starting a background refresh if they
+// are expired.
+func (c *symbolCache) Symbols() map[string]string {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if !c.refreshing && time.Now().After(c.expiry) {
+		c.refreshing = true
+		go c.refresh()
+	}
+	return c.symbols
+}
+
+// Invalidate expires the cached symbols, ex.: after a symbol wasn't found, so
+// the next call to Symbols picks up new listings. Symbols fetched less than
+// symbolsMinAge ago are kept.
+func (c *symbolCache) Invalidate() {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if time.Since(c.fetched) < c.minAge {
+		return
+	}
+	c.expiry = time.Time{}
+}
+
+func (c *symbolCache) refresh() {
+	symbols, err := c.fetch()
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.refreshing = false
+	if err != nil {
+		c.logger.Warn().Err(err).Msg("failed to refresh symbols")
+		return
+	}
+	c.symbols = symbols
+	c.fetched = time.Now()
+	c.expiry = c.fetched.Add(c.ttl)
+	c.logger.Debug().Int("symbols", len(symbols)).Msg("refreshed symbols")
+}
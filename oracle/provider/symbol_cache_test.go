@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSymbolCache_Refresh(t *testing.T) {
+	var hits int32
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/0/public/AssetPairs":
+			atomic.AddInt32(&hits, 1)
+			rw.Write([]byte(`{"result": {"XXBTZUSD": {"wsname": "XBT/USD"}}}`))
+		default:
+			rw.Write([]byte(`{"result": {}}`))
+		}
+	}))
+	defer server.Close()
+
+	ttl := 200 * time.Millisecond
+	p, err := NewKrakenProvider(
+		context.TODO(),
+		zerolog.Nop(),
+		Endpoint{
+			Name:         ProviderKraken,
+			Urls:         []string{server.URL},
+			PollInterval: time.Hour,
+			SymbolsTTL:   ttl,
+		},
+	)
+	require.NoError(t, err)
+
+	// within the ttl, the cached symbols are used
+	require.Equal(t, map[string]string{"BTCUSD": "XXBTZUSD"}, p.symbols.Symbols())
+	require.Equal(t, int32(1), atomic.LoadInt32(&hits))
+
+	// once expired, the symbols are refreshed in the background
+	time.Sleep(ttl)
+	require.Eventually(t, func() bool {
+		p.symbols.Symbols()
+		return atomic.LoadInt32(&hits) == 2
+	}, time.Second, 10*time.Millisecond)
+
+	// invalidating recently fetched symbols keeps them
+	p.symbols.Invalidate()
+	for i := 0; i < 5; i++ {
+		p.symbols.Symbols()
+	}
+	time.Sleep(50 * time.Millisecond)
+	require.Equal(t, int32(2), atomic.LoadInt32(&hits))
+
+	// invalidating older symbols refreshes them on the next call
+	p.symbols.mtx.Lock()
+	p.symbols.minAge = 0
+	p.symbols.mtx.Unlock()
+	p.symbols.Invalidate()
+	require.Eventually(t, func() bool {
+		p.symbols.Symbols()
+		return atomic.LoadInt32(&hits) == 3
+	}, time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	"github.com/rs/zerolog"
+)
+
+const (
+	// terraswapFactory defines the address of the Terraswap factory on
+	// phoenix-1.
+	terraswapFactory = "terra1466nf3zuxpya8q9emxukd7vftaf6h4psr0a07srl5zw74zh84yjqxl5qul"
+)
+
+var (
+	_                         Provider = (*TerraswapProvider)(nil)
+	terraswapDefaultEndpoints          = Endpoint{
+		Name:         ProviderTerraswap,
+		Urls:         []string{"https://rest.cosmos.directory/terra2"},
+		PollInterval: 10 * time.Second,
+	}
+)
+
+type (
+	// TerraswapProvider defines an oracle provider querying Terraswap pair
+	// contracts on phoenix-1 directly using the REST endpoint of a node.
+	// Astroport is a fork of Terraswap, so the pairs are queried like the
+	// ones of the AstroportProvider. Pairs without a configured contract are
+	// resolved using the Terraswap factory, so only the denoms have to be
+	// configured in the `contracts` of the provider endpoints.
+	//
+	// REF: https://docs.terraswap.io/docs/contract_resources/factory
+	TerraswapProvider struct {
+		AstroportProvider
+	}
+)
+
+func NewTerraswapProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*TerraswapProvider, error) {
+	provider := &TerraswapProvider{}
+	provider.factory = terraswapFactory
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		nil,
+		nil,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
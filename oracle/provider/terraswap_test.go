@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTerraswapProvider_Poll(t *testing.T) {
+	const (
+		pair  = "terra1pair"
+		astro = "terra1nsuqsk6kh58ulczatwev87ttq2z6r3pusulg9r24mfj2fvtzd4uq3exn26"
+	)
+
+	server := newWasmTestServer(func(contract string, query map[string]json.RawMessage) interface{} {
+		switch {
+		case contract == terraswapFactory && query["pair"] != nil:
+			return AstroportPairResponse{ContractAddr: pair}
+		case contract == pair && query["pool"] != nil:
+			return AstroportPoolResponse{Assets: []CosmwasmAsset{
+				{Info: newCosmwasmAssetInfo("uluna"), Amount: "1000000000"},
+				{Info: newCosmwasmAssetInfo(cosmwasmTokenPrefix + astro), Amount: "40000000000"},
+			}}
+		case contract == pair && query["simulation"] != nil:
+			return AstroportSimulationResponse{
+				ReturnAmount:     "24900",
+				SpreadAmount:     "25",
+				CommissionAmount: "75",
+			}
+		}
+		return nil
+	})
+	defer server.Close()
+
+	astroLuna := types.CurrencyPair{Base: "ASTRO", Quote: "LUNA"}
+
+	p := newTestProvider(t, NewTerraswapProvider, server, Endpoint{
+		Name:      ProviderTerraswap,
+		Contracts: map[string]string{"ASTRO": cosmwasmTokenPrefix + astro, "LUNA": "uluna"},
+	}, astroLuna)
+
+	// the pair contract is resolved once
+	require.NoError(t, p.Poll())
+	require.NoError(t, p.Poll())
+	factoryQueries := 0
+	for _, query := range wasmTestQueries(t, server) {
+		if query.contract == terraswapFactory {
+			factoryQueries++
+			require.JSONEq(t, `{"asset_infos":[
+				{"token":{"contract_addr":"`+astro+`"}},
+				{"native_token":{"denom":"uluna"}}
+			]}`, string(query.query["pair"]))
+		}
+	}
+	require.Equal(t, 1, factoryQueries)
+	require.Equal(t, sdk.MustNewDecFromStr("0.025"), p.tickers["ASTROLUNA"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("40000"), p.tickers["ASTROLUNA"].Volume)
+}
@@ -0,0 +1,185 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+)
+
+const (
+	// thorchainDecimals defines the decimals of all depths and volumes.
+	thorchainDecimals = 8
+)
+
+var (
+	_                         Provider = (*ThorchainProvider)(nil)
+	thorchainDefaultEndpoints          = Endpoint{
+		Name:         ProviderThorchain,
+		Urls:         []string{"https://midgard.ninerealms.com", "https://midgard.thorswap.net"},
+		PollInterval: 15 * time.Second,
+	}
+
+	// thorchainDefaultPools maps common denoms to their pools, additional
+	// pools are configured as `contracts`.
+	thorchainDefaultPools = map[string]string{
+		"ATOM": "GAIA.ATOM",
+		"AVAX": "AVAX.AVAX",
+		"BCH":  "BCH.BCH",
+		"BNB":  "BSC.BNB",
+		"BTC":  "BTC.BTC",
+		"DOGE": "DOGE.DOGE",
+		"ETH":  "ETH.ETH",
+		"LTC":  "LTC.LTC",
+	}
+)
+
+type (
+	// ThorchainProvider defines an oracle provider reading the pools of
+	// THORChain from Midgard. The prices in RUNE are derived from the depths
+	// of the pools, while the USD prices are the ones Midgard derives from
+	// the stablecoin pools. The `contracts` of the provider endpoints map the
+	// denoms to their pools, ex.: {"ATOM": "GAIA.ATOM"}. The 24h swap volume
+	// of the pools is reported as volume, and the RUNE volume is the one of
+	// all pools.
+	//
+	// REF: https://midgard.ninerealms.com/v2/doc
+	ThorchainProvider struct {
+		provider
+	}
+
+	ThorchainPool struct {
+		Asset         string `json:"asset"`         // ex.: "BTC.BTC"
+		Status        string `json:"status"`        // ex.: "available"
+		AssetDepth    string `json:"assetDepth"`    // ex.: "91257625617"
+		RuneDepth     string `json:"runeDepth"`     // ex.: "1311062339185519"
+		AssetPriceUSD string `json:"assetPriceUSD"` // ex.: "61846.34"
+		Volume24h     string `json:"volume24h"`     // ex.: "1530093911389516"
+	}
+
+	ThorchainStats struct {
+		RunePriceUSD string `json:"runePriceUSD"` // ex.: "4.31"
+	}
+)
+
+func NewThorchainProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*ThorchainProvider, error) {
+	provider := &ThorchainProvider{}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		nil,
+		nil,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
+
+func (p *ThorchainProvider) Poll() error {
+	content, err := p.httpGet("/v2/pools?status=available")
+	if err != nil {
+		return err
+	}
+
+	var pools []ThorchainPool
+	err = json.Unmarshal(content, &pools)
+	if err != nil {
+		return err
+	}
+
+	content, err = p.httpGet("/v2/stats")
+	if err != nil {
+		return err
+	}
+
+	var stats ThorchainStats
+	err = json.Unmarshal(content, &stats)
+	if err != nil {
+		return err
+	}
+
+	runeVolume := sdk.ZeroDec()
+	tickers := map[string]types.TickerPrice{}
+	for _, pool := range pools {
+		assetDepth, err := cosmosParseAmount(pool.AssetDepth)
+		if err != nil || assetDepth.Sign() == 0 {
+			continue
+		}
+		runeDepth, err := cosmosParseAmount(pool.RuneDepth)
+		if err != nil {
+			continue
+		}
+		volume, err := cosmosParseAmount(pool.Volume24h)
+		if err != nil {
+			continue
+		}
+		// the volume is denominated in RUNE
+		runeVolume = runeVolume.Add(bigIntToDec(volume, thorchainDecimals))
+
+		priceRune := bigIntToDec(runeDepth, thorchainDecimals).Quo(bigIntToDec(assetDepth, thorchainDecimals))
+		if !priceRune.IsPositive() {
+			continue
+		}
+		baseVolume := bigIntToDec(volume, thorchainDecimals).Quo(priceRune)
+
+		for symbol, pair := range p.pairs {
+			if p.getPool(pair.Base) != pool.Asset {
+				continue
+			}
+			var price sdk.Dec
+			switch pair.Quote {
+			case "RUNE":
+				price = priceRune
+			case "USD":
+				if pool.AssetPriceUSD == "" {
+					continue
+				}
+				price = strToDec(pool.AssetPriceUSD)
+			default:
+				continue
+			}
+			tickers[symbol] = types.TickerPrice{
+				Price:  price,
+				Volume: baseVolume,
+				Time:   time.Now(),
+			}
+		}
+	}
+
+	for symbol, pair := range p.pairs {
+		if pair.Base != "RUNE" || pair.Quote != "USD" || stats.RunePriceUSD == "" {
+			continue
+		}
+		tickers[symbol] = types.TickerPrice{
+			Price:  strToDec(stats.RunePriceUSD),
+			Volume: runeVolume,
+			Time:   time.Now(),
+		}
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	for symbol, ticker := range tickers {
+		p.tickers[symbol] = ticker
+	}
+	p.logger.Debug().Msg("updated tickers")
+	return nil
+}
+
+// getPool returns the configured or default pool of the denom.
+func (p *ThorchainProvider) getPool(denom string) string {
+	if pool, ok := p.endpoints.Contracts[denom]; ok {
+		return pool
+	}
+	return thorchainDefaultPools[denom]
+}
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestThorchainProvider_Poll(t *testing.T) {
+	server := newTestServer(func(rw http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/v2/pools":
+			_, _ = rw.Write([]byte(`[
+				{"asset":"BTC.BTC","status":"available","assetDepth":"10000000000","runeDepth":"150000000000000","assetPriceUSD":"60000","volume24h":"300000000000000"},
+				{"asset":"GAIA.ATOM","status":"available","assetDepth":"100000000000000","runeDepth":"200000000000000","assetPriceUSD":"8","volume24h":"100000000000000"}
+			]`))
+		case "/v2/stats":
+			_, _ = rw.Write([]byte(`{"runePriceUSD":"4"}`))
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer server.Close()
+
+	pairs := []types.CurrencyPair{
+		{Base: "BTC", Quote: "RUNE"},
+		{Base: "BTC", Quote: "USD"},
+		{Base: "ATOM", Quote: "USD"},
+		{Base: "RUNE", Quote: "USD"},
+	}
+
+	p := newTestProvider(t, NewThorchainProvider, server, Endpoint{
+		Name: ProviderThorchain,
+	}, pairs...)
+
+	require.NoError(t, p.Poll())
+	for _, req := range server.Requests() {
+		if req.URL.Path == "/v2/pools" {
+			require.Equal(t, "available", req.URL.Query().Get("status"))
+		}
+	}
+	require.Len(t, p.tickers, 4)
+
+	require.Equal(t, sdk.MustNewDecFromStr("15000"), p.tickers["BTCRUNE"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("200"), p.tickers["BTCRUNE"].Volume)
+	require.Equal(t, sdk.MustNewDecFromStr("60000"), p.tickers["BTCUSD"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("8"), p.tickers["ATOMUSD"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("500000"), p.tickers["ATOMUSD"].Volume)
+	require.Equal(t, sdk.MustNewDecFromStr("4"), p.tickers["RUNEUSD"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("4000000"), p.tickers["RUNEUSD"].Volume)
+}
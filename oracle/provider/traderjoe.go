@@ -0,0 +1,191 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	"github.com/rs/zerolog"
+)
+
+const (
+	// traderJoeRealIdShift defines the id of the bin with a price of one.
+	traderJoeRealIdShift = 1 << 23
+)
+
+var (
+	_                         Provider = (*TraderJoeProvider)(nil)
+	traderJoeDefaultEndpoints          = Endpoint{
+		Name:         ProviderTraderJoe,
+		Urls:         []string{"https://api.avax.network/ext/bc/C/rpc"},
+		PollInterval: 15 * time.Second,
+	}
+)
+
+type (
+	// TraderJoeProvider defines an oracle provider reading the active bin
+	// price of Trader Joe (LFJ) Liquidity Book pairs using the JSON-RPC
+	// endpoint of Avalanche, Arbitrum or any other EVM chain they're deployed
+	// on. The `contracts` of the provider endpoints map the denoms to their
+	// token addresses and the pairs to their LB pair addresses. The base token
+	// reserve of the pair is reported as volume.
+	//
+	// REF: https://docs.lfj.gg/guides/price-from-id
+	TraderJoeProvider struct {
+		provider
+		decimals *evmDecimalsCache
+		// tokensX caches the address of token X of the pairs
+		tokensX    map[string]string
+		tokensXMtx sync.Mutex
+	}
+)
+
+func NewTraderJoeProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*TraderJoeProvider, error) {
+	provider := &TraderJoeProvider{
+		decimals: newEvmDecimalsCache(),
+		tokensX:  map[string]string{},
+	}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		nil,
+		nil,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
+
+func (p *TraderJoeProvider) Poll() error {
+	for symbol, pair := range p.pairs {
+		ticker, err := p.getTicker(pair)
+		if err != nil {
+			p.logger.Warn().Err(err).Str("pair", symbol).Msg("failed to get active bin")
+			continue
+		}
+
+		p.mtx.Lock()
+		p.tickers[symbol] = ticker
+		p.mtx.Unlock()
+	}
+
+	p.logger.Debug().Msg("updated tickers")
+	return nil
+}
+
+func (p *TraderJoeProvider) getTicker(pair types.CurrencyPair) (types.TickerPrice, error) {
+	address, ok := p.endpoints.Contracts[pair.String()]
+	if !ok {
+		return types.TickerPrice{}, fmt.Errorf("no contract configured for %s", pair.String())
+	}
+	base, ok := p.endpoints.Contracts[pair.Base]
+	if !ok {
+		return types.TickerPrice{}, fmt.Errorf("no contract configured for %s", pair.Base)
+	}
+	quote, ok := p.endpoints.Contracts[pair.Quote]
+	if !ok {
+		return types.TickerPrice{}, fmt.Errorf("no contract configured for %s", pair.Quote)
+	}
+	base = strings.ToLower(base)
+	quote = strings.ToLower(quote)
+
+	tokenX, err := p.getTokenX(address)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+	if tokenX != base && tokenX != quote {
+		return types.TickerPrice{}, fmt.Errorf("pair %s doesn't contain %s", address, pair.String())
+	}
+
+	result, err := p.evmCall(address, evmEncodeCall("getActiveId()"))
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+	activeId, err := evmDecodeUint(result, 0)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	result, err = p.evmCall(address, evmEncodeCall("getBinStep()"))
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+	binStep, err := evmDecodeUint(result, 0)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	result, err = p.evmCall(address, evmEncodeCall("getReserves()"))
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+	reserveIndex := 0
+	if tokenX != base {
+		reserveIndex = 1
+	}
+	reserve, err := evmDecodeUint(result, reserveIndex)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	baseDecimals, err := p.decimals.get(&p.provider, pair.Base, base)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+	quoteDecimals, err := p.decimals.get(&p.provider, pair.Quote, quote)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	price := traderJoeIdToPrice(activeId.Int64(), binStep.Int64(), tokenX != base, baseDecimals-quoteDecimals)
+
+	return types.TickerPrice{
+		Price:  strToDec(strconv.FormatFloat(price, 'f', -1, 64)),
+		Volume: bigIntToDec(reserve, baseDecimals),
+		Time:   time.Now(),
+	}, nil
+}
+
+// traderJoeIdToPrice returns the price of the base token for the bin id,
+// which is the price of token X in token Y. The decimals are the base token
+// decimals minus the quote token decimals.
+func traderJoeIdToPrice(id, binStep int64, inverse bool, decimals int64) float64 {
+	price := math.Pow(1+float64(binStep)/10000, float64(id-traderJoeRealIdShift))
+	if inverse {
+		price = 1 / price
+	}
+	return price * math.Pow(10, float64(decimals))
+}
+
+func (p *TraderJoeProvider) getTokenX(pair string) (string, error) {
+	p.tokensXMtx.Lock()
+	defer p.tokensXMtx.Unlock()
+
+	if tokenX, ok := p.tokensX[pair]; ok {
+		return tokenX, nil
+	}
+
+	result, err := p.evmCall(pair, evmEncodeCall("getTokenX()"))
+	if err != nil {
+		return "", err
+	}
+	tokenX, err := evmDecodeAddress(result, 0)
+	if err != nil {
+		return "", err
+	}
+
+	p.tokensX[pair] = tokenX
+	return tokenX, nil
+}
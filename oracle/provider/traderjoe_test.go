@@ -0,0 +1,15 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTraderJoeIdToPrice(t *testing.T) {
+	require.Equal(t, float64(1), traderJoeIdToPrice(traderJoeRealIdShift, 20, false, 0))
+	require.InDelta(t, 1.10512, traderJoeIdToPrice(traderJoeRealIdShift+100, 10, false, 0), 0.00001)
+
+	// the base token being token Y of the pair
+	require.InDelta(t, 904883e6, traderJoeIdToPrice(traderJoeRealIdShift+100, 10, true, 18-6), 1e6)
+}
@@ -0,0 +1,209 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+)
+
+const (
+	// treasuryRefreshInterval defines how often the yields are requested,
+	// which are only published once per business day.
+	treasuryRefreshInterval = time.Hour
+	// treasuryDefaultMaxAge defines the default age of the latest yields
+	// after which they aren't carried anymore, long enough to cover long
+	// weekends.
+	treasuryDefaultMaxAge = 5 * 24 * time.Hour
+)
+
+var (
+	_                        Provider = (*TreasuryProvider)(nil)
+	treasuryDefaultEndpoints          = Endpoint{
+		Name:         ProviderTreasury,
+		Urls:         []string{"https://home.treasury.gov"},
+		PollInterval: 30 * time.Second,
+	}
+
+	// treasuryDefaultTenors maps the denoms to the columns of their tenors.
+	treasuryDefaultTenors = map[string]string{
+		"UST1M":  "1 Mo",
+		"UST3M":  "3 Mo",
+		"UST6M":  "6 Mo",
+		"UST1Y":  "1 Yr",
+		"UST2Y":  "2 Yr",
+		"UST5Y":  "5 Yr",
+		"UST10Y": "10 Yr",
+		"UST30Y": "30 Yr",
+	}
+
+	// treasuryLocation defines the time zone the yields are published in.
+	treasuryLocation = loadTreasuryLocation()
+)
+
+type (
+	// TreasuryProvider defines an oracle provider reporting the daily par
+	// yield curve rates of US Treasury securities in percent, ex.: 5.27 for
+	// "UST3M", regardless of the quote of the pair. The `contracts` of the
+	// provider endpoints map other denoms to the columns of their tenors,
+	// ex.: {"TBILL": "3 Mo"}. The yields are published once per business
+	// day, so the latest yields are carried on weekends and holidays at
+	// their publication date with the stale flag of their tickers set, until
+	// they're older than the `max_age` of the provider endpoints, which
+	// defaults to five days.
+	//
+	// REF: https://home.treasury.gov/treasury-daily-interest-rate-xml-feed
+	TreasuryProvider struct {
+		provider
+		yields map[string]sdk.Dec
+		// date is the publication date of the yields, while requested is
+		// the time of the last request, successful or not.
+		date      time.Time
+		requested time.Time
+	}
+)
+
+func NewTreasuryProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*TreasuryProvider, error) {
+	provider := &TreasuryProvider{}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		nil,
+		nil,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
+
+func (p *TreasuryProvider) Poll() error {
+	now := time.Now().In(treasuryLocation)
+	if now.Sub(p.requested) > treasuryRefreshInterval {
+		p.requested = now
+		err := p.refreshYields(now.Year())
+		if err != nil {
+			p.logger.Warn().Err(err).Msg("failed to refresh yields")
+		}
+	}
+
+	maxAge := treasuryDefaultMaxAge
+	if p.endpoints.MaxAge != 0 {
+		maxAge = p.endpoints.MaxAge
+	}
+	if now.Sub(p.date) > maxAge {
+		// the carried tickers aren't aged out by the stale tickers cutoff
+		p.mtx.Lock()
+		for symbol := range p.pairs {
+			delete(p.tickers, symbol)
+		}
+		p.mtx.Unlock()
+		return fmt.Errorf("yields of %s are stale", p.date.Format("2006-01-02"))
+	}
+
+	// yields of previous days are carried at their publication date while
+	// no new ones are published
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, treasuryLocation)
+	stale := p.date.Before(today)
+	updated := now
+	if stale {
+		updated = p.date
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	for symbol, pair := range p.pairs {
+		tenor, ok := p.endpoints.Contracts[pair.Base]
+		if !ok {
+			tenor = treasuryDefaultTenors[pair.Base]
+		}
+		yield, ok := p.yields[tenor]
+		if !ok {
+			continue
+		}
+		p.tickers[symbol] = types.TickerPrice{
+			Price:  yield,
+			Volume: sdk.OneDec(),
+			Time:   updated,
+			Stale:  stale,
+		}
+	}
+
+	p.logger.Debug().Bool("stale", stale).Msg("updated yields")
+	return nil
+}
+
+// loadTreasuryLocation returns the New York time zone, or UTC if the time
+// zone database isn't available.
+func loadTreasuryLocation() *time.Location {
+	location, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		return time.UTC
+	}
+	return location
+}
+
+// refreshYields requests the yields of the year, or of the previous year
+// before the first yields of the year are published.
+func (p *TreasuryProvider) refreshYields(year int) error {
+	yields, date, err := p.getYields(year)
+	if err != nil {
+		yields, date, err = p.getYields(year - 1)
+	}
+	if err != nil {
+		return err
+	}
+
+	p.yields = yields
+	p.date = date
+	return nil
+}
+
+func (p *TreasuryProvider) getYields(year int) (map[string]sdk.Dec, time.Time, error) {
+	path := fmt.Sprintf(
+		"/resource-center/data-chart-center/interest-rates/daily-treasury-rates.csv/%d/all?type=daily_treasury_yield_curve&field_tdr_date_value=%d&_format=csv",
+		year, year,
+	)
+	content, err := p.httpGet(path)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	// the rows are sorted by descending date
+	rows, err := csv.NewReader(bytes.NewReader(content)).ReadAll()
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	if len(rows) < 2 {
+		return nil, time.Time{}, fmt.Errorf("no yields published for %d", year)
+	}
+
+	header, latest := rows[0], rows[1]
+	date, err := time.ParseInLocation("01/02/2006", latest[0], treasuryLocation)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	yields := map[string]sdk.Dec{}
+	for i := 1; i < len(header) && i < len(latest); i++ {
+		// tenors without a yield are left empty
+		if _, err := strconv.ParseFloat(latest[i], 64); err != nil {
+			continue
+		}
+		yields[header[i]] = strToDec(latest[i])
+	}
+	return yields, date, nil
+}
@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTreasuryProvider_Poll(t *testing.T) {
+	now := time.Now().In(treasuryLocation)
+	date := now
+	fail := false
+
+	server := newTestServer(func(rw http.ResponseWriter, req *http.Request) {
+		if fail {
+			rw.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = fmt.Fprintf(rw, "Date,\"1 Mo\",\"3 Mo\",\"4 Mo\",\"10 Yr\"\n%s,5.54,5.27,,4.44\n01/02/2006,4.01,4.02,,4.30\n",
+			date.Format("01/02/2006"))
+	})
+	defer server.Close()
+
+	tbillUsd := types.CurrencyPair{Base: "TBILL", Quote: "USD"}
+	ust10yUsd := types.CurrencyPair{Base: "UST10Y", Quote: "USD"}
+	ust4mUsd := types.CurrencyPair{Base: "UST4M", Quote: "USD"}
+
+	p := newTestProvider(t, NewTreasuryProvider, server, Endpoint{
+		Name:      ProviderTreasury,
+		Contracts: map[string]string{"TBILL": "3 Mo", "UST4M": "4 Mo"},
+	}, tbillUsd, ust10yUsd, ust4mUsd)
+
+	require.NoError(t, p.Poll())
+	requests := server.Requests()
+	require.Len(t, requests, 1)
+	require.Equal(t, strconv.Itoa(now.Year()), requests[0].URL.Query().Get("field_tdr_date_value"))
+	require.Equal(t, sdk.MustNewDecFromStr("5.27"), p.tickers["TBILLUSD"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("4.44"), p.tickers["UST10YUSD"].Price)
+	require.False(t, p.tickers["TBILLUSD"].Stale)
+	require.NotContains(t, p.tickers, "UST4MUSD")
+
+	// yields of previous days are carried
+	date = now.AddDate(0, 0, -3)
+	p.requested = time.Time{}
+	require.NoError(t, p.Poll())
+	require.True(t, p.tickers["TBILLUSD"].Stale)
+	require.Equal(t, date.Format("2006-01-02"), p.tickers["TBILLUSD"].Time.Format("2006-01-02"))
+
+	// carried yields aren't dropped as stale tickers
+	tickers, err := p.GetTickerPrices(tbillUsd)
+	require.NoError(t, err)
+	require.Contains(t, tickers, "TBILLUSD")
+
+	// a failed refresh isn't retried before the refresh interval, the
+	// yields of the current and the previous year being requested once
+	fail = true
+	p.requested = time.Time{}
+	require.NoError(t, p.Poll())
+	require.NoError(t, p.Poll())
+	require.Len(t, server.Requests(), 4)
+
+	// yields older than the max age aren't reported anymore
+	p.date = now.AddDate(0, 0, -6)
+	require.Error(t, p.Poll())
+	tickers, err = p.GetTickerPrices(tbillUsd)
+	require.NoError(t, err)
+	require.NotContains(t, tickers, "TBILLUSD")
+}
@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	"github.com/rs/zerolog"
+)
+
+var (
+	_                         Provider = (*UniswapV2Provider)(nil)
+	uniswapV2DefaultEndpoints          = Endpoint{
+		Name:         ProviderUniswapV2,
+		Urls:         []string{"https://cloudflare-eth.com"},
+		PollInterval: 15 * time.Second,
+	}
+)
+
+type (
+	// UniswapV2Provider defines an oracle provider reading the spot prices of
+	// Uniswap V2 style AMM pairs using the JSON-RPC endpoint of any EVM chain.
+	// The `contracts` of the provider endpoints map the denoms to their token
+	// addresses and the pairs to their pair contract addresses, ex.:
+	// {"WETH": "0xc02a...", "USDC": "0xa0b8...", "WETHUSDC": "0xb4e1..."}.
+	// The base token reserve of the pair is reported as volume.
+	//
+	// REF: https://docs.uniswap.org/contracts/v2/reference/smart-contracts/pair#getreserves
+	UniswapV2Provider struct {
+		provider
+		decimals *evmDecimalsCache
+	}
+)
+
+func NewUniswapV2Provider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*UniswapV2Provider, error) {
+	provider := &UniswapV2Provider{
+		decimals: newEvmDecimalsCache(),
+	}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		nil,
+		nil,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
+
+func (p *UniswapV2Provider) Poll() error {
+	for symbol, pair := range p.pairs {
+		ticker, err := p.getTicker(pair)
+		if err != nil {
+			p.logger.Warn().Err(err).Str("pair", symbol).Msg("failed to get reserves")
+			continue
+		}
+
+		p.mtx.Lock()
+		p.tickers[symbol] = ticker
+		p.mtx.Unlock()
+	}
+
+	p.logger.Debug().Msg("updated tickers")
+	return nil
+}
+
+func (p *UniswapV2Provider) getTicker(pair types.CurrencyPair) (types.TickerPrice, error) {
+	address, ok := p.endpoints.Contracts[pair.String()]
+	if !ok {
+		return types.TickerPrice{}, fmt.Errorf("no contract configured for %s", pair.String())
+	}
+	base, ok := p.endpoints.Contracts[pair.Base]
+	if !ok {
+		return types.TickerPrice{}, fmt.Errorf("no contract configured for %s", pair.Base)
+	}
+	quote, ok := p.endpoints.Contracts[pair.Quote]
+	if !ok {
+		return types.TickerPrice{}, fmt.Errorf("no contract configured for %s", pair.Quote)
+	}
+	base = strings.ToLower(base)
+	quote = strings.ToLower(quote)
+
+	baseReserve, quoteReserve, err := uniswapV2GetReserves(&p.provider, p.httpBase, address, base, quote)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	baseDecimals, err := p.decimals.get(&p.provider, pair.Base, base)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+	quoteDecimals, err := p.decimals.get(&p.provider, pair.Quote, quote)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	baseAmount := bigIntToDec(baseReserve, baseDecimals)
+	quoteAmount := bigIntToDec(quoteReserve, quoteDecimals)
+
+	return types.TickerPrice{
+		Price:  quoteAmount.Quo(baseAmount),
+		Volume: baseAmount,
+		Time:   time.Now(),
+	}, nil
+}
+
+// uniswapV2GetReserves returns the reserves of the base and quote token of a
+// Uniswap V2 style pair using the JSON-RPC endpoint of the url.
+func uniswapV2GetReserves(p *provider, url, address, base, quote string) (*big.Int, *big.Int, error) {
+	result, err := p.evmCallUrl(url, address, evmEncodeCall("getReserves()"))
+	if err != nil {
+		return nil, nil, err
+	}
+	reserve0, err := evmDecodeUint(result, 0)
+	if err != nil {
+		return nil, nil, err
+	}
+	reserve1, err := evmDecodeUint(result, 1)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// the token with the lower address is token0
+	baseReserve, quoteReserve := reserve0, reserve1
+	if base > quote {
+		baseReserve, quoteReserve = reserve1, reserve0
+	}
+	if baseReserve.Sign() == 0 || quoteReserve.Sign() == 0 {
+		return nil, nil, fmt.Errorf("no liquidity in pair %s", address)
+	}
+	return baseReserve, quoteReserve, nil
+}
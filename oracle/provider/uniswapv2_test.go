@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"math/big"
+	"testing"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUniswapV2Provider_Poll(t *testing.T) {
+	const (
+		weth = "0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2"
+		usdc = "0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48"
+		pool = "0xb4e16d0168e52d35cacd2c6185b44281ec28c9dc"
+	)
+
+	usdcReserve, _ := new(big.Int).SetString("2000000000000", 10)
+	wethReserve, _ := new(big.Int).SetString("1000000000000000000000", 10)
+
+	server := newEvmTestServer(func(params EvmCallParams) []byte {
+		switch {
+		case params.To == pool && params.Data == "0x0902f1ac":
+			result := append(evmEncodeInt(usdcReserve), evmEncodeInt(wethReserve)...)
+			return append(result, evmEncodeInt(big.NewInt(1677666151))...)
+		case params.To == weth && params.Data == "0x313ce567":
+			return evmEncodeInt(big.NewInt(18))
+		}
+		return nil
+	})
+	defer server.Close()
+
+	wethUsdc := types.CurrencyPair{Base: "WETH", Quote: "USDC"}
+
+	p := newTestProvider(t, NewUniswapV2Provider, server, Endpoint{
+		Name:      ProviderUniswapV2,
+		Contracts: map[string]string{"WETH": weth, "USDC": usdc, "WETHUSDC": pool},
+		Decimals:  map[string]int64{"USDC": 6},
+	}, wethUsdc)
+
+	require.NoError(t, p.Poll())
+	require.Equal(t, sdk.MustNewDecFromStr("2000"), p.tickers["WETHUSDC"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("1000"), p.tickers["WETHUSDC"].Volume)
+}
@@ -0,0 +1,243 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	"github.com/rs/zerolog"
+)
+
+const (
+	// uniswapV3Factory defines the address of the Uniswap V3 factory on
+	// Ethereum mainnet.
+	uniswapV3Factory = "0x1f98431c8ad98523631ae4a59f267346ea31f984"
+	// uniswapV3DefaultTwapWindow defines the default window of the TWAPs.
+	uniswapV3DefaultTwapWindow = 30 * time.Minute
+)
+
+var (
+	_                         Provider = (*UniswapV3Provider)(nil)
+	uniswapV3DefaultEndpoints          = Endpoint{
+		Name:         ProviderUniswapV3,
+		Urls:         []string{"https://cloudflare-eth.com"},
+		PollInterval: 30 * time.Second,
+	}
+
+	// uniswapV3FeeTiers defines the fee tiers the pools are searched in.
+	uniswapV3FeeTiers = []int64{100, 500, 3000, 10000}
+)
+
+type (
+	// UniswapV3Provider defines an oracle provider reading the TWAPs of
+	// Uniswap V3 pools using an Ethereum JSON-RPC endpoint. The token addresses
+	// of the denoms are configured as `contracts` of the provider endpoints
+	// and the pool with the most liquidity across all fee tiers is used for
+	// every pair. The TWAP window can be set using `twap_window` and the base
+	// token balance of the pool is reported as volume.
+	//
+	// REF: https://docs.uniswap.org/contracts/v3/reference/core/UniswapV3Pool#observe
+	UniswapV3Provider struct {
+		provider
+		factory  string
+		feeTiers []int64
+		decimals *evmDecimalsCache
+	}
+)
+
+func NewUniswapV3Provider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*UniswapV3Provider, error) {
+	provider := &UniswapV3Provider{
+		factory:  uniswapV3Factory,
+		feeTiers: uniswapV3FeeTiers,
+		decimals: newEvmDecimalsCache(),
+	}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		nil,
+		nil,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
+
+func (p *UniswapV3Provider) Poll() error {
+	for symbol, pair := range p.pairs {
+		ticker, err := p.getTicker(pair)
+		if err != nil {
+			p.logger.Warn().Err(err).Str("pair", symbol).Msg("failed to get twap")
+			continue
+		}
+
+		p.mtx.Lock()
+		p.tickers[symbol] = ticker
+		p.mtx.Unlock()
+	}
+
+	p.logger.Debug().Msg("updated tickers")
+	return nil
+}
+
+func (p *UniswapV3Provider) getTicker(pair types.CurrencyPair) (types.TickerPrice, error) {
+	base, ok := p.endpoints.Contracts[pair.Base]
+	if !ok {
+		return types.TickerPrice{}, fmt.Errorf("no contract configured for %s", pair.Base)
+	}
+	quote, ok := p.endpoints.Contracts[pair.Quote]
+	if !ok {
+		return types.TickerPrice{}, fmt.Errorf("no contract configured for %s", pair.Quote)
+	}
+	base = strings.ToLower(base)
+	quote = strings.ToLower(quote)
+
+	pool, err := p.getPool(base, quote)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	tick, err := p.getTwapTick(pool)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	baseDecimals, err := p.decimals.get(&p.provider, pair.Base, base)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+	quoteDecimals, err := p.decimals.get(&p.provider, pair.Quote, quote)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	price := uniswapV3TickToPrice(tick, base > quote, baseDecimals-quoteDecimals)
+
+	balance, err := p.evmBalanceOf(base, pool)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	return types.TickerPrice{
+		Price:  strToDec(strconv.FormatFloat(price, 'f', -1, 64)),
+		Volume: bigIntToDec(balance, baseDecimals),
+		Time:   time.Now(),
+	}, nil
+}
+
+// getPool returns the pool of the tokens with the most liquidity.
+func (p *UniswapV3Provider) getPool(tokenA, tokenB string) (string, error) {
+	tokenAWord, err := evmEncodeAddress(tokenA)
+	if err != nil {
+		return "", err
+	}
+	tokenBWord, err := evmEncodeAddress(tokenB)
+	if err != nil {
+		return "", err
+	}
+
+	var pool string
+	maxLiquidity := big.NewInt(0)
+	for _, fee := range p.feeTiers {
+		result, err := p.evmCall(p.factory, evmEncodeCall(
+			"getPool(address,address,uint24)",
+			tokenAWord,
+			tokenBWord,
+			evmEncodeInt(big.NewInt(fee)),
+		))
+		if err != nil {
+			return "", err
+		}
+		address, err := evmDecodeAddress(result, 0)
+		if err != nil {
+			return "", err
+		}
+		if address == "0x0000000000000000000000000000000000000000" {
+			continue
+		}
+
+		result, err = p.evmCall(address, evmEncodeCall("liquidity()"))
+		if err != nil {
+			return "", err
+		}
+		liquidity, err := evmDecodeUint(result, 0)
+		if err != nil {
+			return "", err
+		}
+		if liquidity.Cmp(maxLiquidity) > 0 {
+			pool = address
+			maxLiquidity = liquidity
+		}
+	}
+
+	if pool == "" {
+		return "", fmt.Errorf("no pool with liquidity found")
+	}
+	return pool, nil
+}
+
+// getTwapTick returns the arithmetic mean tick of the pool over the TWAP
+// window.
+func (p *UniswapV3Provider) getTwapTick(pool string) (int64, error) {
+	window := int64(uniswapV3DefaultTwapWindow.Seconds())
+	if p.endpoints.TwapWindow != 0 {
+		window = int64(p.endpoints.TwapWindow.Seconds())
+	}
+
+	// observe(uint32[]) with the dynamic array [window, 0]
+	result, err := p.evmCall(pool, evmEncodeCall(
+		"observe(uint32[])",
+		evmEncodeInt(big.NewInt(evmWordSize)),
+		evmEncodeInt(big.NewInt(2)),
+		evmEncodeInt(big.NewInt(window)),
+		evmEncodeInt(big.NewInt(0)),
+	))
+	if err != nil {
+		return 0, err
+	}
+
+	// the first result is the array of tick cumulatives
+	index, length, err := evmDecodeArray(result, 0)
+	if err != nil {
+		return 0, err
+	}
+	if length != 2 {
+		return 0, fmt.Errorf("invalid observations: %d", length)
+	}
+	tickCumulativeStart, err := evmDecodeInt(result, index)
+	if err != nil {
+		return 0, err
+	}
+	tickCumulativeEnd, err := evmDecodeInt(result, index+1)
+	if err != nil {
+		return 0, err
+	}
+
+	delta := new(big.Int).Sub(tickCumulativeEnd, tickCumulativeStart)
+	// round towards negative infinity like the Uniswap oracle library
+	tick := new(big.Int).Div(delta, big.NewInt(window))
+	return tick.Int64(), nil
+}
+
+// uniswapV3TickToPrice returns the price of the base token for the tick,
+// which is the price of token0 in token1, the token with the lower address
+// being token0. The decimals are the base token decimals minus the quote
+// token decimals.
+func uniswapV3TickToPrice(tick int64, inverse bool, decimals int64) float64 {
+	price := math.Pow(1.0001, float64(tick))
+	if inverse {
+		price = 1 / price
+	}
+	return price * math.Pow(10, float64(decimals))
+}
@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUniswapV3TickToPrice(t *testing.T) {
+	require.Equal(t, float64(1), uniswapV3TickToPrice(0, false, 0))
+
+	// WETH priced in USDC, with USDC being token0 of the pool
+	require.InDelta(t, 2063.2, uniswapV3TickToPrice(200000, true, 18-6), 0.1)
+}
+
+func TestUniswapV3Provider_GetPool(t *testing.T) {
+	const (
+		usdc = "0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48"
+		weth = "0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2"
+	)
+
+	// the 100 fee tier has no pool, the 3000 fee tier the most liquidity
+	pools := map[int64]string{
+		500:   "0x88e6a0c2ddd26feeb64f039a2c41296fcb3f5640",
+		3000:  "0x8ad599c3a0ff1de082011efddc58f1908eb6e6d8",
+		10000: "0x7bea39867e4169dbe237d55c8242a8f2fcdcc387",
+	}
+	liquidity := map[string]int64{
+		pools[500]:   1000,
+		pools[3000]:  5000,
+		pools[10000]: 2000,
+	}
+
+	usdcWord, err := evmEncodeAddress(usdc)
+	require.NoError(t, err)
+	wethWord, err := evmEncodeAddress(weth)
+	require.NoError(t, err)
+
+	getPoolCalls := map[string]int64{}
+	for _, fee := range uniswapV3FeeTiers {
+		data := evmEncodeCall(
+			"getPool(address,address,uint24)",
+			usdcWord,
+			wethWord,
+			evmEncodeInt(big.NewInt(fee)),
+		)
+		getPoolCalls["0x"+hex.EncodeToString(data)] = fee
+	}
+	liquidityCall := "0x" + hex.EncodeToString(evmEncodeCall("liquidity()"))
+
+	server := newEvmTestServer(func(params EvmCallParams) []byte {
+		if params.To == uniswapV3Factory {
+			fee, ok := getPoolCalls[params.Data]
+			if !ok {
+				return nil
+			}
+			pool, ok := pools[fee]
+			if !ok {
+				pool = "0x0000000000000000000000000000000000000000"
+			}
+			word, _ := evmEncodeAddress(pool)
+			return word
+		}
+		if value, ok := liquidity[params.To]; ok && params.Data == liquidityCall {
+			return evmEncodeInt(big.NewInt(value))
+		}
+		return nil
+	})
+	defer server.Close()
+
+	p := newTestProvider(t, NewUniswapV3Provider, server, Endpoint{Name: ProviderUniswapV3})
+
+	pool, err := p.getPool(usdc, weth)
+	require.NoError(t, err)
+	require.Equal(t, pools[3000], pool)
+
+	// no fee tier has a pool
+	p.feeTiers = []int64{100}
+	_, err = p.getPool(usdc, weth)
+	require.Error(t, err)
+}
+
+func TestUniswapV3Provider_GetTwapTick(t *testing.T) {
+	const pool = "0x88e6a0c2ddd26feeb64f039a2c41296fcb3f5640"
+
+	window := 10 * time.Minute
+	observeCall := "0x" + hex.EncodeToString(evmEncodeCall(
+		"observe(uint32[])",
+		evmEncodeInt(big.NewInt(evmWordSize)),
+		evmEncodeInt(big.NewInt(2)),
+		evmEncodeInt(big.NewInt(600)),
+		evmEncodeInt(big.NewInt(0)),
+	))
+
+	testCases := map[string]struct {
+		delta int64
+		tick  int64
+	}{
+		"positive delta":            {delta: 3001, tick: 5},
+		"negative delta":            {delta: -3000, tick: -5},
+		"negative delta rounded":    {delta: -3001, tick: -6},
+		"negative delta below tick": {delta: -1, tick: -1},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			start := big.NewInt(-1234567)
+			end := new(big.Int).Add(start, big.NewInt(tc.delta))
+
+			server := newEvmTestServer(func(params EvmCallParams) []byte {
+				if params.To != pool || params.Data != observeCall {
+					return nil
+				}
+				// (int56[] tickCumulatives, uint160[] secondsPerLiquidityCumulativeX128s)
+				result := evmEncodeInt(big.NewInt(2 * evmWordSize))
+				result = append(result, evmEncodeInt(big.NewInt(5*evmWordSize))...)
+				result = append(result, evmEncodeInt(big.NewInt(2))...)
+				result = append(result, evmEncodeInt(start)...)
+				result = append(result, evmEncodeInt(end)...)
+				result = append(result, evmEncodeInt(big.NewInt(2))...)
+				result = append(result, evmEncodeInt(big.NewInt(1))...)
+				return append(result, evmEncodeInt(big.NewInt(2))...)
+			})
+			defer server.Close()
+
+			p := newTestProvider(t, NewUniswapV3Provider, server, Endpoint{
+				Name:       ProviderUniswapV3,
+				TwapWindow: window,
+			})
+
+			tick, err := p.getTwapTick(pool)
+			require.NoError(t, err)
+			require.Equal(t, tc.tick, tick)
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	"github.com/rs/zerolog"
+)
+
+var (
+	_                     Provider = (*UpbitProvider)(nil)
+	upbitDefaultEndpoints          = Endpoint{
+		Name:         ProviderUpbit,
+		Urls:         []string{"https://api.upbit.com"},
+		PollInterval: 3 * time.Second,
+	}
+)
+
+type (
+	// UpbitProvider defines an oracle provider implemented by the Upbit public
+	// API. Most markets are quoted in KRW, which is converted to USD using the
+	// KRW/USD rate of the fx providers, ex.: frankfurter.
+	//
+	// REF: https://global-docs.upbit.com/reference/ticker
+	UpbitProvider struct {
+		provider
+	}
+
+	UpbitTicker struct {
+		Market    string  `json:"market"`               // ex.: "KRW-ATOM"
+		Price     float64 `json:"trade_price"`          // ex.: 14470
+		Volume    float64 `json:"acc_trade_volume_24h"` // ex.: 162378.79874311
+		Timestamp int64   `json:"timestamp"`            // ex.: 1677666151422
+	}
+)
+
+func NewUpbitProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*UpbitProvider, error) {
+	provider := &UpbitProvider{}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		nil,
+		nil,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
+
+func (p *UpbitProvider) Poll() error {
+	markets := make([]string, 0, len(p.pairs))
+	for _, pair := range p.pairs {
+		markets = append(markets, p.CurrencyPairToProviderPair(pair))
+	}
+
+	content, err := p.httpGet("/v1/ticker?markets=" + strings.Join(markets, ","))
+	if err != nil {
+		return err
+	}
+
+	var tickers []UpbitTicker
+	err = json.Unmarshal(content, &tickers)
+	if err != nil {
+		return err
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	for _, ticker := range tickers {
+		symbol := p.ProviderPairToCurrencyPair(ticker.Market).String()
+		if _, ok := p.pairs[symbol]; !ok {
+			continue
+		}
+
+		p.tickers[symbol] = types.TickerPrice{
+			Price:  floatToDec(ticker.Price),
+			Volume: floatToDec(ticker.Volume),
+			Time:   time.UnixMilli(ticker.Timestamp),
+		}
+	}
+
+	p.logger.Debug().Msg("updated tickers")
+	return nil
+}
+
+// CurrencyPairToProviderPair returns the Upbit market, which starts with the
+// quote, ex.: "ATOMKRW" -> "KRW-ATOM".
+func (p *UpbitProvider) CurrencyPairToProviderPair(pair types.CurrencyPair) string {
+	return pair.Quote + "-" + pair.Base
+}
+
+func (p *UpbitProvider) ProviderPairToCurrencyPair(pair string) types.CurrencyPair {
+	tokens := strings.Split(pair, "-")
+	if len(tokens) != 2 {
+		p.logger.Warn().Str("pair", pair).Msg("failed to convert to currency pair")
+		return types.CurrencyPair{}
+	}
+	return types.CurrencyPair{
+		Base:  tokens[1],
+		Quote: tokens[0],
+	}
+}
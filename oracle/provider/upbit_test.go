@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpbitProvider_Poll(t *testing.T) {
+	server := newTestServer(func(rw http.ResponseWriter, req *http.Request) {
+		_, _ = rw.Write([]byte(`[
+			{"market":"KRW-ATOM","trade_price":14470,"acc_trade_volume_24h":162378.79,"timestamp":1677666151422},
+			{"market":"BTC-ATOM","trade_price":0.00045,"acc_trade_volume_24h":1000,"timestamp":1677666151422},
+			{"market":"KRW-BTC","trade_price":30000000,"acc_trade_volume_24h":1000,"timestamp":1677666151422}
+		]`))
+	})
+	defer server.Close()
+
+	atomKrw := types.CurrencyPair{Base: "ATOM", Quote: "KRW"}
+	atomBtc := types.CurrencyPair{Base: "ATOM", Quote: "BTC"}
+
+	p := newTestProvider(t, NewUpbitProvider, server, Endpoint{
+		Name: ProviderUpbit,
+	}, atomKrw, atomBtc)
+
+	require.NoError(t, p.Poll())
+	require.Len(t, p.tickers, 2)
+	require.Equal(t, sdk.MustNewDecFromStr("14470"), p.tickers["ATOMKRW"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("162378.79"), p.tickers["ATOMKRW"].Volume)
+	require.Equal(t, int64(1677666151422), p.tickers["ATOMKRW"].Time.UnixMilli())
+	require.Equal(t, sdk.MustNewDecFromStr("0.00045"), p.tickers["ATOMBTC"].Price)
+
+	requests := server.Requests()
+	require.Len(t, requests, 1)
+	require.Equal(t, "/v1/ticker", requests[0].URL.Path)
+	require.ElementsMatch(t, []string{"KRW-ATOM", "BTC-ATOM"}, strings.Split(requests[0].URL.Query().Get("markets"), ","))
+}
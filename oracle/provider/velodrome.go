@@ -0,0 +1,221 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	"github.com/rs/zerolog"
+)
+
+const (
+	// velodromeRouter defines the address of the Velodrome V2 router on
+	// Optimism.
+	velodromeRouter = "0xa062ae8a9c5e11aaa026fc2670b0d65ccc8b2858"
+	// velodromeFactory defines the address of the Velodrome V2 pool factory
+	// on Optimism.
+	velodromeFactory = "0xf1046053aa5682b4f9a81b5481394da16be5ff5a"
+)
+
+var (
+	_                         Provider = (*VelodromeProvider)(nil)
+	velodromeDefaultEndpoints          = Endpoint{
+		Name:         ProviderVelodrome,
+		Urls:         []string{"https://mainnet.optimism.io"},
+		PollInterval: 15 * time.Second,
+	}
+)
+
+type (
+	// VelodromeProvider defines an oracle provider quoting Velodrome V2 pools
+	// on Optimism using the getAmountsOut function of the router. Both the
+	// volatile and the stable pool of a pair are quoted for one base token
+	// and the better quote is used. The `contracts` of the provider endpoints
+	// map the denoms to their token addresses. The base token balance of the
+	// quoted pool is reported as volume.
+	//
+	// REF: https://github.com/velodrome-finance/contracts/blob/main/contracts/Router.sol
+	VelodromeProvider struct {
+		provider
+		router   string
+		factory  string
+		decimals *evmDecimalsCache
+	}
+)
+
+func NewVelodromeProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*VelodromeProvider, error) {
+	provider := &VelodromeProvider{
+		router:   velodromeRouter,
+		factory:  velodromeFactory,
+		decimals: newEvmDecimalsCache(),
+	}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		nil,
+		nil,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
+
+func (p *VelodromeProvider) Poll() error {
+	for symbol, pair := range p.pairs {
+		ticker, err := p.getTicker(pair)
+		if err != nil {
+			p.logger.Warn().Err(err).Str("pair", symbol).Msg("failed to get quote")
+			continue
+		}
+
+		p.mtx.Lock()
+		p.tickers[symbol] = ticker
+		p.mtx.Unlock()
+	}
+
+	p.logger.Debug().Msg("updated tickers")
+	return nil
+}
+
+func (p *VelodromeProvider) getTicker(pair types.CurrencyPair) (types.TickerPrice, error) {
+	base, ok := p.endpoints.Contracts[pair.Base]
+	if !ok {
+		return types.TickerPrice{}, fmt.Errorf("no contract configured for %s", pair.Base)
+	}
+	quote, ok := p.endpoints.Contracts[pair.Quote]
+	if !ok {
+		return types.TickerPrice{}, fmt.Errorf("no contract configured for %s", pair.Quote)
+	}
+	base = strings.ToLower(base)
+	quote = strings.ToLower(quote)
+
+	baseDecimals, err := p.decimals.get(&p.provider, pair.Base, base)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+	quoteDecimals, err := p.decimals.get(&p.provider, pair.Quote, quote)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	// quote one whole base token
+	amountIn := new(big.Int).Exp(big.NewInt(10), big.NewInt(baseDecimals), nil)
+
+	var pool string
+	amountOut := big.NewInt(0)
+	for _, stable := range []bool{false, true} {
+		address, err := p.getPool(base, quote, stable)
+		if err != nil {
+			return types.TickerPrice{}, err
+		}
+		if address == "" {
+			continue
+		}
+		amount, err := p.getAmountOut(amountIn, base, quote, stable)
+		if err != nil {
+			return types.TickerPrice{}, err
+		}
+		if amount.Cmp(amountOut) > 0 {
+			pool = address
+			amountOut = amount
+		}
+	}
+	if pool == "" {
+		return types.TickerPrice{}, fmt.Errorf("no pool with liquidity found")
+	}
+
+	balance, err := p.evmBalanceOf(base, pool)
+	if err != nil {
+		return types.TickerPrice{}, err
+	}
+
+	return types.TickerPrice{
+		Price:  bigIntToDec(amountOut, quoteDecimals),
+		Volume: bigIntToDec(balance, baseDecimals),
+		Time:   time.Now(),
+	}, nil
+}
+
+// getPool returns the address of the stable or volatile pool of the tokens,
+// or an empty string if it doesn't exist.
+func (p *VelodromeProvider) getPool(tokenA, tokenB string, stable bool) (string, error) {
+	tokenAWord, err := evmEncodeAddress(tokenA)
+	if err != nil {
+		return "", err
+	}
+	tokenBWord, err := evmEncodeAddress(tokenB)
+	if err != nil {
+		return "", err
+	}
+
+	result, err := p.evmCall(p.factory, evmEncodeCall(
+		"getPool(address,address,bool)",
+		tokenAWord,
+		tokenBWord,
+		evmEncodeBool(stable),
+	))
+	if err != nil {
+		return "", err
+	}
+	address, err := evmDecodeAddress(result, 0)
+	if err != nil {
+		return "", err
+	}
+	if address == "0x0000000000000000000000000000000000000000" {
+		return "", nil
+	}
+	return address, nil
+}
+
+// getAmountOut returns the amount of the output token the router quotes for
+// swapping the input amount using a single route.
+func (p *VelodromeProvider) getAmountOut(amountIn *big.Int, from, to string, stable bool) (*big.Int, error) {
+	fromWord, err := evmEncodeAddress(from)
+	if err != nil {
+		return nil, err
+	}
+	toWord, err := evmEncodeAddress(to)
+	if err != nil {
+		return nil, err
+	}
+	factoryWord, err := evmEncodeAddress(p.factory)
+	if err != nil {
+		return nil, err
+	}
+
+	// getAmountsOut(amountIn, routes) with the routes array holding a single
+	// (from, to, stable, factory) tuple
+	result, err := p.evmCall(p.router, evmEncodeCall(
+		"getAmountsOut(uint256,(address,address,bool,address)[])",
+		evmEncodeInt(amountIn),
+		evmEncodeInt(big.NewInt(2*evmWordSize)),
+		evmEncodeInt(big.NewInt(1)),
+		fromWord,
+		toWord,
+		evmEncodeBool(stable),
+		factoryWord,
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	// the amounts of every hop, including the input amount
+	index, length, err := evmDecodeArray(result, 0)
+	if err != nil {
+		return nil, err
+	}
+	if length != 2 {
+		return nil, fmt.Errorf("invalid amounts: %d", length)
+	}
+	return evmDecodeUint(result, index+1)
+}
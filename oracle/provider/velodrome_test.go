@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVelodromeProvider_Poll(t *testing.T) {
+	const (
+		velo = "0x9560e827af36c94d2ac33a39bce1fe78631088db"
+		usdc = "0x0b2c639c533813f4aa9d7837caf62653d097ff85"
+		pool = "0xa0a215de234276cac1b844fd58901351a50fec8a"
+	)
+
+	getPoolSelector := hex.EncodeToString(evmEncodeCall("getPool(address,address,bool)"))
+	getAmountsOutSelector := hex.EncodeToString(evmEncodeCall("getAmountsOut(uint256,(address,address,bool,address)[])"))
+	balanceOfSelector := hex.EncodeToString(evmEncodeCall("balanceOf(address)"))
+	poolWord, err := evmEncodeAddress(pool)
+	require.NoError(t, err)
+
+	server := newEvmTestServer(func(params EvmCallParams) []byte {
+		selector, args, err := decodeEvmTestData(params)
+		if err != nil {
+			return nil
+		}
+
+		switch {
+		case params.To == velodromeFactory && selector == getPoolSelector:
+			// only the volatile pool exists
+			if args[3*evmWordSize-1] == 1 {
+				return make([]byte, evmWordSize)
+			}
+			return poolWord
+		case params.To == velodromeRouter && selector == getAmountsOutSelector:
+			return bytes.Join([][]byte{
+				evmEncodeInt(big.NewInt(evmWordSize)),
+				evmEncodeInt(big.NewInt(2)),
+				args[:evmWordSize],
+				evmEncodeInt(big.NewInt(125000)),
+			}, nil)
+		case params.To == velo && selector == balanceOfSelector:
+			balance, _ := new(big.Int).SetString("4000000000000000000000000", 10)
+			return evmEncodeInt(balance)
+		}
+		return nil
+	})
+	defer server.Close()
+
+	veloUsdc := types.CurrencyPair{Base: "VELO", Quote: "USDC"}
+
+	p := newTestProvider(t, NewVelodromeProvider, server, Endpoint{
+		Name:      ProviderVelodrome,
+		Contracts: map[string]string{"VELO": velo, "USDC": usdc},
+		Decimals:  map[string]int64{"VELO": 18, "USDC": 6},
+	}, veloUsdc)
+
+	require.NoError(t, p.Poll())
+	for _, call := range evmTestCalls(t, server) {
+		selector, args, err := decodeEvmTestData(call)
+		require.NoError(t, err)
+		if call.To == velodromeRouter && selector == getAmountsOutSelector {
+			require.Len(t, args, 7*evmWordSize)
+		}
+	}
+	require.Equal(t, sdk.MustNewDecFromStr("0.125"), p.tickers["VELOUSDC"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("4000000"), p.tickers["VELOUSDC"].Volume)
+}
@@ -28,6 +28,8 @@ type (
 
 	SubscribeHandler func(...types.CurrencyPair) []interface{}
 
+	URLHandler func() (url.URL, error)
+
 	// WebsocketController defines a provider agnostic websocket handler
 	// that manages reconnecting, subscribing, and receiving messages
 	WebsocketController struct {
@@ -39,6 +41,7 @@ type (
 		pairs 				[]types.CurrencyPair
 		messageHandler      MessageHandler
 		subscribeHandler	SubscribeHandler
+		urlHandler          URLHandler
 		pingDuration        time.Duration
 		pingMessage         string
 		pingMessageType     uint
@@ -59,6 +62,7 @@ func NewWebsocketController(
 	pairs []types.CurrencyPair,
 	messageHandler MessageHandler,
 	subscribeHandler SubscribeHandler,
+	urlHandler URLHandler,
 	pingDuration time.Duration,
 	pingMessageType uint,
 	pingMessage string,
@@ -71,6 +75,7 @@ func NewWebsocketController(
 		pairs: pairs,
 		subscribeHandler: subscribeHandler,
 		messageHandler: messageHandler,
+		urlHandler: urlHandler,
 		pingDuration: pingDuration,
 		pingMessage: pingMessage,
 		pingMessageType: pingMessageType,
@@ -115,6 +120,14 @@ func (wsc *WebsocketController) connect() error {
 	wsc.mtx.Lock()
 	defer wsc.mtx.Unlock()
 
+	if wsc.urlHandler != nil {
+		websocketURL, err := wsc.urlHandler()
+		if err != nil {
+			return fmt.Errorf(types.ErrWebsocketDial.Error(), wsc.providerName, err)
+		}
+		wsc.websocketURL = websocketURL
+	}
+
 	wsc.logger.Debug().Msg("connecting to websocket")
 	conn, resp, err := websocket.DefaultDialer.Dial(wsc.websocketURL.String(), nil)
 	if err != nil {
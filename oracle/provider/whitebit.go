@@ -0,0 +1,173 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	"github.com/gorilla/websocket"
+	"github.com/rs/zerolog"
+)
+
+var (
+	_                        Provider = (*WhitebitProvider)(nil)
+	whitebitDefaultEndpoints          = Endpoint{
+		Name:          ProviderWhitebit,
+		Urls:          []string{"https://whitebit.com"},
+		Websocket:     "api.whitebit.com",
+		WebsocketPath: "/ws",
+		// WhiteBIT closes connections without any messages for 60 seconds
+		PingDuration: 30 * time.Second,
+		PingType:     websocket.TextMessage,
+		PingMessage:  `{"id":0,"method":"ping","params":[]}`,
+	}
+)
+
+type (
+	// WhitebitProvider defines an oracle provider implemented by the WhiteBIT
+	// public websocket API. The market channel reports the last price and
+	// the volume of the last 24h.
+	//
+	// REF: https://docs.whitebit.com/public/websocket/#market-statistics
+	WhitebitProvider struct {
+		provider
+	}
+
+	WhitebitSubscriptionMsg struct {
+		Id     int64    `json:"id"`     // ex.: 1
+		Method string   `json:"method"` // ex.: "market_subscribe"
+		Params []string `json:"params"` // ex.: ["BTC_USDT"]
+	}
+
+	// WhitebitMarketMsg defines a market update, subscription result, pong or
+	// error, ex.:
+	// {"id":null,"method":"market_update","params":["BTC_USDT",{"last":"23117.4","volume":"1402.2"}]}
+	// {"id":0,"result":"pong","error":null}
+	WhitebitMarketMsg struct {
+		Method string            `json:"method"`
+		Params []json.RawMessage `json:"params"`
+		Error  *WhitebitError    `json:"error"`
+	}
+
+	WhitebitError struct {
+		Code    int64  `json:"code"`
+		Message string `json:"message"`
+	}
+
+	WhitebitMarket struct {
+		Price  string `json:"last"`   // ex.: "23117.4"
+		Volume string `json:"volume"` // Total traded base asset volume ex.: "1402.2"
+	}
+)
+
+func NewWhitebitProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*WhitebitProvider, error) {
+	provider := &WhitebitProvider{}
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		provider.messageReceived,
+		provider.getSubscriptionMsgs,
+	)
+	return provider, nil
+}
+
+func (p *WhitebitProvider) getSubscriptionMsgs(pairs ...types.CurrencyPair) []interface{} {
+	markets := make([]string, len(pairs))
+	for i, pair := range pairs {
+		markets[i] = p.CurrencyPairToProviderPair(pair)
+	}
+	return []interface{}{
+		WhitebitSubscriptionMsg{
+			Id:     1,
+			Method: "market_subscribe",
+			Params: markets,
+		},
+	}
+}
+
+func (p *WhitebitProvider) messageReceived(messageType int, bz []byte) {
+	var marketMsg WhitebitMarketMsg
+	err := json.Unmarshal(bz, &marketMsg)
+	if err != nil {
+		p.logger.Error().Err(err).Msg("failed to unmarshal message")
+		return
+	}
+
+	if marketMsg.Error != nil {
+		p.logger.Error().
+			Int64("code", marketMsg.Error.Code).
+			Str("msg", marketMsg.Error.Message).
+			Msg("received error message")
+		return
+	}
+
+	if marketMsg.Method != "market_update" || len(marketMsg.Params) != 2 {
+		return
+	}
+
+	var market string
+	err = json.Unmarshal(marketMsg.Params[0], &market)
+	if err != nil {
+		p.logger.Error().Err(err).Msg("failed to unmarshal market")
+		return
+	}
+
+	var stats WhitebitMarket
+	err = json.Unmarshal(marketMsg.Params[1], &stats)
+	if err != nil {
+		p.logger.Error().Err(err).Msg("failed to unmarshal market statistics")
+		return
+	}
+
+	price, err := decFromString(stats.Price)
+	if err != nil {
+		p.logger.Error().Err(err).Msg("failed to parse price")
+		return
+	}
+	volume, err := decFromString(stats.Volume)
+	if err != nil {
+		p.logger.Error().Err(err).Msg("failed to parse volume")
+		return
+	}
+
+	symbol := p.ProviderPairToCurrencyPair(market).String()
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	if _, ok := p.pairs[symbol]; !ok {
+		return
+	}
+
+	p.tickers[symbol] = types.TickerPrice{
+		Price:  price,
+		Volume: volume,
+		Time:   time.Now(),
+	}
+}
+
+func (p *WhitebitProvider) CurrencyPairToProviderPair(pair types.CurrencyPair) string {
+	return pair.Join("_")
+}
+
+func (p *WhitebitProvider) ProviderPairToCurrencyPair(pair string) types.CurrencyPair {
+	tokens := strings.Split(pair, "_")
+	if len(tokens) != 2 {
+		p.logger.Warn().Str("pair", pair).Msg("failed to convert to currency pair")
+		return types.CurrencyPair{}
+	}
+	return types.CurrencyPair{
+		Base:  tokens[0],
+		Quote: tokens[1],
+	}
+}
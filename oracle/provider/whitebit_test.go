@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"testing"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWhitebitProvider_MessageReceived(t *testing.T) {
+	atomUsdt := types.CurrencyPair{Base: "ATOM", Quote: "USDT"}
+
+	p := &WhitebitProvider{}
+	p.logger = zerolog.Nop()
+	p.pairs = map[string]types.CurrencyPair{atomUsdt.String(): atomUsdt}
+	p.tickers = map[string]types.TickerPrice{}
+
+	// ignores subscription results and pongs
+	p.messageReceived(1, []byte(`{"id":1,"result":{"status":"success"},"error":null}`))
+	p.messageReceived(1, []byte(`{"id":0,"result":"pong","error":null}`))
+	require.Empty(t, p.tickers)
+
+	// drops updates with unparsable numbers
+	p.messageReceived(1, []byte(`{"id":null,"method":"market_update","params":["ATOM_USDT",{"period":86400,"last":"13.61","volume":""}]}`))
+	require.Empty(t, p.tickers)
+
+	p.messageReceived(1, []byte(`{"id":null,"method":"market_update","params":["ATOM_USDT",{"period":86400,"last":"13.61","open":"13.2","close":"13.61","high":"13.9","low":"13.1","volume":"433812.95","deal":"5904191.25"}]}`))
+	require.Equal(t, sdk.MustNewDecFromStr("13.61"), p.tickers["ATOMUSDT"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("433812.95"), p.tickers["ATOMUSDT"].Volume)
+}
@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"price-feeder/oracle/types"
+
+	"github.com/rs/zerolog"
+)
+
+var (
+	_                          Provider = (*WhiteWhaleProvider)(nil)
+	whiteWhaleDefaultEndpoints          = Endpoint{
+		Name:         ProviderWhiteWhale,
+		Urls:         []string{"https://rest.cosmos.directory/migaloo"},
+		PollInterval: 10 * time.Second,
+	}
+)
+
+type (
+	// WhiteWhaleProvider defines an oracle provider querying White Whale pool
+	// contracts directly using the REST endpoint of a node. The pools are
+	// forked from Terraswap and queried like the ones of Astroport, except for
+	// the swap, protocol and burn fees of the simulation. White Whale is
+	// deployed on multiple chains (Migaloo, Terra, Juno), the chain is
+	// selected by the `urls` and the `contracts` of the provider endpoints.
+	//
+	// REF: https://docs.whitewhale.money/white-whale/smart-contracts/liquidity-hub/pool-network/pool
+	WhiteWhaleProvider struct {
+		AstroportProvider
+	}
+
+	WhiteWhaleSimulationResponse struct {
+		ReturnAmount      string `json:"return_amount"`       // ex.: "1214235"
+		SpreadAmount      string `json:"spread_amount"`       // ex.: "12"
+		SwapFeeAmount     string `json:"swap_fee_amount"`     // ex.: "3653"
+		ProtocolFeeAmount string `json:"protocol_fee_amount"` // ex.: "1217"
+		BurnFeeAmount     string `json:"burn_fee_amount"`     // ex.: "0"
+	}
+)
+
+func NewWhiteWhaleProvider(
+	ctx context.Context,
+	logger zerolog.Logger,
+	endpoints Endpoint,
+	pairs ...types.CurrencyPair,
+) (*WhiteWhaleProvider, error) {
+	provider := &WhiteWhaleProvider{}
+	provider.newSimulation = newWhiteWhaleSimulation
+	provider.Init(
+		ctx,
+		endpoints,
+		logger,
+		pairs,
+		nil,
+		nil,
+	)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
+	return provider, nil
+}
+
+func newWhiteWhaleSimulation() cosmwasmSimulation {
+	return &WhiteWhaleSimulationResponse{}
+}
+
+func (r WhiteWhaleSimulationResponse) amounts() []string {
+	return []string{r.ReturnAmount, r.SpreadAmount, r.SwapFeeAmount, r.ProtocolFeeAmount, r.BurnFeeAmount}
+}
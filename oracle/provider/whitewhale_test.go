@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWhiteWhaleProvider_Poll(t *testing.T) {
+	const (
+		pool = "migaloo1xv4ql6t6r8zawlqn2tyxqsrvjpmjfm6kvdfvytaueqe3qvcwyr7shtx0hj"
+		usdc = "ibc/BC5C0BAFD19A5E4133FDA0F3E04AE1FBEE75A4A226554B2CBB021089FF2E1F8A"
+	)
+
+	server := newWasmTestServer(func(contract string, query map[string]json.RawMessage) interface{} {
+		switch {
+		case query["pool"] != nil:
+			return AstroportPoolResponse{Assets: []CosmwasmAsset{
+				{Info: newCosmwasmAssetInfo("uwhale"), Amount: "800000000000"},
+				{Info: newCosmwasmAssetInfo(usdc), Amount: "16000000000"},
+			}}
+		case query["simulation"] != nil:
+			return WhiteWhaleSimulationResponse{
+				ReturnAmount:      "19940",
+				SpreadAmount:      "1",
+				SwapFeeAmount:     "40",
+				ProtocolFeeAmount: "19",
+				BurnFeeAmount:     "0",
+			}
+		}
+		return nil
+	})
+	defer server.Close()
+
+	whaleUsdc := types.CurrencyPair{Base: "WHALE", Quote: "USDC"}
+
+	p := newTestProvider(t, NewWhiteWhaleProvider, server, Endpoint{
+		Name:      ProviderWhiteWhale,
+		Contracts: map[string]string{"WHALE": "uwhale", "USDC": usdc, "WHALEUSDC": pool},
+	}, whaleUsdc)
+
+	require.NoError(t, p.Poll())
+	for _, query := range wasmTestQueries(t, server) {
+		require.Equal(t, pool, query.contract)
+	}
+	require.Equal(t, sdk.MustNewDecFromStr("0.02"), p.tickers["WHALEUSDC"].Price)
+	require.Equal(t, sdk.MustNewDecFromStr("800000"), p.tickers["WHALEUSDC"].Volume)
+}
@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"strings"
 	"time"
 
@@ -24,7 +25,7 @@ type (
 	// XtProvider defines an oracle provider implemented by the XT.COM
 	// public API.
 	//
-	// REF: hhttps://doc.xt.com/#documentationrestApi
+	// REF: https://doc.xt.com/#market5tickerGet
 	XtProvider struct {
 		provider
 	}
@@ -58,17 +59,22 @@ func NewXtProvider(
 		nil,
 		nil,
 	)
-	go startPolling(provider, provider.endpoints.PollInterval, logger)
+	go startPolling(ctx, provider, provider.endpoints.PollInterval, logger)
 	return provider, nil
 }
 
 func (p *XtProvider) Poll() error {
 	symbols := make(map[string]string, len(p.pairs))
+	xtSymbols := make([]string, 0, len(p.pairs))
 	for _, pair := range p.pairs {
-		symbols[strings.ToLower(pair.Join("_"))] = pair.String()
+		xtSymbol := strings.ToLower(pair.Join("_"))
+		symbols[xtSymbol] = pair.String()
+		xtSymbols = append(xtSymbols, xtSymbol)
 	}
 
-	content, err := p.httpGet("/v4/public/ticker")
+	// only request the configured symbols, the long tail of XT.COM markets
+	// makes the response of all tickers rather large
+	content, err := p.httpGet("/v4/public/ticker?symbols=" + strings.Join(xtSymbols, ","))
 	if err != nil {
 		return err
 	}
@@ -79,6 +85,10 @@ func (p *XtProvider) Poll() error {
 		return err
 	}
 
+	if tickers.Code != 0 {
+		return fmt.Errorf("invalid response: %d: %s", tickers.Code, tickers.Message)
+	}
+
 	p.mtx.Lock()
 	defer p.mtx.Unlock()
 	for _, ticker := range tickers.Result {
@@ -0,0 +1,184 @@
+package oracle
+
+import (
+	"sync"
+	"time"
+
+	"price-feeder/oracle/provider"
+	"price-feeder/oracle/types"
+
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// qualityWindow defines the amount of ticks the provider quality is
+	// computed over.
+	qualityWindow = 100
+
+	qualityWeightUptime    = 0.4
+	qualityWeightLatency   = 0.2
+	qualityWeightDeviation = 0.2
+	qualityWeightStaleness = 0.2
+)
+
+type (
+	// qualitySample defines the health of a provider during a single tick.
+	qualitySample struct {
+		Up        bool
+		Latency   time.Duration
+		Expected  int // pairs configured for the provider
+		Missing   int // pairs missing or stale in the response
+		Deviating int // tickers deviating from consensus
+	}
+
+	// providerQualityTracker keeps a rolling window of samples per provider
+	// and scores them.
+	providerQualityTracker struct {
+		mtx     sync.RWMutex
+		window  int
+		timeout time.Duration
+		samples map[provider.Name][]qualitySample
+	}
+)
+
+func newProviderQualityTracker(window int, timeout time.Duration) *providerQualityTracker {
+	return &providerQualityTracker{
+		window:  window,
+		timeout: timeout,
+		samples: map[provider.Name][]qualitySample{},
+	}
+}
+
+// Add adds a sample to the window of the provider, dropping the oldest one
+// if the window is full.
+func (t *providerQualityTracker) Add(providerName provider.Name, sample qualitySample) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	samples := append(t.samples[providerName], sample)
+	if len(samples) > t.window {
+		samples = samples[len(samples)-t.window:]
+	}
+	t.samples[providerName] = samples
+}
+
+// Qualities returns the current quality of all tracked providers.
+func (t *providerQualityTracker) Qualities() map[provider.Name]types.ProviderQuality {
+	t.mtx.RLock()
+	defer t.mtx.RUnlock()
+
+	qualities := make(map[provider.Name]types.ProviderQuality, len(t.samples))
+	for providerName, samples := range t.samples {
+		qualities[providerName] = computeProviderQuality(samples, t.timeout)
+	}
+	return qualities
+}
+
+// computeProviderQuality scores the samples from 0 to 100 based on the
+// uptime, latency relative to the timeout, deviation from consensus and
+// staleness of the provider.
+func computeProviderQuality(samples []qualitySample, timeout time.Duration) types.ProviderQuality {
+	quality := types.ProviderQuality{SampleCount: len(samples)}
+	if len(samples) == 0 {
+		return quality
+	}
+
+	var (
+		up        int
+		latency   time.Duration
+		expected  int
+		missing   int
+		tickers   int
+		deviating int
+	)
+	for _, sample := range samples {
+		expected += sample.Expected
+		if !sample.Up {
+			// every pair of a provider that is down is missing
+			missing += sample.Expected
+			continue
+		}
+		up++
+		latency += sample.Latency
+		missing += sample.Missing
+		tickers += sample.Expected - sample.Missing
+		deviating += sample.Deviating
+	}
+
+	quality.Uptime = float64(up) / float64(len(samples))
+
+	latencyScore := 0.0
+	if up > 0 {
+		avgLatency := latency / time.Duration(up)
+		quality.LatencyMs = float64(avgLatency.Microseconds()) / 1000
+		if timeout > 0 && avgLatency < timeout {
+			latencyScore = 1 - float64(avgLatency)/float64(timeout)
+		}
+	}
+
+	if tickers > 0 {
+		quality.Deviation = float64(deviating) / float64(tickers)
+	}
+	if expected > 0 {
+		quality.Staleness = float64(missing) / float64(expected)
+	}
+
+	quality.Score = 100 * (qualityWeightUptime*quality.Uptime +
+		qualityWeightLatency*latencyScore +
+		qualityWeightDeviation*(1-quality.Deviation) +
+		qualityWeightStaleness*(1-quality.Staleness))
+
+	return quality
+}
+
+// countDeviatingTickers returns the amount of tickers per provider which are
+// not within the deviation threshold of the consensus.
+func countDeviatingTickers(
+	prices provider.AggregatedProviderPrices,
+	deviationThresholds map[string]sdk.Dec,
+	deviationFilter string,
+) map[provider.Name]int {
+	priceMap := make(map[provider.Name]map[string]sdk.Dec, len(prices))
+	for providerName, tickers := range prices {
+		priceMap[providerName] = make(map[string]sdk.Dec, len(tickers))
+		for symbol, ticker := range tickers {
+			priceMap[providerName][symbol] = ticker.Price
+		}
+	}
+
+	deviating := make(map[provider.Name]int)
+	deviations, means, err := ComputeDeviations(priceMap, deviationFilter)
+	if err != nil {
+		return deviating
+	}
+
+	for providerName, tickers := range priceMap {
+		for symbol, price := range tickers {
+			d, ok := deviations[symbol]
+			if !ok {
+				continue
+			}
+			t := defaultDeviationThreshold
+			if threshold, ok := deviationThresholds[symbol]; ok {
+				t = threshold
+			}
+			if !isBetween(price, means[symbol], d.Mul(t)) {
+				deviating[providerName]++
+			}
+		}
+	}
+
+	return deviating
+}
+
+// telemetryProviderQuality gives an standard way to add
+// `price_feeder_provider_quality{provider="x"}` metric.
+func telemetryProviderQuality(providerName provider.Name, quality types.ProviderQuality) {
+	telemetry.SetGaugeWithLabels(
+		[]string{"provider", "quality"},
+		float32(quality.Score),
+		[]metrics.Label{telemetry.NewLabel("provider", providerName.String())},
+	)
+}
@@ -0,0 +1,67 @@
+package oracle
+
+import (
+	"testing"
+	"time"
+
+	"price-feeder/oracle/provider"
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProviderQuality(t *testing.T) {
+	tracker := newProviderQualityTracker(10, 100*time.Millisecond)
+
+	for i := 0; i < 20; i++ {
+		tracker.Add(provider.ProviderBinance, qualitySample{
+			Up:       true,
+			Latency:  10 * time.Millisecond,
+			Expected: 2,
+		})
+
+		// only responds every fourth tick
+		sample := qualitySample{Expected: 2}
+		if i%4 == 0 {
+			sample = qualitySample{
+				Up:       true,
+				Latency:  10 * time.Millisecond,
+				Expected: 2,
+			}
+		}
+		tracker.Add(provider.ProviderKraken, sample)
+	}
+
+	qualities := tracker.Qualities()
+
+	healthy := qualities[provider.ProviderBinance]
+	require.Equal(t, 10, healthy.SampleCount)
+	require.Equal(t, 1.0, healthy.Uptime)
+	require.Equal(t, 10.0, healthy.LatencyMs)
+	// 40 + 20 * 0.9 + 20 + 20
+	require.InDelta(t, 98, healthy.Score, 0.001)
+
+	poor := qualities[provider.ProviderKraken]
+	require.Equal(t, 10, poor.SampleCount)
+	require.InDelta(t, 0.2, poor.Uptime, 0.001)
+	require.InDelta(t, 0.8, poor.Staleness, 0.001)
+	// 40 * 0.2 + 20 * 0.9 + 20 + 20 * 0.2
+	require.InDelta(t, 50, poor.Score, 0.001)
+	require.Less(t, poor.Score, healthy.Score)
+}
+
+func TestCountDeviatingTickers(t *testing.T) {
+	ticker := func(price string) types.TickerPrice {
+		return types.TickerPrice{Price: sdk.MustNewDecFromStr(price), Volume: sdk.OneDec()}
+	}
+	prices := provider.AggregatedProviderPrices{
+		provider.ProviderBinance:  {"ATOMUSDT": ticker("10")},
+		provider.ProviderHuobi:    {"ATOMUSDT": ticker("10")},
+		provider.ProviderKraken:   {"ATOMUSDT": ticker("10")},
+		provider.ProviderCoinbase: {"ATOMUSDT": ticker("12")},
+	}
+
+	deviating := countDeviatingTickers(prices, map[string]sdk.Dec{}, "")
+	require.Equal(t, map[provider.Name]int{provider.ProviderCoinbase: 1}, deviating)
+}
@@ -0,0 +1,138 @@
+package oracle
+
+import (
+	"price-feeder/config"
+	"price-feeder/oracle/provider"
+	"price-feeder/oracle/types"
+
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// providerRoles returns the roles of the providers of the currency pairs, which
+// default to the role of their default endpoints, ex.: "fallback" for
+// coingecko, or "vote" otherwise.
+func providerRoles(
+	currencyPairs []config.CurrencyPair,
+	endpoints map[provider.Name]provider.Endpoint,
+) map[provider.Name]provider.Role {
+	roles := make(map[provider.Name]provider.Role)
+	for _, pair := range currencyPairs {
+		for _, providerName := range pair.Providers {
+			endpoint, ok := endpoints[providerName]
+			if !ok {
+				endpoint = provider.Endpoint{Name: providerName}
+			}
+			endpoint.SetDefaults()
+			roles[providerName] = endpoint.Role
+			if endpoint.Role == "" {
+				roles[providerName] = provider.RoleVote
+			}
+		}
+	}
+	return roles
+}
+
+// splitReferencePrices separates the prices of reference-only providers, which
+// are monitored but never voted, from the prices of voting providers. The
+// tickers of fallback providers are only voted for bases none of the other
+// voting providers has a ticker for.
+func splitReferencePrices(
+	providerPrices provider.AggregatedProviderPrices,
+	providerPairs map[provider.Name][]types.CurrencyPair,
+	roles map[provider.Name]provider.Role,
+) (provider.AggregatedProviderPrices, provider.AggregatedProviderPrices) {
+	votePrices := provider.AggregatedProviderPrices{}
+	referencePrices := provider.AggregatedProviderPrices{}
+	votedBases := map[string]struct{}{}
+	for providerName, tickers := range providerPrices {
+		switch roles[providerName] {
+		case provider.RoleReferenceOnly:
+			referencePrices[providerName] = tickers
+		case provider.RoleFallback:
+		default:
+			votePrices[providerName] = tickers
+			for _, pair := range providerPairs[providerName] {
+				if _, ok := tickers[pair.String()]; ok {
+					votedBases[pair.Base] = struct{}{}
+				}
+			}
+		}
+	}
+
+	for providerName, tickers := range providerPrices {
+		if roles[providerName] != provider.RoleFallback {
+			continue
+		}
+		fallbackTickers := map[string]types.TickerPrice{}
+		for _, pair := range providerPairs[providerName] {
+			ticker, ok := tickers[pair.String()]
+			if !ok {
+				continue
+			}
+			if _, ok := votedBases[pair.Base]; !ok {
+				fallbackTickers[pair.String()] = ticker
+			}
+		}
+		if len(fallbackTickers) > 0 {
+			votePrices[providerName] = fallbackTickers
+		}
+	}
+	return votePrices, referencePrices
+}
+
+// ComputeReferenceDeviations returns the relative deviation of the prices of
+// reference-only providers from the computed USD prices, per provider and base.
+// Prices in other quotes are converted using the computed price of the quote.
+func ComputeReferenceDeviations(
+	computedPrices map[string]sdk.Dec,
+	referencePrices provider.AggregatedProviderPrices,
+	providerPairs map[provider.Name][]types.CurrencyPair,
+) map[provider.Name]map[string]sdk.Dec {
+	deviations := make(map[provider.Name]map[string]sdk.Dec, len(referencePrices))
+	for providerName, tickers := range referencePrices {
+		for _, pair := range providerPairs[providerName] {
+			ticker, ok := tickers[pair.String()]
+			if !ok {
+				continue
+			}
+			computed, ok := computedPrices[pair.Base]
+			if !ok || !computed.IsPositive() {
+				continue
+			}
+
+			price := ticker.Price
+			if pair.Quote != config.DenomUSD {
+				quotePrice, ok := computedPrices[pair.Quote]
+				if !ok {
+					continue
+				}
+				price = price.Mul(quotePrice)
+			}
+
+			if _, ok := deviations[providerName]; !ok {
+				deviations[providerName] = make(map[string]sdk.Dec)
+			}
+			deviations[providerName][pair.Base] = price.Sub(computed).Abs().Quo(computed)
+		}
+	}
+	return deviations
+}
+
+// telemetryReferenceDeviations gives an standard way to add
+// `price_feeder_reference_deviation{provider="x",denom="x"}` metrics.
+func telemetryReferenceDeviations(deviations map[provider.Name]map[string]sdk.Dec) {
+	for providerName, bases := range deviations {
+		for base, deviation := range bases {
+			telemetry.SetGaugeWithLabels(
+				[]string{"reference", "deviation"},
+				float32(deviation.MustFloat64()),
+				[]metrics.Label{
+					telemetry.NewLabel("provider", providerName.String()),
+					telemetry.NewLabel("denom", base),
+				},
+			)
+		}
+	}
+}
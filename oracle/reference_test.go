@@ -0,0 +1,77 @@
+package oracle
+
+import (
+	"testing"
+
+	"price-feeder/config"
+	"price-feeder/oracle/provider"
+	"price-feeder/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProviderRoles(t *testing.T) {
+	roles := providerRoles([]config.CurrencyPair{
+		{Base: "ATOM", Quote: "USDT", Providers: []provider.Name{
+			provider.ProviderBinance,
+			provider.ProviderKraken,
+			provider.ProviderCoinGecko,
+			provider.ProviderCoinMarketCap,
+		}},
+	}, map[provider.Name]provider.Endpoint{
+		provider.ProviderKraken:        {Name: provider.ProviderKraken, Role: provider.RoleReferenceOnly},
+		provider.ProviderCoinMarketCap: {Name: provider.ProviderCoinMarketCap, Role: provider.RoleVote},
+	})
+
+	require.Equal(t, map[provider.Name]provider.Role{
+		provider.ProviderBinance:       provider.RoleVote,
+		provider.ProviderKraken:        provider.RoleReferenceOnly,
+		provider.ProviderCoinGecko:     provider.RoleFallback,
+		provider.ProviderCoinMarketCap: provider.RoleVote,
+	}, roles)
+}
+
+func TestSplitReferencePrices(t *testing.T) {
+	atomUsdt := types.CurrencyPair{Base: "ATOM", Quote: "USDT"}
+	atomUsd := types.CurrencyPair{Base: "ATOM", Quote: "USD"}
+	osmoUsd := types.CurrencyPair{Base: "OSMO", Quote: "USD"}
+	ticker := types.TickerPrice{Price: sdk.OneDec(), Volume: sdk.OneDec()}
+
+	providerPairs := map[provider.Name][]types.CurrencyPair{
+		provider.ProviderBinance:   {atomUsdt, osmoUsd},
+		provider.ProviderKraken:    {osmoUsd},
+		provider.ProviderCoinGecko: {atomUsd, osmoUsd},
+	}
+	roles := map[provider.Name]provider.Role{
+		provider.ProviderBinance:   provider.RoleVote,
+		provider.ProviderKraken:    provider.RoleReferenceOnly,
+		provider.ProviderCoinGecko: provider.RoleFallback,
+	}
+
+	// binance prices ATOM in another quote, so only the OSMO price of the
+	// fallback is voted since binance has no OSMO ticker
+	votePrices, referencePrices := splitReferencePrices(provider.AggregatedProviderPrices{
+		provider.ProviderBinance:   {"ATOMUSDT": ticker},
+		provider.ProviderKraken:    {"OSMOUSD": ticker},
+		provider.ProviderCoinGecko: {"ATOMUSD": ticker, "OSMOUSD": ticker},
+	}, providerPairs, roles)
+
+	require.Equal(t, provider.AggregatedProviderPrices{
+		provider.ProviderBinance:   {"ATOMUSDT": ticker},
+		provider.ProviderCoinGecko: {"OSMOUSD": ticker},
+	}, votePrices)
+	require.Equal(t, provider.AggregatedProviderPrices{
+		provider.ProviderKraken: {"OSMOUSD": ticker},
+	}, referencePrices)
+
+	// the fallback isn't voted at all while binance prices both bases
+	votePrices, _ = splitReferencePrices(provider.AggregatedProviderPrices{
+		provider.ProviderBinance:   {"ATOMUSDT": ticker, "OSMOUSD": ticker},
+		provider.ProviderCoinGecko: {"ATOMUSD": ticker, "OSMOUSD": ticker},
+	}, providerPairs, roles)
+
+	require.Equal(t, provider.AggregatedProviderPrices{
+		provider.ProviderBinance: {"ATOMUSDT": ticker, "OSMOUSD": ticker},
+	}, votePrices)
+}
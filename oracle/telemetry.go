@@ -0,0 +1,124 @@
+package oracle
+
+import (
+	"math"
+
+	"price-feeder/oracle/provider"
+	"price-feeder/oracle/types"
+
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// telemetryPrices gives an standard way to add `price_feeder_price{denom="x"}`
+// and `price_feeder_price_deviation{denom="x"}` metrics for the voted prices of
+// the current cycle. Denoms of the previous cycle which are abstained from are set
+// to NaN, so the last voted price doesn't linger. It returns the denoms which
+// were set.
+func telemetryPrices(
+	prices map[string]sdk.Dec,
+	deviations map[string]sdk.Dec,
+	previous map[string]struct{},
+) map[string]struct{} {
+	current := make(map[string]struct{}, len(prices))
+	for denom, price := range prices {
+		labels := []metrics.Label{telemetry.NewLabel("denom", denom)}
+		telemetry.SetGaugeWithLabels([]string{"price"}, float32(price.MustFloat64()), labels)
+		if deviation, ok := deviations[denom]; ok {
+			telemetry.SetGaugeWithLabels([]string{"price", "deviation"}, float32(deviation.MustFloat64()), labels)
+		}
+		current[denom] = struct{}{}
+	}
+
+	nan := float32(math.NaN())
+	for denom := range previous {
+		if _, ok := current[denom]; ok {
+			continue
+		}
+		labels := []metrics.Label{telemetry.NewLabel("denom", denom)}
+		telemetry.SetGaugeWithLabels([]string{"price"}, nan, labels)
+		telemetry.SetGaugeWithLabels([]string{"price", "deviation"}, nan, labels)
+	}
+
+	return current
+}
+
+// telemetryStaleTickers gives an standard way to add
+// `price_feeder_provider_stale{provider="x",pair="x"}` metrics, which are set
+// to 1 for the tickers carried by their providers while the market is closed.
+// Tickers of the previous cycle which aren't carried anymore are set to 0. It
+// returns the tickers which were set to 1.
+func telemetryStaleTickers(
+	providerPrices provider.AggregatedProviderPrices,
+	previous map[provider.Name]map[string]struct{},
+) map[provider.Name]map[string]struct{} {
+	current := make(map[provider.Name]map[string]struct{})
+	for providerName, tickers := range providerPrices {
+		for symbol, ticker := range tickers {
+			if !ticker.Stale {
+				continue
+			}
+			if _, ok := current[providerName]; !ok {
+				current[providerName] = make(map[string]struct{})
+			}
+			current[providerName][symbol] = struct{}{}
+			telemetry.SetGaugeWithLabels([]string{"provider", "stale"}, 1, staleTickerLabels(providerName, symbol))
+		}
+	}
+
+	for providerName, symbols := range previous {
+		for symbol := range symbols {
+			if _, ok := current[providerName][symbol]; ok {
+				continue
+			}
+			telemetry.SetGaugeWithLabels([]string{"provider", "stale"}, 0, staleTickerLabels(providerName, symbol))
+		}
+	}
+
+	return current
+}
+
+func staleTickerLabels(providerName provider.Name, symbol string) []metrics.Label {
+	return []metrics.Label{
+		telemetry.NewLabel("provider", providerName.String()),
+		telemetry.NewLabel("pair", symbol),
+	}
+}
+
+// computePriceDeviations returns the largest relative standard deviation
+// (𝜎 / mean) between the providers of any pair of each base. Pairs with less
+// than three providers are skipped.
+func computePriceDeviations(
+	providerPrices provider.AggregatedProviderPrices,
+	providerPairs map[provider.Name][]types.CurrencyPair,
+) map[string]sdk.Dec {
+	priceMap := make(map[provider.Name]map[string]sdk.Dec, len(providerPrices))
+	for providerName, tickers := range providerPrices {
+		priceMap[providerName] = make(map[string]sdk.Dec, len(tickers))
+		for symbol, ticker := range tickers {
+			priceMap[providerName][symbol] = ticker.Price
+		}
+	}
+
+	deviations := make(map[string]sdk.Dec)
+	stdDevs, means, err := StandardDeviation(priceMap)
+	if err != nil {
+		return deviations
+	}
+
+	for _, pairs := range providerPairs {
+		for _, pair := range pairs {
+			stdDev, ok := stdDevs[pair.String()]
+			if !ok || !means[pair.String()].IsPositive() {
+				continue
+			}
+			deviation := stdDev.Quo(means[pair.String()])
+			if existing, ok := deviations[pair.Base]; !ok || deviation.GT(existing) {
+				deviations[pair.Base] = deviation
+			}
+		}
+	}
+
+	return deviations
+}
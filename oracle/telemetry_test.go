@@ -0,0 +1,94 @@
+package oracle
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"price-feeder/oracle/provider"
+
+	"github.com/armon/go-metrics"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTelemetryPrices(t *testing.T) {
+	sink := metrics.NewInmemSink(time.Minute, time.Minute)
+	cfg := metrics.DefaultConfig("price_feeder")
+	cfg.EnableHostname = false
+	cfg.EnableRuntimeMetrics = false
+	_, err := metrics.NewGlobal(cfg, sink)
+	require.NoError(t, err)
+
+	gauge := func(key string) float32 {
+		data := sink.Data()
+		require.NotEmpty(t, data)
+		value, ok := data[len(data)-1].Gauges[key]
+		require.True(t, ok, key)
+		return value.Value
+	}
+
+	published := telemetryPrices(
+		map[string]sdk.Dec{
+			"ATOM": sdk.MustNewDecFromStr("10.5"),
+			"UMEE": sdk.MustNewDecFromStr("0.25"),
+		},
+		map[string]sdk.Dec{"ATOM": sdk.MustNewDecFromStr("0.01")},
+		nil,
+	)
+	require.Len(t, published, 2)
+	require.Equal(t, float32(10.5), gauge("price_feeder.price;denom=ATOM"))
+	require.Equal(t, float32(0.01), gauge("price_feeder.price.deviation;denom=ATOM"))
+	require.Equal(t, float32(0.25), gauge("price_feeder.price;denom=UMEE"))
+
+	// the gauge follows the latest aggregate and abstained denoms are cleared
+	published = telemetryPrices(
+		map[string]sdk.Dec{"ATOM": sdk.MustNewDecFromStr("11")},
+		map[string]sdk.Dec{},
+		published,
+	)
+	require.Len(t, published, 1)
+	require.Equal(t, float32(11), gauge("price_feeder.price;denom=ATOM"))
+	require.True(t, math.IsNaN(float64(gauge("price_feeder.price;denom=UMEE"))))
+}
+
+func TestTelemetryStaleTickers(t *testing.T) {
+	sink := metrics.NewInmemSink(time.Minute, time.Minute)
+	cfg := metrics.DefaultConfig("price_feeder")
+	cfg.EnableHostname = false
+	cfg.EnableRuntimeMetrics = false
+	_, err := metrics.NewGlobal(cfg, sink)
+	require.NoError(t, err)
+
+	gauge := func(key string) float32 {
+		data := sink.Data()
+		require.NotEmpty(t, data)
+		value, ok := data[len(data)-1].Gauges[key]
+		require.True(t, ok, key)
+		return value.Value
+	}
+
+	stale := telemetryStaleTickers(provider.AggregatedProviderPrices{
+		provider.ProviderTreasury: {
+			"TBILLUSD":  {Price: sdk.MustNewDecFromStr("5.27"), Stale: true},
+			"UST10YUSD": {Price: sdk.MustNewDecFromStr("4.44"), Stale: true},
+		},
+		provider.ProviderKraken: {
+			"ATOMUSD": {Price: sdk.MustNewDecFromStr("10.5")},
+		},
+	}, nil)
+	require.Len(t, stale[provider.ProviderTreasury], 2)
+	require.NotContains(t, stale, provider.ProviderKraken)
+	require.Equal(t, float32(1), gauge("price_feeder.provider.stale;provider=treasury;pair=TBILLUSD"))
+
+	// tickers which aren't carried anymore are reset
+	stale = telemetryStaleTickers(provider.AggregatedProviderPrices{
+		provider.ProviderTreasury: {
+			"TBILLUSD":  {Price: sdk.MustNewDecFromStr("5.28")},
+			"UST10YUSD": {Price: sdk.MustNewDecFromStr("4.44"), Stale: true},
+		},
+	}, stale)
+	require.Len(t, stale[provider.ProviderTreasury], 1)
+	require.Equal(t, float32(0), gauge("price_feeder.provider.stale;provider=treasury;pair=TBILLUSD"))
+	require.Equal(t, float32(1), gauge("price_feeder.provider.stale;provider=treasury;pair=UST10YUSD"))
+}
@@ -1,6 +1,23 @@
 package types
 
-import "strings"
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// PriceUnitWhole denotes prices quoted per whole token, e.g. ATOM.
+	PriceUnitWhole PriceUnit = "whole"
+	// PriceUnitMicro denotes prices quoted per micro token (10^-6), e.g. uatom.
+	PriceUnitMicro PriceUnit = "micro"
+)
+
+// PriceUnit defines the denomination unit an exchange rate is expressed in.
+// Providers always report prices per whole token, the unit of a pair only
+// affects the exchange rate submitted on-chain.
+type PriceUnit string
 
 // CurrencyPair defines a currency exchange pair consisting of a base and a quote.
 // We primarily utilize the base for broadcasting exchange rates and use the
@@ -8,6 +25,7 @@ import "strings"
 type CurrencyPair struct {
 	Base  string
 	Quote string
+	Unit  PriceUnit
 }
 
 // String implements the Stringer interface and defines a ticker symbol for
@@ -33,3 +51,36 @@ func MapPairsToSlice(mapPairs map[string]CurrencyPair) []CurrencyPair {
 
 	return currencyPairs
 }
+
+// ParsePriceUnit returns the PriceUnit for the given string. An empty string
+// defaults to whole units.
+func ParsePriceUnit(unit string) (PriceUnit, error) {
+	switch PriceUnit(strings.ToLower(unit)) {
+	case "", PriceUnitWhole:
+		return PriceUnitWhole, nil
+	case PriceUnitMicro:
+		return PriceUnitMicro, nil
+	}
+	return "", fmt.Errorf("unsupported price unit: %s", unit)
+}
+
+// Exponent returns the decimal exponent of one unit relative to a whole token.
+func (u PriceUnit) Exponent() int64 {
+	if u == PriceUnitMicro {
+		return 6
+	}
+	return 0
+}
+
+// ConvertPrice converts a price quoted per `from` unit into a price quoted per
+// `to` unit, e.g. 12.5 per ATOM becomes 0.0000125 per uatom.
+func ConvertPrice(price sdk.Dec, from, to PriceUnit) sdk.Dec {
+	exponent := from.Exponent() - to.Exponent()
+	switch {
+	case exponent > 0:
+		return price.Mul(sdk.NewDec(10).Power(uint64(exponent)))
+	case exponent < 0:
+		return price.Quo(sdk.NewDec(10).Power(uint64(-exponent)))
+	}
+	return price
+}
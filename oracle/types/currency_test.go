@@ -0,0 +1,46 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePriceUnit(t *testing.T) {
+	unit, err := ParsePriceUnit("")
+	require.NoError(t, err)
+	require.Equal(t, PriceUnitWhole, unit)
+
+	unit, err = ParsePriceUnit("Micro")
+	require.NoError(t, err)
+	require.Equal(t, PriceUnitMicro, unit)
+
+	_, err = ParsePriceUnit("nano")
+	require.Error(t, err)
+}
+
+func TestConvertPrice(t *testing.T) {
+	price := sdk.MustNewDecFromStr("12.5")
+
+	t.Run("whole to micro", func(t *testing.T) {
+		converted := ConvertPrice(price, PriceUnitWhole, PriceUnitMicro)
+		require.Equal(t, sdk.MustNewDecFromStr("0.0000125"), converted)
+	})
+
+	t.Run("micro to whole", func(t *testing.T) {
+		converted := ConvertPrice(sdk.MustNewDecFromStr("0.0000125"), PriceUnitMicro, PriceUnitWhole)
+		require.Equal(t, price, converted)
+	})
+
+	t.Run("already micro passthrough", func(t *testing.T) {
+		micro := sdk.MustNewDecFromStr("0.0000125")
+		converted := ConvertPrice(micro, PriceUnitMicro, PriceUnitMicro)
+		require.Equal(t, micro, converted)
+	})
+
+	t.Run("whole passthrough", func(t *testing.T) {
+		converted := ConvertPrice(price, PriceUnitWhole, PriceUnitWhole)
+		require.Equal(t, price, converted)
+	})
+}
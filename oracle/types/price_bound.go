@@ -0,0 +1,32 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PriceBound defines the absolute plausibility bounds of a denom's whole
+// token price. Any unset (nil) field is not checked.
+type PriceBound struct {
+	Min sdk.Dec
+	Max sdk.Dec
+	// Magnitude is the expected order of magnitude of the price. Prices more
+	// than ten times larger or smaller are considered implausible.
+	Magnitude sdk.Dec
+}
+
+// IsPlausible returns whether the price lies within the bounds.
+func (b PriceBound) IsPlausible(price sdk.Dec) bool {
+	if !b.Min.IsNil() && price.LT(b.Min) {
+		return false
+	}
+	if !b.Max.IsNil() && price.GT(b.Max) {
+		return false
+	}
+	if !b.Magnitude.IsNil() {
+		ten := sdk.NewDec(10)
+		if price.LT(b.Magnitude.Quo(ten)) || price.GT(b.Magnitude.Mul(ten)) {
+			return false
+		}
+	}
+	return true
+}
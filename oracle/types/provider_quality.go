@@ -0,0 +1,12 @@
+package types
+
+// ProviderQuality summarizes the data quality of a provider over a rolling
+// window of oracle ticks. All ratios are in [0, 1].
+type ProviderQuality struct {
+	Score       float64 `json:"score"`        // 0-100, higher is better
+	Uptime      float64 `json:"uptime"`       // ratio of ticks the provider responded in time
+	LatencyMs   float64 `json:"latency_ms"`   // average response latency
+	Deviation   float64 `json:"deviation"`    // ratio of tickers deviating from consensus
+	Staleness   float64 `json:"staleness"`    // ratio of pairs missing or stale
+	SampleCount int     `json:"sample_count"` // amount of ticks in the window
+}
@@ -12,6 +12,8 @@ type TickerPrice struct {
 	Price  sdk.Dec   `json:"price"`  // last trade price
 	Volume sdk.Dec   `json:"volume"` // 24h volume
 	Time   time.Time `json:"time"`
+	Spread sdk.Dec   `json:"spread,omitempty"` // relative bid/ask spread, if reported
+	Stale  bool      `json:"stale,omitempty"`  // last value carried at its original time while the market is closed
 }
 
 func NewTickerPrice(price string, volume string, timestamp time.Time) (TickerPrice, error) {
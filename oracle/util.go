@@ -1,12 +1,35 @@
 package oracle
 
 import (
+	"fmt"
+	"sort"
+
 	"price-feeder/oracle/provider"
 	"price-feeder/oracle/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+const (
+	// AggregationVWAP weights the prices of the providers by their volume.
+	AggregationVWAP = "vwap"
+	// AggregationMedian uses the median of the prices of the providers.
+	AggregationMedian = "median"
+	// AggregationTrimmedMean uses the mean of the prices of the providers
+	// after dropping the highest and lowest prices.
+	AggregationTrimmedMean = "trimmed_mean"
+)
+
+// defaultAggregationTrim defines the fraction of the prices dropped at each
+// end by the trimmed mean if the pair doesn't set a trim.
+var defaultAggregationTrim = sdk.MustNewDecFromStr("0.2")
+
+// Aggregation defines how the prices of the providers of a pair are combined.
+type Aggregation struct {
+	Mode string  // ex. "median"
+	Trim sdk.Dec // ex. 0.2, only used by the trimmed mean
+}
+
 // ComputeVWAP computes the volume weighted average price for all tickers
 // of all pairs of the same symbol.
 // Ref: https://en.wikipedia.org/wiki/Volume-weighted_average_price
@@ -29,6 +52,155 @@ func ComputeVWAP(tickers []types.TickerPrice) (sdk.Dec, error) {
 	return weightedPrice.Quo(volumeSum), nil
 }
 
+// ComputeMedian computes the median price of all tickers of all pairs of the
+// same symbol regardless of their volume, so a single venue with inflated
+// volume can't dominate the price of a thin market.
+func ComputeMedian(tickers []types.TickerPrice) (sdk.Dec, error) {
+	if len(tickers) == 0 {
+		return sdk.ZeroDec(), nil
+	}
+
+	prices := sortedPrices(tickers)
+	middle := len(prices) / 2
+	if len(prices)%2 == 0 {
+		return prices[middle-1].Add(prices[middle]).QuoInt64(2), nil
+	}
+	return prices[middle], nil
+}
+
+// ComputeTrimmedMean computes the mean price of all tickers of all pairs of
+// the same symbol after dropping the given fraction of the highest and of the
+// lowest prices, as a middle ground between the VWAP and the median.
+func ComputeTrimmedMean(tickers []types.TickerPrice, trim sdk.Dec) (sdk.Dec, error) {
+	if len(tickers) == 0 {
+		return sdk.ZeroDec(), nil
+	}
+	if trim.IsNil() || trim.IsNegative() || trim.GTE(sdk.NewDecWithPrec(5, 1)) {
+		return sdk.Dec{}, fmt.Errorf("invalid trim: %s", trim)
+	}
+
+	prices := sortedPrices(tickers)
+	dropped := trim.MulInt64(int64(len(prices))).TruncateInt64()
+	prices = prices[dropped : int64(len(prices))-dropped]
+
+	sum := sdk.ZeroDec()
+	for _, price := range prices {
+		sum = sum.Add(price)
+	}
+	return sum.QuoInt64(int64(len(prices))), nil
+}
+
+// sortedPrices returns the prices of the tickers in ascending order.
+func sortedPrices(tickers []types.TickerPrice) []sdk.Dec {
+	prices := make([]sdk.Dec, len(tickers))
+	for i, tp := range tickers {
+		prices[i] = tp.Price
+	}
+	sort.Slice(prices, func(i, j int) bool {
+		return prices[i].LT(prices[j])
+	})
+	return prices
+}
+
+// ComputeAggregatedPrice computes the price of the tickers of a symbol using
+// the configured aggregation, which defaults to the VWAP.
+func ComputeAggregatedPrice(aggregation Aggregation, tickers []types.TickerPrice) (sdk.Dec, error) {
+	switch aggregation.Mode {
+	case AggregationMedian:
+		return ComputeMedian(tickers)
+	case AggregationTrimmedMean:
+		trim := aggregation.Trim
+		if trim.IsNil() {
+			trim = defaultAggregationTrim
+		}
+		return ComputeTrimmedMean(tickers, trim)
+	default:
+		return ComputeVWAP(tickers)
+	}
+}
+
+const (
+	// DeviationFilterStdDev filters prices using the standard deviation
+	// around the mean.
+	DeviationFilterStdDev = "stddev"
+	// DeviationFilterMAD filters prices using the median absolute deviation
+	// around the median, which isn't widened by the outliers themselves.
+	DeviationFilterMAD = "mad"
+)
+
+var (
+	// madScale scales the median absolute deviation to estimate the standard
+	// deviation of normally distributed prices, so the deviation thresholds
+	// keep their meaning.
+	madScale = sdk.MustNewDecFromStr("1.4826")
+	// madMinDeviation defines the minimum deviation relative to the median,
+	// since the median absolute deviation is zero when most providers report
+	// the same price.
+	madMinDeviation = sdk.MustNewDecFromStr("0.001")
+)
+
+// ComputeDeviations returns maps of the deviations and centers of assets
+// used to filter outliers, using either the standard deviation around the
+// mean or the median absolute deviation around the median.
+func ComputeDeviations(
+	prices map[provider.Name]map[string]sdk.Dec,
+	filter string,
+) (map[string]sdk.Dec, map[string]sdk.Dec, error) {
+	if filter == DeviationFilterMAD {
+		return MedianAbsoluteDeviation(prices)
+	}
+	return StandardDeviation(prices)
+}
+
+// MedianAbsoluteDeviation returns maps of the scaled median absolute
+// deviations and medians of assets. Will skip calculating for an asset if
+// there are less than 3 prices.
+func MedianAbsoluteDeviation(
+	prices map[provider.Name]map[string]sdk.Dec,
+) (map[string]sdk.Dec, map[string]sdk.Dec, error) {
+	var (
+		deviations = make(map[string]sdk.Dec)
+		medians    = make(map[string]sdk.Dec)
+		priceSlice = make(map[string][]types.TickerPrice)
+	)
+
+	for _, providerPrices := range prices {
+		for base, p := range providerPrices {
+			priceSlice[base] = append(priceSlice[base], types.TickerPrice{Price: p})
+		}
+	}
+
+	for base, tickers := range priceSlice {
+		if len(tickers) < 3 {
+			continue
+		}
+
+		median, err := ComputeMedian(tickers)
+		if err != nil {
+			return make(map[string]sdk.Dec), make(map[string]sdk.Dec), err
+		}
+
+		absoluteDeviations := make([]types.TickerPrice, len(tickers))
+		for i, ticker := range tickers {
+			absoluteDeviations[i] = types.TickerPrice{Price: ticker.Price.Sub(median).Abs()}
+		}
+		mad, err := ComputeMedian(absoluteDeviations)
+		if err != nil {
+			return make(map[string]sdk.Dec), make(map[string]sdk.Dec), err
+		}
+
+		deviation := mad.Mul(madScale)
+		if minDeviation := median.Abs().Mul(madMinDeviation); deviation.LT(minDeviation) {
+			deviation = minDeviation
+		}
+
+		deviations[base] = deviation
+		medians[base] = median
+	}
+
+	return deviations, medians, nil
+}
+
 // StandardDeviation returns maps of the standard deviations and means of assets.
 // Will skip calculating for an asset if there are less than 3 prices.
 func StandardDeviation(
@@ -88,3 +260,54 @@ func StandardDeviation(
 
 	return deviations, means, nil
 }
+
+// ComputeVolumeWeightedSpread computes the volume weighted average spread of
+// all tickers reporting a spread. It returns false if none of them do.
+func ComputeVolumeWeightedSpread(tickers []types.TickerPrice) (sdk.Dec, bool) {
+	weightedSpread := sdk.ZeroDec()
+	volumeSum := sdk.ZeroDec()
+
+	for _, tp := range tickers {
+		if tp.Spread.IsNil() || tp.Volume.IsNil() {
+			continue
+		}
+
+		// weightedSpread = Σ {S * V} for all TickerPrice with a spread
+		weightedSpread = weightedSpread.Add(tp.Spread.Mul(tp.Volume))
+		volumeSum = volumeSum.Add(tp.Volume)
+	}
+
+	if !volumeSum.IsPositive() {
+		return sdk.Dec{}, false
+	}
+
+	return weightedSpread.Quo(volumeSum), true
+}
+
+// ComputeSpreads returns the volume weighted average spread per base across
+// all providers and quotes.
+func ComputeSpreads(
+	providerPrices provider.AggregatedProviderPrices,
+	providerPairs map[provider.Name][]types.CurrencyPair,
+) map[string]sdk.Dec {
+	tickers := make(map[string][]types.TickerPrice)
+	for providerName, pairs := range providerPairs {
+		for _, pair := range pairs {
+			ticker, ok := providerPrices[providerName][pair.String()]
+			if !ok {
+				continue
+			}
+			tickers[pair.Base] = append(tickers[pair.Base], ticker)
+		}
+	}
+
+	spreads := make(map[string]sdk.Dec)
+	for base, baseTickers := range tickers {
+		spread, ok := ComputeVolumeWeightedSpread(baseTickers)
+		if ok {
+			spreads[base] = spread
+		}
+	}
+
+	return spreads
+}
@@ -65,6 +65,62 @@ func TestComputeVWAP(t *testing.T) {
 	}
 }
 
+func TestComputeMedian(t *testing.T) {
+	tickers := []types.TickerPrice{{
+		Price:  sdk.MustNewDecFromStr("10.1"),
+		Volume: sdk.MustNewDecFromStr("100"),
+	}, {
+		Price:  sdk.MustNewDecFromStr("9.9"),
+		Volume: sdk.MustNewDecFromStr("100"),
+	}, {
+		// a wash traded venue dominating the volume
+		Price:  sdk.MustNewDecFromStr("15"),
+		Volume: sdk.MustNewDecFromStr("1000000"),
+	}}
+
+	median, err := oracle.ComputeMedian(tickers)
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("10.1"), median)
+
+	median, err = oracle.ComputeAggregatedPrice(oracle.Aggregation{Mode: oracle.AggregationMedian}, tickers[:2])
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("10"), median)
+
+	vwap, err := oracle.ComputeAggregatedPrice(oracle.Aggregation{}, tickers)
+	require.NoError(t, err)
+	require.True(t, vwap.GT(sdk.MustNewDecFromStr("14.9")))
+
+	median, err = oracle.ComputeMedian(nil)
+	require.NoError(t, err)
+	require.True(t, median.IsZero())
+}
+
+func TestComputeTrimmedMean(t *testing.T) {
+	tickers := []types.TickerPrice{}
+	for _, price := range []string{"15", "10.2", "9.8", "10", "1"} {
+		tickers = append(tickers, types.TickerPrice{
+			Price:  sdk.MustNewDecFromStr(price),
+			Volume: sdk.OneDec(),
+		})
+	}
+
+	mean, err := oracle.ComputeTrimmedMean(tickers, sdk.MustNewDecFromStr("0.2"))
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("10"), mean)
+
+	// less than one price per end is kept
+	mean, err = oracle.ComputeTrimmedMean(tickers, sdk.MustNewDecFromStr("0.1"))
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("9.2"), mean)
+
+	mean, err = oracle.ComputeAggregatedPrice(oracle.Aggregation{Mode: oracle.AggregationTrimmedMean}, tickers)
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("10"), mean)
+
+	_, err = oracle.ComputeTrimmedMean(tickers, sdk.MustNewDecFromStr("0.5"))
+	require.Error(t, err)
+}
+
 func TestStandardDeviation(t *testing.T) {
 	type deviation struct {
 		mean      sdk.Dec
@@ -178,3 +234,43 @@ func TestStandardDeviation(t *testing.T) {
 		})
 	}
 }
+
+func TestComputeSpreads(t *testing.T) {
+	providerPrices := provider.AggregatedProviderPrices{
+		provider.ProviderKraken: {
+			"ATOMUSD": {
+				Price:  sdk.MustNewDecFromStr("10"),
+				Volume: sdk.MustNewDecFromStr("100"),
+				Spread: sdk.MustNewDecFromStr("0.001"),
+			},
+		},
+		provider.ProviderHuobi: {
+			"ATOMUSDT": {
+				Price:  sdk.MustNewDecFromStr("10"),
+				Volume: sdk.MustNewDecFromStr("300"),
+				Spread: sdk.MustNewDecFromStr("0.003"),
+			},
+		},
+		provider.ProviderBinance: {
+			// providers without bid/ask data are ignored
+			"ATOMUSDT": {
+				Price:  sdk.MustNewDecFromStr("10"),
+				Volume: sdk.MustNewDecFromStr("1000"),
+			},
+			"UMEEUSDT": {
+				Price:  sdk.MustNewDecFromStr("1"),
+				Volume: sdk.MustNewDecFromStr("1000"),
+			},
+		},
+	}
+	providerPairs := map[provider.Name][]types.CurrencyPair{
+		provider.ProviderKraken:  {{Base: "ATOM", Quote: "USD"}},
+		provider.ProviderHuobi:   {{Base: "ATOM", Quote: "USDT"}},
+		provider.ProviderBinance: {{Base: "ATOM", Quote: "USDT"}, {Base: "UMEE", Quote: "USDT"}},
+	}
+
+	spreads := oracle.ComputeSpreads(providerPrices, providerPairs)
+	require.Len(t, spreads, 1)
+	// (0.001 * 100 + 0.003 * 300) / 400
+	require.Equal(t, sdk.MustNewDecFromStr("0.0025"), spreads["ATOM"])
+}
@@ -4,10 +4,14 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"price-feeder/oracle/types"
 )
 
 // Oracle defines the Oracle interface contract that the v1 router depends on.
 type Oracle interface {
 	GetLastPriceSyncTimestamp() time.Time
 	GetPrices() sdk.DecCoins
+	GetSpreads() map[string]sdk.Dec
+	GetProviderQuality() map[string]types.ProviderQuality
 }
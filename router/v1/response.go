@@ -5,6 +5,8 @@ import (
 	"net/http"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"price-feeder/oracle/types"
 )
 
 // Response constants
@@ -24,7 +26,14 @@ type (
 	// PricesResponse defines the response type for getting the latest exchange
 	// rates from the oracle.
 	PricesResponse struct {
-		Prices map[string]sdk.Dec `json:"prices"`
+		Prices  map[string]sdk.Dec `json:"prices"`
+		Spreads map[string]sdk.Dec `json:"spreads,omitempty"`
+	}
+
+	// QualityResponse defines the response type for getting the data quality
+	// scores of the oracle's providers.
+	QualityResponse struct {
+		Providers map[string]types.ProviderQuality `json:"providers"`
 	}
 )
 
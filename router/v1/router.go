@@ -70,6 +70,11 @@ func (r *Router) RegisterRoutes(rtr *mux.Router, prefix string) {
 		mChain.ThenFunc(r.pricesHandler()),
 	).Methods(httputil.MethodGET)
 
+	v1Router.Handle(
+		"/quality",
+		mChain.ThenFunc(r.qualityHandler()),
+	).Methods(httputil.MethodGET)
+
 	if r.cfg.Telemetry.Enabled {
 		v1Router.Handle(
 			"/metrics",
@@ -97,7 +102,18 @@ func (r *Router) pricesHandler() http.HandlerFunc {
 			prices[price.Denom] = price.Amount
 		}
 		resp := PricesResponse{
-			Prices: prices,
+			Prices:  prices,
+			Spreads: r.oracle.GetSpreads(),
+		}
+
+		httputil.RespondWithJSON(w, http.StatusOK, resp)
+	}
+}
+
+func (r *Router) qualityHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		resp := QualityResponse{
+			Providers: r.oracle.GetProviderQuality(),
 		}
 
 		httputil.RespondWithJSON(w, http.StatusOK, resp)
@@ -13,6 +13,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"price-feeder/config"
+	"price-feeder/oracle/types"
 	v1 "price-feeder/router/v1"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
@@ -25,6 +26,10 @@ var (
 		sdk.NewDecCoinFromDec("ATOM", sdk.MustNewDecFromStr("34.84")),
 		sdk.NewDecCoinFromDec("UMEE", sdk.MustNewDecFromStr("4.21")),
 	}
+
+	mockQuality = map[string]types.ProviderQuality{
+		"binance": {Score: 97.5, Uptime: 1, SampleCount: 10},
+	}
 )
 
 type mockOracle struct{}
@@ -37,6 +42,14 @@ func (m mockOracle) GetPrices() sdk.DecCoins {
 	return mockPrices
 }
 
+func (m mockOracle) GetSpreads() map[string]sdk.Dec {
+	return map[string]sdk.Dec{}
+}
+
+func (m mockOracle) GetProviderQuality() map[string]types.ProviderQuality {
+	return mockQuality
+}
+
 type mockMetrics struct{}
 
 func (mockMetrics) Gather(format string) (telemetry.GatherResponse, error) {
@@ -103,3 +116,15 @@ func (rts *RouterTestSuite) TestPrices() {
 	rts.Require().Equal(respBody.Prices["UMEE"], mockPrices.AmountOf("UMEE"))
 	rts.Require().Equal(respBody.Prices["FOO"], sdk.Dec{})
 }
+
+func (rts *RouterTestSuite) TestQuality() {
+	req, err := http.NewRequest("GET", "/api/v1/quality", nil)
+	rts.Require().NoError(err)
+
+	response := rts.executeRequest(req)
+	rts.Require().Equal(http.StatusOK, response.Code)
+
+	var respBody v1.QualityResponse
+	rts.Require().NoError(json.Unmarshal(response.Body.Bytes(), &respBody))
+	rts.Require().Equal(mockQuality, respBody.Providers)
+}